@@ -4,16 +4,26 @@ import (
 	"fmt"
 	"github.com/go-chi/chi/v5"
 	"github.com/samber/do"
+	"github.com/samber/lo"
 	"invoice-backend/internal/api"
+	"invoice-backend/internal/api/middleware"
+	"invoice-backend/internal/api/server"
+	v1 "invoice-backend/internal/api/v1"
 	"invoice-backend/internal/appbase"
+	"invoice-backend/internal/auth"
 )
 
 func buildRouter(app *appbase.AppBase) *chi.Mux {
 	fmt.Println("hey")
 	mux := do.MustInvokeNamed[*chi.Mux](app.Injector, appbase.InjectorApplicationRouter)
 	routes := do.MustInvoke[*api.Routes](app.Injector)
+	tokenManager := do.MustInvoke[*auth.TokenManager](app.Injector)
+	apiKeys := do.MustInvoke[*v1.APIKeysHandler](app.Injector)
 
-	api.InitRoutes(mux, routes)
+	authorize := lo.Must(middleware.Authorize(lo.Must(server.GetSwagger())))
+
+	// The last middleware wraps the others, so the caller is authenticated before being authorized.
+	api.InitRoutes(mux, routes, authorize, middleware.Authenticate(tokenManager, apiKeys))
 
 	return mux
 }
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"invoice-backend/internal/appbase"
+	"invoice-backend/internal/jobs"
+	"invoice-backend/pkg/signals"
+
+	"github.com/rs/zerolog/log"
+	"github.com/samber/do"
+)
+
+const (
+	serviceName = "invoice-backend.worker"
+)
+
+func main() {
+	ctx, mainCtxStop := context.WithCancel(context.Background())
+
+	app := appbase.New(
+		appbase.Init(serviceName),
+		appbase.WithDependencyInjector(),
+	)
+	defer app.Shutdown()
+	fmt.Println(serviceName)
+
+	overdueJob := do.MustInvoke[*jobs.OverdueJob](app.Injector)
+	reminderJob := do.MustInvoke[*jobs.ReminderJob](app.Injector)
+	recurringJob := do.MustInvoke[*jobs.RecurringInvoiceJob](app.Injector)
+	estimateExpiryJob := do.MustInvoke[*jobs.EstimateExpiryJob](app.Injector)
+
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+
+	var running sync.WaitGroup
+
+	running.Add(4)
+
+	go func() {
+		defer running.Done()
+
+		overdueJob.Start(jobsCtx, app.Config.OverdueJobPeriod())
+	}()
+
+	go func() {
+		defer running.Done()
+
+		reminderJob.Start(jobsCtx, app.Config.ReminderJobPeriod())
+	}()
+
+	go func() {
+		defer running.Done()
+
+		recurringJob.Start(jobsCtx, app.Config.RecurringJobPeriod())
+	}()
+
+	go func() {
+		defer running.Done()
+
+		estimateExpiryJob.Start(jobsCtx, app.Config.EstimateExpiryJobPeriod())
+	}()
+
+	signals.HandleSignals(ctx, mainCtxStop, func() {
+		stopJobs()
+		running.Wait()
+	})
+
+	log.Info().Msgf(
+		"started worker, marking overdue invoices every %s, sending reminders every %s, generating recurring invoices "+
+			"every %s and expiring estimates every %s",
+		app.Config.OverdueJobPeriod(),
+		app.Config.ReminderJobPeriod(),
+		app.Config.RecurringJobPeriod(),
+		app.Config.EstimateExpiryJobPeriod(),
+	)
+
+	<-ctx.Done()
+}
@@ -0,0 +1,16 @@
+CREATE TABLE activities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    type VARCHAR(50) NOT NULL, -- Enum-like field (e.g., invoice_created, status_changed)
+    description TEXT NOT NULL,
+    invoice_id UUID NULL,
+    customer_id UUID NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT fk_invoice FOREIGN KEY (invoice_id) REFERENCES invoices (id) ON DELETE SET NULL,
+    CONSTRAINT fk_customer FOREIGN KEY (customer_id) REFERENCES customers (id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_activities_user_id_created_at ON activities (user_id, created_at DESC);
+CREATE INDEX idx_activities_invoice_id ON activities (invoice_id);
+CREATE INDEX idx_activities_customer_id ON activities (customer_id);
@@ -0,0 +1,5 @@
+ALTER TABLE invoices DROP CONSTRAINT invoices_user_id_invoice_number_key;
+ALTER TABLE invoices ADD CONSTRAINT invoices_invoice_number_key UNIQUE (invoice_number);
+
+DROP TABLE IF EXISTS document_sequences;
+DROP TABLE IF EXISTS document_numbering_settings;
@@ -0,0 +1,32 @@
+CREATE TABLE document_numbering_settings (
+    user_id UUID NOT NULL,
+    document_type VARCHAR(50) NOT NULL, -- Enum-like field (e.g., invoice)
+    prefix VARCHAR(20) NOT NULL,
+    template VARCHAR(100) NOT NULL, -- e.g., {PREFIX}-{YYYY}-{SEQ:5}
+    reset_policy VARCHAR(20) NOT NULL, -- Enum-like field (never, yearly)
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, document_type),
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE TABLE document_sequences (
+    user_id UUID NOT NULL,
+    document_type VARCHAR(50) NOT NULL,
+    period VARCHAR(10) NOT NULL DEFAULT '', -- e.g., 2026 for yearly resets, empty when numbers never reset
+    last_value BIGINT NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, document_type, period),
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- Continue the legacy INV0000001 numbering where each user left off.
+INSERT INTO document_sequences (user_id, document_type, period, last_value)
+SELECT user_id, 'invoice', '', MAX(CAST(SUBSTRING(invoice_number FROM 4) AS BIGINT))
+FROM invoices
+WHERE invoice_number ~ '^INV[0-9]+$'
+GROUP BY user_id;
+
+-- Invoice numbers only have to be unique per user now that every user has their own sequence.
+ALTER TABLE invoices DROP CONSTRAINT invoices_invoice_number_key;
+ALTER TABLE invoices ADD CONSTRAINT invoices_user_id_invoice_number_key UNIQUE (user_id, invoice_number);
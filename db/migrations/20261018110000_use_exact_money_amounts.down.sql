@@ -0,0 +1,6 @@
+ALTER TABLE invoice_items DROP COLUMN total_price;
+ALTER TABLE invoice_items ALTER COLUMN unit_price TYPE DECIMAL(15, 2);
+ALTER TABLE invoice_items ADD COLUMN total_price DECIMAL(15, 2) GENERATED ALWAYS AS (quantity * unit_price) STORED;
+
+ALTER TABLE invoices ALTER COLUMN total_amount TYPE DECIMAL(15, 2);
+ALTER TABLE invoices DROP COLUMN currency;
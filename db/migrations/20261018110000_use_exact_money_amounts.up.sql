@@ -0,0 +1,12 @@
+ALTER TABLE invoices ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE invoices ALTER COLUMN total_amount TYPE NUMERIC(19, 4);
+
+-- total_price was generated as quantity * unit_price, which cannot apply the
+-- currency's rounding rules; the application now stores the rounded line total.
+ALTER TABLE invoice_items DROP COLUMN total_price;
+ALTER TABLE invoice_items ALTER COLUMN unit_price TYPE NUMERIC(19, 4);
+ALTER TABLE invoice_items ADD COLUMN total_price NUMERIC(19, 4);
+
+UPDATE invoice_items SET total_price = ROUND(quantity * unit_price, 2);
+
+ALTER TABLE invoice_items ALTER COLUMN total_price SET NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_invoice_items_invoice_id;
+
+ALTER TABLE invoice_items DROP COLUMN position;
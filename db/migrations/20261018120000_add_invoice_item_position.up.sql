@@ -0,0 +1,11 @@
+ALTER TABLE invoice_items ADD COLUMN position INT NOT NULL DEFAULT 0;
+
+UPDATE invoice_items
+SET position = numbered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY invoice_id ORDER BY created_at, id) - 1 AS position
+    FROM invoice_items
+) AS numbered
+WHERE invoice_items.id = numbered.id;
+
+CREATE INDEX idx_invoice_items_invoice_id ON invoice_items (invoice_id, position);
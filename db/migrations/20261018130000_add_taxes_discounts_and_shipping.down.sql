@@ -0,0 +1,16 @@
+ALTER TABLE invoices
+    DROP COLUMN subtotal,
+    DROP COLUMN discount_type,
+    DROP COLUMN discount_value,
+    DROP COLUMN discount_amount,
+    DROP COLUMN shipping_amount,
+    DROP COLUMN tax_amount;
+
+ALTER TABLE invoice_items
+    DROP COLUMN discount_type,
+    DROP COLUMN discount_value,
+    DROP COLUMN discount_amount,
+    DROP COLUMN tax_amount;
+
+DROP TABLE IF EXISTS invoice_item_taxes;
+DROP TABLE IF EXISTS tax_rates;
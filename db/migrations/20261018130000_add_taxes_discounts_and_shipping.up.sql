@@ -0,0 +1,47 @@
+CREATE TABLE tax_rates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    rate NUMERIC(7, 4) NOT NULL, -- Percentage, e.g. 20 for 20%
+    compound BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT chk_tax_rates_rate CHECK (rate >= 0 AND rate <= 100)
+);
+
+CREATE INDEX idx_tax_rates_user_id ON tax_rates (user_id);
+
+-- Name, rate and compound are copied from tax_rates so that editing the catalogue never changes issued invoices.
+CREATE TABLE invoice_item_taxes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    invoice_item_id UUID NOT NULL,
+    tax_rate_id UUID NULL,
+    position INT NOT NULL DEFAULT 0,
+    name VARCHAR(100) NOT NULL,
+    rate NUMERIC(7, 4) NOT NULL,
+    compound BOOLEAN NOT NULL DEFAULT FALSE,
+    amount NUMERIC(19, 4) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT fk_invoice_item FOREIGN KEY (invoice_item_id) REFERENCES invoice_items (id) ON DELETE CASCADE,
+    CONSTRAINT fk_tax_rate FOREIGN KEY (tax_rate_id) REFERENCES tax_rates (id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_invoice_item_taxes_invoice_item_id ON invoice_item_taxes (invoice_item_id, position);
+
+ALTER TABLE invoice_items
+    ADD COLUMN discount_type VARCHAR(20) NULL, -- Enum-like field (percentage, fixed)
+    ADD COLUMN discount_value NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN discount_amount NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN tax_amount NUMERIC(19, 4) NOT NULL DEFAULT 0;
+
+ALTER TABLE invoices
+    ADD COLUMN subtotal NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN discount_type VARCHAR(20) NULL, -- Enum-like field (percentage, fixed)
+    ADD COLUMN discount_value NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN discount_amount NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN shipping_amount NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN tax_amount NUMERIC(19, 4) NOT NULL DEFAULT 0;
+
+-- Existing invoices have neither taxes nor discounts, so their subtotal is their total.
+UPDATE invoices SET subtotal = total_amount;
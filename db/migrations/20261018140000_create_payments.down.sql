@@ -0,0 +1,7 @@
+UPDATE invoices SET status = 'PENDING_PAYMENT' WHERE status = 'PARTIALLY_PAID';
+
+ALTER TABLE invoices
+    DROP COLUMN amount_paid,
+    DROP COLUMN amount_due;
+
+DROP TABLE IF EXISTS payments;
@@ -0,0 +1,31 @@
+CREATE TABLE payments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    invoice_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    type VARCHAR(20) NOT NULL, -- Enum-like field (payment, refund)
+    amount NUMERIC(19, 4) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    method VARCHAR(30) NOT NULL, -- Enum-like field (e.g., bank_transfer, card, cash)
+    reference VARCHAR(255) NULL,
+    paid_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    -- Payments are accounting records: an invoice holding payments cannot be deleted.
+    CONSTRAINT fk_invoice FOREIGN KEY (invoice_id) REFERENCES invoices (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT chk_payments_amount CHECK (amount > 0)
+);
+
+CREATE INDEX idx_payments_invoice_id ON payments (invoice_id, paid_at);
+
+ALTER TABLE invoices
+    ADD COLUMN amount_paid NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN amount_due NUMERIC(19, 4) NOT NULL DEFAULT 0;
+
+-- Invoices marked as paid before the ledger existed get a single payment covering their total.
+INSERT INTO payments (invoice_id, user_id, type, amount, currency, method, reference, paid_at)
+SELECT id, user_id, 'payment', total_amount, currency, 'other', 'Recorded before the payments ledger', updated_at
+FROM invoices
+WHERE status = 'PAID' AND total_amount > 0;
+
+UPDATE invoices SET amount_paid = total_amount WHERE status = 'PAID';
+UPDATE invoices SET amount_due = total_amount - amount_paid;
@@ -0,0 +1,14 @@
+CREATE TABLE branding_settings (
+    user_id UUID PRIMARY KEY,
+    company_name VARCHAR(255) NOT NULL DEFAULT '',
+    address TEXT NOT NULL DEFAULT '',
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    phone VARCHAR(20) NOT NULL DEFAULT '',
+    logo BYTEA, -- PNG or JPEG printed in the document header
+    primary_color CHAR(7) NOT NULL, -- #RRGGBB
+    accent_color CHAR(7) NOT NULL, -- #RRGGBB
+    footer_text VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
@@ -0,0 +1,5 @@
+-- Users created before roles were enforced own their account.
+UPDATE users SET role = 'owner' WHERE role NOT IN ('owner', 'accountant', 'viewer', 'admin');
+
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'owner';
+ALTER TABLE users ADD CONSTRAINT chk_users_role CHECK (role IN ('owner', 'accountant', 'viewer', 'admin'));
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_customers_deleted_at;
+DROP INDEX IF EXISTS idx_customers_user_phone;
+DROP INDEX IF EXISTS idx_customers_user_email;
+
+ALTER TABLE customers ADD CONSTRAINT customers_email_key UNIQUE (email);
+ALTER TABLE customers ADD CONSTRAINT customers_phone_key UNIQUE (phone);
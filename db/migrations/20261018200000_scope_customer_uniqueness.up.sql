@@ -0,0 +1,7 @@
+-- Emails and phone numbers only have to be unique among the live customers of a user.
+ALTER TABLE customers DROP CONSTRAINT IF EXISTS customers_email_key;
+ALTER TABLE customers DROP CONSTRAINT IF EXISTS customers_phone_key;
+
+CREATE UNIQUE INDEX idx_customers_user_email ON customers (user_id, LOWER(email)) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX idx_customers_user_phone ON customers (user_id, phone) WHERE deleted_at IS NULL AND phone <> '';
+CREATE INDEX idx_customers_deleted_at ON customers (deleted_at);
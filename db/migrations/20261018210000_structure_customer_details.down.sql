@@ -0,0 +1,26 @@
+ALTER TABLE customers ADD COLUMN address TEXT;
+
+UPDATE customers
+SET address = NULLIF(CONCAT_WS(E'\n',
+    NULLIF(billing_line1, ''),
+    NULLIF(billing_line2, ''),
+    NULLIF(TRIM(billing_postal_code || ' ' || billing_city), ''),
+    NULLIF(billing_region, ''),
+    NULLIF(billing_country, '')
+), '');
+
+ALTER TABLE customers
+    DROP COLUMN billing_line1,
+    DROP COLUMN billing_line2,
+    DROP COLUMN billing_city,
+    DROP COLUMN billing_region,
+    DROP COLUMN billing_postal_code,
+    DROP COLUMN billing_country,
+    DROP COLUMN shipping_line1,
+    DROP COLUMN shipping_line2,
+    DROP COLUMN shipping_city,
+    DROP COLUMN shipping_region,
+    DROP COLUMN shipping_postal_code,
+    DROP COLUMN shipping_country,
+    DROP COLUMN tax_id,
+    DROP COLUMN contacts;
@@ -0,0 +1,27 @@
+ALTER TABLE customers
+    ADD COLUMN billing_line1 VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN billing_line2 VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN billing_city VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN billing_region VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN billing_postal_code VARCHAR(32) NOT NULL DEFAULT '',
+    ADD COLUMN billing_country VARCHAR(2) NOT NULL DEFAULT '', -- ISO 3166-1 alpha-2, empty when unknown
+    ADD COLUMN shipping_line1 VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN shipping_line2 VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN shipping_city VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN shipping_region VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN shipping_postal_code VARCHAR(32) NOT NULL DEFAULT '',
+    ADD COLUMN shipping_country VARCHAR(2) NOT NULL DEFAULT '',
+    ADD COLUMN tax_id VARCHAR(32) NOT NULL DEFAULT '',
+    ADD COLUMN contacts JSONB NOT NULL DEFAULT '[]'; -- [{"name", "email", "phone", "cc"}]
+
+-- The free-text address becomes the billing address: its first line as line1 and the rest as line2.
+UPDATE customers
+SET billing_line1 = LEFT(TRIM(SPLIT_PART(address, E'\n', 1)), 255),
+    billing_line2 = LEFT(TRIM(REPLACE(SUBSTRING(address FROM POSITION(E'\n' IN address) + 1), E'\n', ', ')), 255)
+WHERE COALESCE(TRIM(address), '') <> '' AND POSITION(E'\n' IN address) > 0;
+
+UPDATE customers
+SET billing_line1 = LEFT(TRIM(address), 255)
+WHERE COALESCE(TRIM(address), '') <> '' AND POSITION(E'\n' IN address) = 0;
+
+ALTER TABLE customers DROP COLUMN address;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS invoice_reminders;
+DROP TABLE IF EXISTS reminder_rules;
@@ -0,0 +1,27 @@
+CREATE TABLE reminder_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    offset_days INTEGER NOT NULL, -- Days between the due date and the first reminder, negative before it
+    repeat_days INTEGER NULL, -- Days between the following reminders, NULL sends a single reminder
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT chk_reminder_rules_repeat_days CHECK (repeat_days IS NULL OR repeat_days > 0)
+);
+
+CREATE INDEX idx_reminder_rules_user_id ON reminder_rules (user_id);
+
+CREATE TABLE invoice_reminders (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    invoice_id UUID NOT NULL,
+    scheduled_on DATE NOT NULL,
+    status VARCHAR(20) NOT NULL, -- Enum-like field (pending, sent, failed)
+    error TEXT NULL,
+    sent_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT fk_invoice FOREIGN KEY (invoice_id) REFERENCES invoices (id) ON DELETE CASCADE,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    -- A reminder is claimed before it is sent, so an invoice never gets two reminders for the same day.
+    CONSTRAINT uq_invoice_reminders_invoice_day UNIQUE (invoice_id, scheduled_on)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE invoices DROP COLUMN recurring_invoice_id;
+
+DROP TABLE IF EXISTS recurring_invoices;
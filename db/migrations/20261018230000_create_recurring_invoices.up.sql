@@ -0,0 +1,34 @@
+CREATE TABLE recurring_invoices (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    currency CHAR(3) NOT NULL,
+    discount_type VARCHAR(20) NULL,
+    discount_value NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    shipping_amount NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    items JSONB NOT NULL DEFAULT '[]', -- Template lines, taxes are referenced by tax rate ID
+    frequency VARCHAR(20) NOT NULL, -- Enum-like field (weekly, monthly, yearly)
+    interval_count INTEGER NOT NULL DEFAULT 1,
+    day_of_month INTEGER NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NULL,
+    max_count INTEGER NULL,
+    payment_terms_days INTEGER NOT NULL DEFAULT 30,
+    auto_send BOOLEAN NOT NULL DEFAULT FALSE,
+    status VARCHAR(20) NOT NULL, -- Enum-like field (active, paused, completed)
+    generated_count INTEGER NOT NULL DEFAULT 0,
+    next_run_on DATE NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT fk_customer FOREIGN KEY (customer_id) REFERENCES customers (id) ON DELETE CASCADE,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT chk_recurring_invoices_interval CHECK (interval_count > 0),
+    CONSTRAINT chk_recurring_invoices_day_of_month CHECK (day_of_month BETWEEN 1 AND 31)
+);
+
+CREATE INDEX idx_recurring_invoices_user_id ON recurring_invoices (user_id, created_at);
+CREATE INDEX idx_recurring_invoices_next_run_on ON recurring_invoices (next_run_on) WHERE status = 'active';
+
+ALTER TABLE invoices
+    ADD COLUMN recurring_invoice_id UUID NULL,
+    ADD CONSTRAINT fk_recurring_invoice FOREIGN KEY (recurring_invoice_id) REFERENCES recurring_invoices (id) ON DELETE SET NULL;
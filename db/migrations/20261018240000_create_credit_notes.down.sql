@@ -0,0 +1,4 @@
+ALTER TABLE invoices DROP COLUMN amount_credited;
+
+DROP TABLE IF EXISTS credit_note_items;
+DROP TABLE IF EXISTS credit_notes;
@@ -0,0 +1,44 @@
+CREATE TABLE credit_notes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    invoice_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    credit_note_number VARCHAR(255) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    reason TEXT NOT NULL,
+    issue_date DATE NOT NULL,
+    subtotal NUMERIC(19, 4) NOT NULL,
+    tax_amount NUMERIC(19, 4) NOT NULL,
+    shipping_amount NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    total_amount NUMERIC(19, 4) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    -- Credit notes are accounting records: an invoice holding credit notes cannot be deleted.
+    CONSTRAINT fk_invoice FOREIGN KEY (invoice_id) REFERENCES invoices (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_customer FOREIGN KEY (customer_id) REFERENCES customers (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT uq_credit_notes_number UNIQUE (user_id, credit_note_number),
+    CONSTRAINT chk_credit_notes_total CHECK (total_amount > 0)
+);
+
+CREATE INDEX idx_credit_notes_user_id ON credit_notes (user_id, issue_date);
+CREATE INDEX idx_credit_notes_invoice_id ON credit_notes (invoice_id);
+
+CREATE TABLE credit_note_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    credit_note_id UUID NOT NULL,
+    invoice_item_id UUID NOT NULL,
+    position INTEGER NOT NULL,
+    description TEXT NOT NULL,
+    quantity INTEGER NOT NULL,
+    unit_price NUMERIC(19, 4) NOT NULL,
+    net_amount NUMERIC(19, 4) NOT NULL,
+    tax_amount NUMERIC(19, 4) NOT NULL,
+    taxes JSONB NOT NULL DEFAULT '[]', -- Credited share of every tax of the invoice line
+    CONSTRAINT fk_credit_note FOREIGN KEY (credit_note_id) REFERENCES credit_notes (id) ON DELETE CASCADE,
+    CONSTRAINT fk_invoice_item FOREIGN KEY (invoice_item_id) REFERENCES invoice_items (id) ON DELETE RESTRICT,
+    CONSTRAINT chk_credit_note_items_quantity CHECK (quantity > 0)
+);
+
+CREATE INDEX idx_credit_note_items_credit_note_id ON credit_note_items (credit_note_id, position);
+
+ALTER TABLE invoices ADD COLUMN amount_credited NUMERIC(19, 4) NOT NULL DEFAULT 0;
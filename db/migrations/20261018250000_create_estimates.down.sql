@@ -0,0 +1,3 @@
+ALTER TABLE invoices DROP COLUMN estimate_id;
+
+DROP TABLE IF EXISTS estimates;
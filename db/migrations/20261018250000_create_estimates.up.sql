@@ -0,0 +1,37 @@
+CREATE TABLE estimates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    estimate_number VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL, -- Enum-like field (DRAFT, SENT, ACCEPTED, DECLINED, EXPIRED)
+    currency CHAR(3) NOT NULL,
+    issue_date DATE NOT NULL,
+    expiry_date DATE NOT NULL,
+    discount_type VARCHAR(20) NULL,
+    discount_value NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    discount_amount NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    shipping_amount NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    subtotal NUMERIC(19, 4) NOT NULL,
+    tax_amount NUMERIC(19, 4) NOT NULL,
+    total_amount NUMERIC(19, 4) NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    items JSONB NOT NULL DEFAULT '[]', -- Priced lines, in the shape of invoice items with their taxes
+    public_token_hash VARCHAR(64) NULL, -- SHA-256 of the token of the customer link
+    responded_at TIMESTAMP NULL,
+    invoice_id UUID NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT fk_customer FOREIGN KEY (customer_id) REFERENCES customers (id) ON DELETE CASCADE,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT fk_invoice FOREIGN KEY (invoice_id) REFERENCES invoices (id) ON DELETE SET NULL,
+    CONSTRAINT uq_estimates_number UNIQUE (user_id, estimate_number),
+    CONSTRAINT uq_estimates_public_token_hash UNIQUE (public_token_hash),
+    CONSTRAINT chk_estimates_expiry_date CHECK (expiry_date >= issue_date)
+);
+
+CREATE INDEX idx_estimates_user_id ON estimates (user_id, created_at);
+CREATE INDEX idx_estimates_expiry_date ON estimates (expiry_date) WHERE status = 'SENT';
+
+ALTER TABLE invoices
+    ADD COLUMN estimate_id UUID NULL,
+    ADD CONSTRAINT fk_estimate FOREIGN KEY (estimate_id) REFERENCES estimates (id) ON DELETE SET NULL;
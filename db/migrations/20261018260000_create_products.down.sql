@@ -0,0 +1,3 @@
+ALTER TABLE invoice_items DROP COLUMN product_id;
+
+DROP TABLE IF EXISTS products;
@@ -0,0 +1,26 @@
+CREATE TABLE products (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    sku VARCHAR(64) NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    unit_price NUMERIC(19, 4) NOT NULL,
+    unit VARCHAR(20) NOT NULL DEFAULT '', -- Unit of measure, e.g. hour or kg
+    tax_rate_id UUID NULL, -- Tax rate applied by default to the lines of the product
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT fk_tax_rate FOREIGN KEY (tax_rate_id) REFERENCES tax_rates (id) ON DELETE SET NULL,
+    CONSTRAINT uq_products_sku UNIQUE (user_id, sku),
+    CONSTRAINT chk_products_unit_price CHECK (unit_price >= 0)
+);
+
+CREATE INDEX idx_products_user_id ON products (user_id, name);
+
+-- Lines keep their own description and prices: the product only records where they were filled from.
+ALTER TABLE invoice_items
+    ADD COLUMN product_id UUID NULL,
+    ADD CONSTRAINT fk_product FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_invoice_items_product_id ON invoice_items (product_id) WHERE product_id IS NOT NULL;
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/auth"
+)
+
+const (
+	bearerScheme = "Bearer"
+	apiKeyScheme = "ApiKey"
+)
+
+var ErrMissingCredentials = errors.New("missing bearer token or API key")
+
+// APIKeyAuthenticator resolves the principal of an API key, failing with auth.ErrInvalidAPIKey for unknown,
+// expired and revoked keys.
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*auth.Principal, error)
+}
+
+// Authenticate verifies the credentials of the operations the OpenAPI spec secures and puts the authenticated
+// principal into the request context. Callers send either `Authorization: Bearer <access token>` or
+// `Authorization: ApiKey <key>`. Operations declaring `security: []` stay public.
+func Authenticate(tokenManager *auth.TokenManager, apiKeys APIKeyAuthenticator) server.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !requiresAuthentication(r) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			scheme, credentials, ok := authorizationHeader(r)
+			if !ok {
+				server.UnauthorizedError(ErrMissingCredentials, w, r)
+
+				return
+			}
+
+			var (
+				principal *auth.Principal
+				err       error
+			)
+
+			switch {
+			case strings.EqualFold(scheme, bearerScheme):
+				principal, err = tokenManager.ParseAccessToken(credentials)
+				if err != nil {
+					err = auth.ErrInvalidToken
+				}
+			case strings.EqualFold(scheme, apiKeyScheme):
+				principal, err = apiKeys.AuthenticateAPIKey(r.Context(), credentials)
+			default:
+				err = ErrMissingCredentials
+			}
+
+			if err != nil {
+				if !errors.Is(err, auth.ErrInvalidToken) && !errors.Is(err, auth.ErrInvalidAPIKey) &&
+					!errors.Is(err, ErrMissingCredentials) {
+					log.Ctx(r.Context()).Error().Err(err).Msg("failed to authenticate request")
+					server.ProcessingError(err, w, r)
+
+					return
+				}
+
+				server.UnauthorizedError(err, w, r)
+
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+// requiresAuthentication reports whether the generated server marked the operation as secured.
+func requiresAuthentication(r *http.Request) bool {
+	_, secured := r.Context().Value(server.BearerAuthScopes).([]string)
+
+	return secured
+}
+
+func authorizationHeader(r *http.Request) (scheme string, credentials string, ok bool) {
+	scheme, credentials, ok = strings.Cut(r.Header.Get("Authorization"), " ")
+	credentials = strings.TrimSpace(credentials)
+
+	return scheme, credentials, ok && credentials != ""
+}
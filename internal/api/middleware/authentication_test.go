@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/auth"
+)
+
+type fakeAPIKeys map[string]*auth.Principal
+
+func (f fakeAPIKeys) AuthenticateAPIKey(_ context.Context, key string) (*auth.Principal, error) {
+	principal, ok := f[key]
+	if !ok {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	return principal, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	tokenManager := auth.NewTokenManager("secret", "invoice-backend", time.Minute, time.Hour)
+	principal := &auth.Principal{UserID: uuid.New(), Email: "jane@example.com", Role: auth.RoleOwner}
+	keyPrincipal := &auth.Principal{UserID: principal.UserID, Role: auth.RoleOwner, Scopes: []string{"invoices:read"}}
+	apiKeys := fakeAPIKeys{"inv_3f9a0c1d_secret": keyPrincipal}
+
+	validToken, err := tokenManager.IssueAccessToken(principal, time.Now())
+	require.NoError(t, err)
+
+	expiredToken, err := tokenManager.IssueAccessToken(principal, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		secured       bool
+		authorization string
+		status        int
+		principal     *auth.Principal
+	}{
+		{"public operation", false, "", http.StatusOK, nil},
+		{"missing token", true, "", http.StatusUnauthorized, nil},
+		{"wrong scheme", true, "Basic " + validToken, http.StatusUnauthorized, nil},
+		{"expired token", true, "Bearer " + expiredToken, http.StatusUnauthorized, nil},
+		{"valid token", true, "Bearer " + validToken, http.StatusOK, principal},
+		{"lowercase scheme", true, "bearer " + validToken, http.StatusOK, principal},
+		{"valid API key", true, "ApiKey inv_3f9a0c1d_secret", http.StatusOK, keyPrincipal},
+		{"unknown API key", true, "ApiKey inv_00000000_secret", http.StatusUnauthorized, nil},
+		{"access token sent as API key", true, "ApiKey " + validToken, http.StatusUnauthorized, nil},
+		{"empty credentials", true, "Bearer ", http.StatusUnauthorized, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen *auth.Principal
+
+			handler := Authenticate(tokenManager, apiKeys)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seen, _ = auth.PrincipalFromContext(r.Context())
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/v1/invoices", nil)
+			if tt.secured {
+				r = r.WithContext(context.WithValue(r.Context(), server.BearerAuthScopes, []string{}))
+			}
+
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Equal(t, tt.principal, seen)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/auth"
+)
+
+var ErrUnmappedOperation = errors.New("operation has no permission")
+
+// OperationPermissions maps the operations of server.ServerInterface, named by the operation IDs of the embedded
+// spec, to the permission a caller needs to run them. Secured operations missing from the map are denied to everybody.
+var OperationPermissions = map[string]auth.Permission{
+	"V1GetActivities": auth.PermissionActivitiesRead,
+
+	"V1GetApiKeys":   auth.PermissionAPIKeysRead,
+	"V1CreateApiKey": auth.PermissionAPIKeysWrite,
+	"V1RevokeApiKey": auth.PermissionAPIKeysWrite,
+
+	"V1GetCustomers":    auth.PermissionCustomersRead,
+	"V1GetCustomer":     auth.PermissionCustomersRead,
+	"V1CreateCustomer":  auth.PermissionCustomersWrite,
+	"V1UpdateCustomer":  auth.PermissionCustomersWrite,
+	"V1DeleteCustomer":  auth.PermissionCustomersDelete,
+	"V1RestoreCustomer": auth.PermissionCustomersDelete,
+
+	"V1GetInvoices":          auth.PermissionInvoicesRead,
+	"V1GetInvoice":           auth.PermissionInvoicesRead,
+	"V1GetInvoicePdf":        auth.PermissionInvoicesRead,
+	"V1GetInvoiceDeliveries": auth.PermissionInvoicesRead,
+	"V1CreateInvoice":        auth.PermissionInvoicesWrite,
+	"V1UpdateInvoice":        auth.PermissionInvoicesWrite,
+	"V1SendInvoice":          auth.PermissionInvoicesWrite,
+	"V1VoidInvoice":          auth.PermissionInvoicesWrite,
+	"V1DeleteInvoice":        auth.PermissionInvoicesDelete,
+
+	"V1GetInvoicePayments":   auth.PermissionPaymentsRead,
+	"V1CreateInvoicePayment": auth.PermissionPaymentsWrite,
+	"V1MarkInvoicePaid":      auth.PermissionPaymentsWrite,
+
+	"V1GetBrandingSettings":            auth.PermissionSettingsRead,
+	"V1GetInvoiceNumberingSettings":    auth.PermissionSettingsRead,
+	"V1GetReminderSettings":            auth.PermissionSettingsRead,
+	"V1UpdateBrandingSettings":         auth.PermissionSettingsWrite,
+	"V1UpdateInvoiceNumberingSettings": auth.PermissionSettingsWrite,
+	"V1UpdateReminderSettings":         auth.PermissionSettingsWrite,
+
+	"V1GetCreditNotes":          auth.PermissionInvoicesRead,
+	"V1GetCreditNote":           auth.PermissionInvoicesRead,
+	"V1GetCreditNotePdf":        auth.PermissionInvoicesRead,
+	"V1GetInvoiceCreditNotes":   auth.PermissionInvoicesRead,
+	"V1CreateInvoiceCreditNote": auth.PermissionInvoicesWrite,
+
+	"V1GetEstimates":    auth.PermissionInvoicesRead,
+	"V1GetEstimate":     auth.PermissionInvoicesRead,
+	"V1CreateEstimate":  auth.PermissionInvoicesWrite,
+	"V1UpdateEstimate":  auth.PermissionInvoicesWrite,
+	"V1SendEstimate":    auth.PermissionInvoicesWrite,
+	"V1ConvertEstimate": auth.PermissionInvoicesWrite,
+	"V1DeleteEstimate":  auth.PermissionInvoicesDelete,
+
+	"V1GetRecurringInvoices":    auth.PermissionInvoicesRead,
+	"V1GetRecurringInvoice":     auth.PermissionInvoicesRead,
+	"V1PreviewRecurringInvoice": auth.PermissionInvoicesRead,
+	"V1CreateRecurringInvoice":  auth.PermissionInvoicesWrite,
+	"V1UpdateRecurringInvoice":  auth.PermissionInvoicesWrite,
+	"V1PauseRecurringInvoice":   auth.PermissionInvoicesWrite,
+	"V1ResumeRecurringInvoice":  auth.PermissionInvoicesWrite,
+	"V1DeleteRecurringInvoice":  auth.PermissionInvoicesDelete,
+
+	"V1GetProducts":     auth.PermissionProductsRead,
+	"V1GetProduct":      auth.PermissionProductsRead,
+	"V1GetProductSales": auth.PermissionProductsRead,
+	"V1CreateProduct":   auth.PermissionProductsWrite,
+	"V1UpdateProduct":   auth.PermissionProductsWrite,
+	"V1DeleteProduct":   auth.PermissionProductsDelete,
+
+	"V1GetTaxRates":   auth.PermissionTaxRatesRead,
+	"V1GetTaxRate":    auth.PermissionTaxRatesRead,
+	"V1CreateTaxRate": auth.PermissionTaxRatesWrite,
+	"V1UpdateTaxRate": auth.PermissionTaxRatesWrite,
+	"V1DeleteTaxRate": auth.PermissionTaxRatesDelete,
+}
+
+// Authorize checks the role of the authenticated principal against the permission OperationPermissions requires
+// for the requested operation, answering 403 when it is not granted. It relies on Authenticate running first.
+func Authorize(doc *openapi3.T) (server.MiddlewareFunc, error) {
+	doc.Servers = nil
+
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !requiresAuthentication(r) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			principal, ok := auth.PrincipalFromContext(r.Context())
+			if !ok {
+				server.UnauthorizedError(ErrMissingCredentials, w, r)
+
+				return
+			}
+
+			permission, err := operationPermission(router, r)
+			if err != nil {
+				server.ForbiddenError(err, w, r)
+
+				return
+			}
+
+			err = principal.Authorize(permission)
+			if err != nil {
+				server.ForbiddenError(err, w, r)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+func operationPermission(router routers.Router, r *http.Request) (auth.Permission, error) {
+	route, _, err := router.FindRoute(r)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s %s", ErrUnmappedOperation, r.Method, r.URL.Path)
+	}
+
+	permission, ok := OperationPermissions[route.Operation.OperationID]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnmappedOperation, route.Operation.OperationID)
+	}
+
+	return permission, nil
+}
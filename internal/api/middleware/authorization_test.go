@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/auth"
+)
+
+func TestOperationPermissionsCoverSecuredOperations(t *testing.T) {
+	doc, err := server.GetSwagger()
+	require.NoError(t, err)
+
+	for path, item := range doc.Paths.Map() {
+		for method, operation := range item.Operations() {
+			if operation.Security != nil && len(*operation.Security) == 0 {
+				continue
+			}
+
+			assert.Contains(t, OperationPermissions, operation.OperationID, "%s %s has no permission", method, path)
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	doc, err := server.GetSwagger()
+	require.NoError(t, err)
+
+	authorize, err := Authorize(doc)
+	require.NoError(t, err)
+
+	invoicePath := "/v1/invoices/" + uuid.NewString()
+
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		secured   bool
+		principal *auth.Principal
+		status    int
+	}{
+		{"public operation", http.MethodPost, "/v1/auth/login", false, nil, http.StatusOK},
+		{"missing principal", http.MethodGet, "/v1/invoices", true, nil, http.StatusUnauthorized},
+		{"viewer lists invoices", http.MethodGet, "/v1/invoices", true, &auth.Principal{Role: auth.RoleViewer}, http.StatusOK},
+		{"viewer cannot create invoices", http.MethodPost, "/v1/invoices", true, &auth.Principal{Role: auth.RoleViewer}, http.StatusForbidden},
+		{"accountant creates invoices", http.MethodPost, "/v1/invoices", true, &auth.Principal{Role: auth.RoleAccountant}, http.StatusOK},
+		{"owner deletes invoices", http.MethodDelete, invoicePath, true, &auth.Principal{Role: auth.RoleOwner}, http.StatusOK},
+		{"accountant cannot delete invoices", http.MethodDelete, invoicePath, true, &auth.Principal{Role: auth.RoleAccountant}, http.StatusForbidden},
+		{"admin deletes invoices", http.MethodDelete, invoicePath, true, &auth.Principal{Role: auth.RoleAdmin}, http.StatusOK},
+		{"API key scoped for reading", http.MethodGet, "/v1/invoices", true, &auth.Principal{Role: auth.RoleOwner, Scopes: []string{"invoices:read"}}, http.StatusOK},
+		{"API key not scoped for writing", http.MethodPost, "/v1/invoices", true, &auth.Principal{Role: auth.RoleOwner, Scopes: []string{"invoices:read"}}, http.StatusForbidden},
+		{"unknown operation", http.MethodGet, "/v1/unknown", true, &auth.Principal{Role: auth.RoleAdmin}, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := authorize(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			r := httptest.NewRequest(tt.method, tt.path, nil)
+			ctx := r.Context()
+
+			if tt.secured {
+				ctx = context.WithValue(ctx, server.BearerAuthScopes, []string{})
+			}
+
+			if tt.principal != nil {
+				ctx = auth.WithPrincipal(ctx, tt.principal)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r.WithContext(ctx))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
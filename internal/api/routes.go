@@ -10,8 +10,13 @@ import (
 	v1 "invoice-backend/internal/api/v1"
 )
 
-func InitRoutes(router *chi.Mux, si *Routes) {
-	server.HandlerFromMux(si, router)
+// InitRoutes mounts the API on the router. The middlewares run for every operation, after the generated server
+// put the operation's security requirements into the request context.
+func InitRoutes(router *chi.Mux, si *Routes, middlewares ...server.MiddlewareFunc) {
+	server.HandlerWithOptions(si, server.ChiServerOptions{
+		BaseRouter:  router,
+		Middlewares: middlewares,
+	})
 }
 func NewRoutes(apiV1 *v1.API) *Routes {
 	return &Routes{
@@ -24,9 +29,36 @@ type Routes struct {
 	v1 *v1.API
 }
 
-func (a Routes) V1GetActivities(w http.ResponseWriter, r *http.Request) {
-	//TODO implement me
-	panic("implement me")
+func (a Routes) V1Register(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1Register(w, r)
+}
+
+func (a Routes) V1Login(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1Login(w, r)
+}
+
+func (a Routes) V1RefreshToken(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1RefreshToken(w, r)
+}
+
+func (a Routes) V1Logout(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1Logout(w, r)
+}
+
+func (a Routes) V1GetApiKeys(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1GetApiKeys(w, r)
+}
+
+func (a Routes) V1CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1CreateApiKey(w, r)
+}
+
+func (a Routes) V1RevokeApiKey(w http.ResponseWriter, r *http.Request, apiKeyId openapi_types.UUID) {
+	a.v1.V1RevokeApiKey(w, r, apiKeyId)
+}
+
+func (a Routes) V1GetActivities(w http.ResponseWriter, r *http.Request, params server.V1GetActivitiesParams) {
+	a.v1.V1GetActivities(w, r, params)
 }
 
 func (a Routes) V1GetCustomers(w http.ResponseWriter, r *http.Request, params server.V1GetCustomersParams) {
@@ -37,6 +69,22 @@ func (a Routes) V1CreateCustomer(w http.ResponseWriter, r *http.Request) {
 	a.v1.V1CreateCustomer(w, r)
 }
 
+func (a Routes) V1DeleteCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID) {
+	a.v1.V1DeleteCustomer(w, r, customerId)
+}
+
+func (a Routes) V1GetCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID) {
+	a.v1.V1GetCustomer(w, r, customerId)
+}
+
+func (a Routes) V1UpdateCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID) {
+	a.v1.V1UpdateCustomer(w, r, customerId)
+}
+
+func (a Routes) V1RestoreCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID) {
+	a.v1.V1RestoreCustomer(w, r, customerId)
+}
+
 func (a Routes) V1GetInvoices(w http.ResponseWriter, r *http.Request, params server.V1GetInvoicesParams) {
 	a.v1.V1GetInvoices(w, r, params)
 }
@@ -45,17 +93,212 @@ func (a Routes) V1CreateInvoice(w http.ResponseWriter, r *http.Request) {
 	a.v1.V1CreateInvoice(w, r)
 }
 
-func (a Routes) V1DeleteInvoice(w http.ResponseWriter, r *http.Request, invoiceId string) {
-	//TODO implement me
-	panic("implement me")
+func (a Routes) V1DeleteInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	a.v1.V1DeleteInvoice(w, r, invoiceId)
 }
 
 func (a Routes) V1GetInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
-	//TODO implement me
-	panic("implement me")
+	a.v1.V1GetInvoice(w, r, invoiceId)
+}
+
+func (a Routes) V1UpdateInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	a.v1.V1UpdateInvoice(w, r, invoiceId)
+}
+
+func (a Routes) V1MarkInvoicePaid(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	a.v1.V1MarkInvoicePaid(w, r, invoiceId)
+}
+
+func (a Routes) V1GetInvoiceDeliveries(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	a.v1.V1GetInvoiceDeliveries(w, r, invoiceId)
+}
+
+func (a Routes) V1GetInvoicePdf(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	a.v1.V1GetInvoicePdf(w, r, invoiceId)
+}
+
+func (a Routes) V1SendInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	a.v1.V1SendInvoice(w, r, invoiceId)
+}
+
+func (a Routes) V1VoidInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	a.v1.V1VoidInvoice(w, r, invoiceId)
+}
+
+func (a Routes) V1GetBrandingSettings(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1GetBrandingSettings(w, r)
+}
+
+func (a Routes) V1UpdateBrandingSettings(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1UpdateBrandingSettings(w, r)
+}
+
+func (a Routes) V1GetReminderSettings(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1GetReminderSettings(w, r)
+}
+
+func (a Routes) V1UpdateReminderSettings(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1UpdateReminderSettings(w, r)
+}
+
+func (a Routes) V1GetInvoiceNumberingSettings(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1GetInvoiceNumberingSettings(w, r)
+}
+
+func (a Routes) V1UpdateInvoiceNumberingSettings(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1UpdateInvoiceNumberingSettings(w, r)
+}
+
+func (a Routes) V1GetCreditNotes(w http.ResponseWriter, r *http.Request, params server.V1GetCreditNotesParams) {
+	a.v1.V1GetCreditNotes(w, r, params)
+}
+
+func (a Routes) V1GetCreditNote(w http.ResponseWriter, r *http.Request, creditNoteId openapi_types.UUID) {
+	a.v1.V1GetCreditNote(w, r, creditNoteId)
+}
+
+func (a Routes) V1GetCreditNotePdf(w http.ResponseWriter, r *http.Request, creditNoteId openapi_types.UUID) {
+	a.v1.V1GetCreditNotePdf(w, r, creditNoteId)
+}
+
+func (a Routes) V1GetInvoiceCreditNotes(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	a.v1.V1GetInvoiceCreditNotes(w, r, invoiceId)
+}
+
+func (a Routes) V1CreateInvoiceCreditNote(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	a.v1.V1CreateInvoiceCreditNote(w, r, invoiceId)
+}
+
+func (a Routes) V1GetEstimates(w http.ResponseWriter, r *http.Request, params server.V1GetEstimatesParams) {
+	a.v1.V1GetEstimates(w, r, params)
+}
+
+func (a Routes) V1CreateEstimate(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1CreateEstimate(w, r)
+}
+
+func (a Routes) V1GetEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID) {
+	a.v1.V1GetEstimate(w, r, estimateId)
+}
+
+func (a Routes) V1UpdateEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID) {
+	a.v1.V1UpdateEstimate(w, r, estimateId)
+}
+
+func (a Routes) V1DeleteEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID) {
+	a.v1.V1DeleteEstimate(w, r, estimateId)
+}
+
+func (a Routes) V1SendEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID) {
+	a.v1.V1SendEstimate(w, r, estimateId)
+}
+
+func (a Routes) V1ConvertEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID) {
+	a.v1.V1ConvertEstimate(w, r, estimateId)
+}
+
+func (a Routes) V1GetPublicEstimate(w http.ResponseWriter, r *http.Request, token string) {
+	a.v1.V1GetPublicEstimate(w, r, token)
+}
+
+func (a Routes) V1AcceptPublicEstimate(w http.ResponseWriter, r *http.Request, token string) {
+	a.v1.V1AcceptPublicEstimate(w, r, token)
+}
+
+func (a Routes) V1DeclinePublicEstimate(w http.ResponseWriter, r *http.Request, token string) {
+	a.v1.V1DeclinePublicEstimate(w, r, token)
+}
+
+func (a Routes) V1GetProducts(w http.ResponseWriter, r *http.Request, params server.V1GetProductsParams) {
+	a.v1.V1GetProducts(w, r, params)
+}
+
+func (a Routes) V1CreateProduct(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1CreateProduct(w, r)
+}
+
+func (a Routes) V1GetProduct(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	a.v1.V1GetProduct(w, r, productId)
+}
+
+func (a Routes) V1UpdateProduct(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	a.v1.V1UpdateProduct(w, r, productId)
+}
+
+func (a Routes) V1DeleteProduct(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	a.v1.V1DeleteProduct(w, r, productId)
+}
+
+func (a Routes) V1GetProductSales(
+	w http.ResponseWriter,
+	r *http.Request,
+	productId openapi_types.UUID,
+	params server.V1GetProductSalesParams,
+) {
+	a.v1.V1GetProductSales(w, r, productId, params)
+}
+
+func (a Routes) V1GetRecurringInvoices(w http.ResponseWriter, r *http.Request, params server.V1GetRecurringInvoicesParams) {
+	a.v1.V1GetRecurringInvoices(w, r, params)
+}
+
+func (a Routes) V1CreateRecurringInvoice(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1CreateRecurringInvoice(w, r)
+}
+
+func (a Routes) V1GetRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID) {
+	a.v1.V1GetRecurringInvoice(w, r, recurringInvoiceId)
+}
+
+func (a Routes) V1UpdateRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID) {
+	a.v1.V1UpdateRecurringInvoice(w, r, recurringInvoiceId)
+}
+
+func (a Routes) V1DeleteRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID) {
+	a.v1.V1DeleteRecurringInvoice(w, r, recurringInvoiceId)
+}
+
+func (a Routes) V1PauseRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID) {
+	a.v1.V1PauseRecurringInvoice(w, r, recurringInvoiceId)
+}
+
+func (a Routes) V1ResumeRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID) {
+	a.v1.V1ResumeRecurringInvoice(w, r, recurringInvoiceId)
+}
+
+func (a Routes) V1PreviewRecurringInvoice(
+	w http.ResponseWriter,
+	r *http.Request,
+	recurringInvoiceId openapi_types.UUID,
+	params server.V1PreviewRecurringInvoiceParams,
+) {
+	a.v1.V1PreviewRecurringInvoice(w, r, recurringInvoiceId, params)
+}
+
+func (a Routes) V1GetTaxRates(w http.ResponseWriter, r *http.Request, params server.V1GetTaxRatesParams) {
+	a.v1.V1GetTaxRates(w, r, params)
+}
+
+func (a Routes) V1CreateTaxRate(w http.ResponseWriter, r *http.Request) {
+	a.v1.V1CreateTaxRate(w, r)
+}
+
+func (a Routes) V1GetTaxRate(w http.ResponseWriter, r *http.Request, taxRateId openapi_types.UUID) {
+	a.v1.V1GetTaxRate(w, r, taxRateId)
+}
+
+func (a Routes) V1UpdateTaxRate(w http.ResponseWriter, r *http.Request, taxRateId openapi_types.UUID) {
+	a.v1.V1UpdateTaxRate(w, r, taxRateId)
+}
+
+func (a Routes) V1DeleteTaxRate(w http.ResponseWriter, r *http.Request, taxRateId openapi_types.UUID) {
+	a.v1.V1DeleteTaxRate(w, r, taxRateId)
+}
+
+func (a Routes) V1GetInvoicePayments(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	a.v1.V1GetInvoicePayments(w, r, invoiceId)
 }
 
-func (a Routes) V1UpdateInvoice(w http.ResponseWriter, r *http.Request, invoiceId string) {
-	//TODO implement me
-	panic("implement me")
+func (a Routes) V1CreateInvoicePayment(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	a.v1.V1CreateInvoicePayment(w, r, invoiceId)
 }
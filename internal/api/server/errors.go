@@ -22,6 +22,8 @@ const (
 	processingErrorTitle = "PROCESSING_ERROR"
 	timeoutErrorTitle    = "TIMEOUT"
 	notFoundErrorTitle   = "NOT_FOUND"
+	unauthorizedTitle    = "UNAUTHORIZED"
+	forbiddenTitle       = "FORBIDDEN"
 
 	notFoundErrorDetail = "record not found"
 )
@@ -188,3 +190,47 @@ func ConflictError(conflictErr error, pendingAccounts []string, w http.ResponseW
 	render.Status(r, statusCode)
 	render.JSON(w, r, errResponse)
 }
+
+func UnauthorizedError(unauthorizedErr error, w http.ResponseWriter, r *http.Request) {
+	statusCode := http.StatusUnauthorized
+
+	errs := make([]Error, 0)
+
+	err := Error{
+		Code:   http.StatusText(statusCode),
+		Detail: unauthorizedErr.Error(),
+		Meta:   lo.ToPtr(map[string]interface{}{}),
+		Status: statusCode,
+		Title:  unauthorizedTitle,
+	}
+
+	errs = append(errs, err)
+
+	errResponse := ErrorResponse{Errors: errs}
+
+	w.Header().Add("WWW-Authenticate", `Bearer realm="invoice-backend"`)
+	w.Header().Add("WWW-Authenticate", `ApiKey realm="invoice-backend"`)
+	render.Status(r, statusCode)
+	render.JSON(w, r, errResponse)
+}
+
+func ForbiddenError(forbiddenErr error, w http.ResponseWriter, r *http.Request) {
+	statusCode := http.StatusForbidden
+
+	errs := make([]Error, 0)
+
+	err := Error{
+		Code:   http.StatusText(statusCode),
+		Detail: forbiddenErr.Error(),
+		Meta:   lo.ToPtr(map[string]interface{}{}),
+		Status: statusCode,
+		Title:  forbiddenTitle,
+	}
+
+	errs = append(errs, err)
+
+	errResponse := ErrorResponse{Errors: errs}
+
+	render.Status(r, statusCode)
+	render.JSON(w, r, errResponse)
+}
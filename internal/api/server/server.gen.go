@@ -6,6 +6,7 @@ package server
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"net/http"
@@ -20,51 +21,392 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for ActivityTypeEnum.
+const (
+	CreditNoteIssued  ActivityTypeEnum = "credit_note_issued"
+	CustomerCreated   ActivityTypeEnum = "customer_created"
+	CustomerDeleted   ActivityTypeEnum = "customer_deleted"
+	CustomerRestored  ActivityTypeEnum = "customer_restored"
+	CustomerUpdated   ActivityTypeEnum = "customer_updated"
+	EstimateAccepted  ActivityTypeEnum = "estimate_accepted"
+	EstimateConverted ActivityTypeEnum = "estimate_converted"
+	EstimateDeclined  ActivityTypeEnum = "estimate_declined"
+	EstimateSent      ActivityTypeEnum = "estimate_sent"
+	InvoiceCreated    ActivityTypeEnum = "invoice_created"
+	InvoiceDeleted    ActivityTypeEnum = "invoice_deleted"
+	InvoiceSent       ActivityTypeEnum = "invoice_sent"
+	InvoiceUpdated    ActivityTypeEnum = "invoice_updated"
+	PaymentRecorded   ActivityTypeEnum = "payment_recorded"
+	PaymentRefunded   ActivityTypeEnum = "payment_refunded"
+	ReminderSent      ActivityTypeEnum = "reminder_sent"
+	StatusChanged     ActivityTypeEnum = "status_changed"
+)
+
+// Defines values for CustomerSortEnum.
+const (
+	CustomerSortCreatedAt     CustomerSortEnum = "created_at"
+	CustomerSortCreatedAtDesc CustomerSortEnum = "-created_at"
+	CustomerSortEmail         CustomerSortEnum = "email"
+	CustomerSortEmailDesc     CustomerSortEnum = "-email"
+	CustomerSortName          CustomerSortEnum = "name"
+	CustomerSortNameDesc      CustomerSortEnum = "-name"
+)
+
+// Defines values for DeliveryStatusEnum.
+const (
+	Failed  DeliveryStatusEnum = "failed"
+	Pending DeliveryStatusEnum = "pending"
+	Sent    DeliveryStatusEnum = "sent"
+)
+
+// Defines values for DiscountTypeEnum.
+const (
+	Fixed      DiscountTypeEnum = "fixed"
+	Percentage DiscountTypeEnum = "percentage"
+)
+
+// Defines values for EstimateStatusEnum.
+const (
+	EstimateStatusEnumACCEPTED EstimateStatusEnum = "ACCEPTED"
+	EstimateStatusEnumDECLINED EstimateStatusEnum = "DECLINED"
+	EstimateStatusEnumDRAFT    EstimateStatusEnum = "DRAFT"
+	EstimateStatusEnumEXPIRED  EstimateStatusEnum = "EXPIRED"
+	EstimateStatusEnumSENT     EstimateStatusEnum = "SENT"
+)
+
+// Defines values for InvoiceSortEnum.
+const (
+	InvoiceSortCreatedAt         InvoiceSortEnum = "created_at"
+	InvoiceSortCreatedAtDesc     InvoiceSortEnum = "-created_at"
+	InvoiceSortDueDate           InvoiceSortEnum = "due_date"
+	InvoiceSortDueDateDesc       InvoiceSortEnum = "-due_date"
+	InvoiceSortInvoiceNumber     InvoiceSortEnum = "invoice_number"
+	InvoiceSortInvoiceNumberDesc InvoiceSortEnum = "-invoice_number"
+	InvoiceSortIssueDate         InvoiceSortEnum = "issue_date"
+	InvoiceSortIssueDateDesc     InvoiceSortEnum = "-issue_date"
+	InvoiceSortTotalAmount       InvoiceSortEnum = "total_amount"
+	InvoiceSortTotalAmountDesc   InvoiceSortEnum = "-total_amount"
+)
+
 // Defines values for InvoiceStatusEnum.
 const (
-	DRAFT          InvoiceStatusEnum = "DRAFT"
-	OVERDUE        InvoiceStatusEnum = "OVERDUE"
-	PAID           InvoiceStatusEnum = "PAID"
-	PENDINGPAYMENT InvoiceStatusEnum = "PENDING_PAYMENT"
+	InvoiceStatusEnumCANCELLED      InvoiceStatusEnum = "CANCELLED"
+	InvoiceStatusEnumDRAFT          InvoiceStatusEnum = "DRAFT"
+	InvoiceStatusEnumOVERDUE        InvoiceStatusEnum = "OVERDUE"
+	InvoiceStatusEnumPAID           InvoiceStatusEnum = "PAID"
+	InvoiceStatusEnumPARTIALLYPAID  InvoiceStatusEnum = "PARTIALLY_PAID"
+	InvoiceStatusEnumPENDINGPAYMENT InvoiceStatusEnum = "PENDING_PAYMENT"
+	InvoiceStatusEnumVOID           InvoiceStatusEnum = "VOID"
+)
+
+// Defines values for PaymentMethodEnum.
+const (
+	BankTransfer PaymentMethodEnum = "bank_transfer"
+	Card         PaymentMethodEnum = "card"
+	Cash         PaymentMethodEnum = "cash"
+	Check        PaymentMethodEnum = "check"
+	Other        PaymentMethodEnum = "other"
+	Paypal       PaymentMethodEnum = "paypal"
+)
+
+// Defines values for PaymentTypeEnum.
+const (
+	PaymentTypeEnumPayment PaymentTypeEnum = "payment"
+	PaymentTypeEnumRefund  PaymentTypeEnum = "refund"
+)
+
+// Defines values for RecurringFrequencyEnum.
+const (
+	RecurringFrequencyEnumMonthly RecurringFrequencyEnum = "monthly"
+	RecurringFrequencyEnumWeekly  RecurringFrequencyEnum = "weekly"
+	RecurringFrequencyEnumYearly  RecurringFrequencyEnum = "yearly"
+)
+
+// Defines values for RecurringStatusEnum.
+const (
+	Active    RecurringStatusEnum = "active"
+	Completed RecurringStatusEnum = "completed"
+	Paused    RecurringStatusEnum = "paused"
+)
+
+// Defines values for ResetPolicyEnum.
+const (
+	ResetPolicyEnumNever  ResetPolicyEnum = "never"
+	ResetPolicyEnumYearly ResetPolicyEnum = "yearly"
 )
 
-// Defines values for UpdateInvoiceStatus.
+// Defines values for RoleEnum.
 const (
-	Draft   UpdateInvoiceStatus = "draft"
-	Overdue UpdateInvoiceStatus = "overdue"
-	Paid    UpdateInvoiceStatus = "paid"
+	Accountant RoleEnum = "accountant"
+	Admin      RoleEnum = "admin"
+	Owner      RoleEnum = "owner"
+	Viewer     RoleEnum = "viewer"
 )
 
 // Activity defines model for Activity.
 type Activity struct {
-	CreatedAt   *time.Time          `json:"createdAt,omitempty"`
-	Description *string             `json:"description,omitempty"`
-	Id          *openapi_types.UUID `json:"id,omitempty"`
-	Type        *string             `json:"type,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	CustomerId  *openapi_types.UUID `json:"customer_id,omitempty"`
+	Description string              `json:"description"`
+	Id          openapi_types.UUID  `json:"id"`
+	InvoiceId   *openapi_types.UUID `json:"invoice_id,omitempty"`
+	Type        ActivityTypeEnum    `json:"type"`
+}
+
+// ActivityFilters defines model for ActivityFilters.
+type ActivityFilters struct {
+	CreatedAfter  *openapi_types.Date   `json:"created_after,omitempty"`
+	CreatedBefore *openapi_types.Date   `json:"created_before,omitempty"`
+	CustomerId    *[]openapi_types.UUID `json:"customer_id,omitempty"`
+	InvoiceId     *[]openapi_types.UUID `json:"invoice_id,omitempty"`
+	Type          *[]ActivityTypeEnum   `json:"type,omitempty"`
+}
+
+// ActivityTypeEnum defines model for ActivityTypeEnum.
+type ActivityTypeEnum string
+
+// Address Postal address. Line1 is required unless every field is empty, which clears the address.
+type Address struct {
+	City *string `json:"city,omitempty"`
+
+	// Country ISO 3166-1 alpha-2 country code
+	Country    *string `json:"country,omitempty"`
+	Line1      *string `json:"line1,omitempty"`
+	Line2      *string `json:"line2,omitempty"`
+	PostalCode *string `json:"postal_code,omitempty"`
+
+	// Region State, province or county
+	Region *string `json:"region,omitempty"`
+}
+
+// ApiKey defines model for ApiKey.
+type ApiKey struct {
+	CreatedAt  time.Time          `json:"created_at"`
+	ExpiresAt  *time.Time         `json:"expires_at,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+	LastUsedAt *time.Time         `json:"last_used_at,omitempty"`
+	Name       string             `json:"name"`
+
+	// Prefix Identifies the key without revealing it
+	Prefix    string        `json:"prefix"`
+	RevokedAt *time.Time    `json:"revoked_at,omitempty"`
+	Scopes    []ApiKeyScope `json:"scopes"`
+}
+
+// ApiKeyRequestBodyData defines model for ApiKeyRequestBodyData.
+type ApiKeyRequestBodyData struct {
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+	Name      string        `json:"name"`
+	Scopes    []ApiKeyScope `json:"scopes"`
+}
+
+// ApiKeyScope A permission such as `invoices:read`, or `<resource>:*` for every permission on a resource
+type ApiKeyScope = string
+
+// AuthTokens defines model for AuthTokens.
+type AuthTokens struct {
+	// AccessToken JWT to send as `Authorization: Bearer <token>`
+	AccessToken string `json:"access_token"`
+
+	// ExpiresIn Lifetime of the access token in seconds
+	ExpiresIn int `json:"expires_in"`
+
+	// RefreshToken Single-use token to obtain a new token pair
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	User         User   `json:"user"`
+}
+
+// BrandingSettings defines model for BrandingSettings.
+type BrandingSettings struct {
+	// AccentColor Colour of table headings
+	AccentColor string `json:"accent_color"`
+	Address     string `json:"address"`
+
+	// CompanyName Printed as the sender name, the account name is used when empty
+	CompanyName string `json:"company_name"`
+	Email       string `json:"email"`
+	FooterText  string `json:"footer_text"`
+
+	// Logo Base64 encoded PNG or JPEG of at most 512KB
+	Logo  *[]byte `json:"logo"`
+	Phone string  `json:"phone"`
+
+	// PrimaryColor Colour of titles and totals
+	PrimaryColor string `json:"primary_color"`
+}
+
+// Contact defines model for Contact.
+type Contact struct {
+	// Cc Copy the contact on the invoice emails sent to the customer email
+	Cc    *bool               `json:"cc,omitempty"`
+	Email openapi_types.Email `json:"email"`
+	Name  *string             `json:"name,omitempty"`
+	Phone *string             `json:"phone,omitempty"`
+}
+
+// Contacts defines model for Contacts.
+type Contacts = []Contact
+
+// ConvertEstimateRequestBodyData defines model for ConvertEstimateRequestBodyData.
+type ConvertEstimateRequestBodyData struct {
+	// DueDate Due date of the invoice, defaults to 30 days after its issue date
+	DueDate *openapi_types.Date `json:"due_date,omitempty"`
+
+	// IssueDate Issue date of the invoice, defaults to today
+	IssueDate *openapi_types.Date `json:"issue_date,omitempty"`
+}
+
+// CreatedApiKey defines model for CreatedApiKey.
+type CreatedApiKey struct {
+	ApiKey ApiKey `json:"api_key"`
+
+	// Key The secret key. It cannot be retrieved again.
+	Key string `json:"key"`
+}
+
+// CreditNote defines model for CreditNote.
+type CreditNote struct {
+	CreatedAt        time.Time          `json:"created_at"`
+	CreditNoteNumber string             `json:"credit_note_number"`
+	Currency         string             `json:"currency"`
+	CustomerId       openapi_types.UUID `json:"customer_id"`
+	Id               openapi_types.UUID `json:"id"`
+	InvoiceId        openapi_types.UUID `json:"invoice_id"`
+	IssueDate        openapi_types.Date `json:"issue_date"`
+	Items            []CreditNoteItem   `json:"items"`
+	Reason           string             `json:"reason"`
+	ShippingAmount   string             `json:"shipping_amount"`
+	Subtotal         string             `json:"subtotal"`
+	TaxAmount        string             `json:"tax_amount"`
+
+	// TotalAmount Subtotal plus tax and shipping, taken off the amount due of the invoice
+	TotalAmount string `json:"total_amount"`
+}
+
+// CreditNoteFilters defines model for CreditNoteFilters.
+type CreditNoteFilters struct {
+	CustomerId *[]openapi_types.UUID `json:"customer_id,omitempty"`
+	InvoiceId  *[]openapi_types.UUID `json:"invoice_id,omitempty"`
+}
+
+// CreditNoteItem defines model for CreditNoteItem.
+type CreditNoteItem struct {
+	Description   string             `json:"description"`
+	Id            openapi_types.UUID `json:"id"`
+	InvoiceItemId openapi_types.UUID `json:"invoice_item_id"`
+
+	// NetAmount Credited share of the line after discounts
+	NetAmount string              `json:"net_amount"`
+	Quantity  int                 `json:"quantity"`
+	TaxAmount string              `json:"tax_amount"`
+	Taxes     []CreditNoteItemTax `json:"taxes"`
+	UnitPrice string              `json:"unit_price"`
+}
+
+// CreditNoteItemTax defines model for CreditNoteItemTax.
+type CreditNoteItemTax struct {
+	Amount   string `json:"amount"`
+	Compound bool   `json:"compound"`
+	Name     string `json:"name"`
+	Rate     string `json:"rate"`
+}
+
+// CreditNoteLine defines model for CreditNoteLine.
+type CreditNoteLine struct {
+	InvoiceItemId openapi_types.UUID `json:"invoice_item_id"`
+
+	// Quantity Units of the invoice line to credit
+	Quantity int `json:"quantity"`
+}
+
+// CreditNoteRequestBodyData defines model for CreditNoteRequestBodyData.
+type CreditNoteRequestBodyData struct {
+	// IssueDate Defaults to today
+	IssueDate *openapi_types.Date `json:"issue_date,omitempty"`
+	Items     *[]CreditNoteLine   `json:"items,omitempty"`
+	Reason    string              `json:"reason"`
+
+	// ShippingAmount Shipping to credit, in the invoice currency
+	ShippingAmount *string `json:"shipping_amount,omitempty"`
 }
 
 // CustomerFilters defines model for CustomerFilters.
 type CustomerFilters struct {
-	UserId *[]string `json:"user_id,omitempty"`
+	// Search Case-insensitive search on the name, email and phone number
+	Search *string               `json:"search,omitempty"`
+	UserId *[]openapi_types.UUID `json:"user_id,omitempty"`
 }
 
 // CustomerRequestBodyData defines model for CustomerRequestBodyData.
 type CustomerRequestBodyData struct {
-	Address string             `json:"address"`
-	Email   string             `json:"email"`
-	Name    string             `json:"name"`
-	Phone   string             `json:"phone"`
-	UserId  openapi_types.UUID `json:"user_id"`
+	// BillingAddress Postal address. Line1 is required unless every field is empty, which clears the address.
+	BillingAddress *Address  `json:"billing_address,omitempty"`
+	Contacts       *Contacts `json:"contacts,omitempty"`
+
+	// Email Primary billing email, invoices are sent to it
+	Email string `json:"email"`
+	Name  string `json:"name"`
+	Phone string `json:"phone"`
+
+	// ShippingAddress Postal address. Line1 is required unless every field is empty, which clears the address.
+	ShippingAddress *Address `json:"shipping_address,omitempty"`
+
+	// TaxId VAT number or other tax ID, validated against the format of the billing address country. VAT numbers are returned with their country prefix, e.g. DE123456789. An empty string clears it.
+	TaxId *TaxId `json:"tax_id,omitempty"`
 }
 
 // CustomerResponseData defines model for CustomerResponseData.
 type CustomerResponseData struct {
-	Email string             `json:"email"`
-	Id    openapi_types.UUID `json:"id"`
-	Name  string             `json:"name"`
-	Phone string             `json:"phone"`
+	// BillingAddress Postal address. Line1 is required unless every field is empty, which clears the address.
+	BillingAddress *Address           `json:"billing_address,omitempty"`
+	Contacts       Contacts           `json:"contacts"`
+	Email          string             `json:"email"`
+	Id             openapi_types.UUID `json:"id"`
+	Name           string             `json:"name"`
+	Phone          string             `json:"phone"`
+
+	// ShippingAddress Postal address. Line1 is required unless every field is empty, which clears the address.
+	ShippingAddress *Address `json:"shipping_address,omitempty"`
+	TaxId           string   `json:"tax_id"`
+}
+
+// CustomerSortEnum Sort field, prefixed with - for descending order
+type CustomerSortEnum string
+
+// Delivery defines model for Delivery.
+type Delivery struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Error Why the delivery failed
+	Error     *string             `json:"error,omitempty"`
+	Id        openapi_types.UUID  `json:"id"`
+	InvoiceId openapi_types.UUID  `json:"invoice_id"`
+	Recipient openapi_types.Email `json:"recipient"`
+	SentAt    *time.Time          `json:"sent_at,omitempty"`
+	Status    DeliveryStatusEnum  `json:"status"`
+	Subject   string              `json:"subject"`
+}
+
+// DeliveryStatusEnum defines model for DeliveryStatusEnum.
+type DeliveryStatusEnum string
+
+// Discount defines model for Discount.
+type Discount struct {
+	Type DiscountTypeEnum `json:"type"`
+
+	// Value Percentage between 0 and 100, or a fixed amount in the invoice currency
+	Value string `json:"value"`
 }
 
+// DiscountTypeEnum defines model for DiscountTypeEnum.
+type DiscountTypeEnum string
+
 // Error defines model for Error.
 type Error struct {
 	Code   string                  `json:"code"`
@@ -79,56 +421,577 @@ type ErrorResponse struct {
 	Errors []Error `json:"errors"`
 }
 
-// InvoiceFilters defines model for InvoiceFilters.
+// Estimate defines model for Estimate.
+type Estimate struct {
+	CreatedAt      time.Time          `json:"created_at"`
+	Currency       string             `json:"currency"`
+	CustomerId     openapi_types.UUID `json:"customer_id"`
+	Discount       *Discount          `json:"discount,omitempty"`
+	DiscountAmount string             `json:"discount_amount"`
+	EstimateNumber string             `json:"estimate_number"`
+	ExpiryDate     openapi_types.Date `json:"expiry_date"`
+	Id             openapi_types.UUID `json:"id"`
+
+	// InvoiceId Invoice the estimate was converted into
+	InvoiceId *openapi_types.UUID `json:"invoice_id"`
+	IssueDate openapi_types.Date  `json:"issue_date"`
+	Items     []Item              `json:"items"`
+	Notes     string              `json:"notes"`
+
+	// RespondedAt When the customer accepted or declined the estimate
+	RespondedAt    *time.Time         `json:"responded_at"`
+	ShippingAmount string             `json:"shipping_amount"`
+	Status         EstimateStatusEnum `json:"status"`
+	Subtotal       string             `json:"subtotal"`
+	TaxAmount      string             `json:"tax_amount"`
+	TotalAmount    string             `json:"total_amount"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+}
+
+// EstimateFilters defines model for EstimateFilters.
+type EstimateFilters struct {
+	CustomerId *[]openapi_types.UUID `json:"customer_id,omitempty"`
+	Status     *[]EstimateStatusEnum `json:"status,omitempty"`
+}
+
+// EstimateRequestBodyData defines model for EstimateRequestBodyData.
+type EstimateRequestBodyData struct {
+	// Currency ISO 4217 currency code; defaults to USD
+	Currency   *string            `json:"currency,omitempty"`
+	CustomerId openapi_types.UUID `json:"customer_id"`
+	Discount   *Discount          `json:"discount,omitempty"`
+
+	// ExpiryDate Last day the customer may accept the estimate
+	ExpiryDate openapi_types.Date `json:"expiry_date"`
+
+	// IssueDate Defaults to today
+	IssueDate *openapi_types.Date `json:"issue_date,omitempty"`
+	Items     []Item              `json:"items"`
+	Notes     *string             `json:"notes,omitempty"`
+
+	// ShippingAmount Untaxed shipping charge added to the total
+	ShippingAmount *string `json:"shipping_amount,omitempty"`
+}
+
+// EstimateStatusEnum defines model for EstimateStatusEnum.
+type EstimateStatusEnum string
+
+// InvoiceFilters All filters are optional and combined with AND. Date bounds are inclusive unless noted.
 type InvoiceFilters struct {
-	CustomerId    *[]string            `json:"customer_id,omitempty"`
-	Id            *[]string            `json:"id,omitempty"`
-	InvoiceNumber *[]string            `json:"invoice_number,omitempty"`
-	Status        *[]InvoiceStatusEnum `json:"status,omitempty"`
-	UserId        *[]string            `json:"user_id,omitempty"`
+	// CreatedAfter Created after this day, excluded
+	CreatedAfter *openapi_types.Date `json:"created_after,omitempty"`
+
+	// CreatedBefore Created before this day, excluded
+	CreatedBefore *openapi_types.Date   `json:"created_before,omitempty"`
+	CustomerId    *[]openapi_types.UUID `json:"customer_id,omitempty"`
+	DueDateFrom   *openapi_types.Date   `json:"due_date_from,omitempty"`
+	DueDateTo     *openapi_types.Date   `json:"due_date_to,omitempty"`
+	Id            *[]openapi_types.UUID `json:"id,omitempty"`
+	InvoiceNumber *[]string             `json:"invoice_number,omitempty"`
+	IssueDateFrom *openapi_types.Date   `json:"issue_date_from,omitempty"`
+	IssueDateTo   *openapi_types.Date   `json:"issue_date_to,omitempty"`
+
+	// MaxTotal Maximum total amount, in the invoice currency
+	MaxTotal *string `json:"max_total,omitempty"`
+
+	// MinTotal Minimum total amount, in the invoice currency
+	MinTotal *string `json:"min_total,omitempty"`
+
+	// Overdue Only invoices that are overdue, or still open after their due date
+	Overdue            *bool                 `json:"overdue,omitempty"`
+	RecurringInvoiceId *[]openapi_types.UUID `json:"recurring_invoice_id,omitempty"`
+
+	// Search Case-insensitive search on the invoice number, the customer name and the item descriptions
+	Search *string               `json:"search,omitempty"`
+	Status *[]InvoiceStatusEnum  `json:"status,omitempty"`
+	UserId *[]openapi_types.UUID `json:"user_id,omitempty"`
 }
 
 // InvoiceRequestBodyData defines model for InvoiceRequestBodyData.
 type InvoiceRequestBodyData struct {
-	CustomerId *openapi_types.UUID `json:"customer_id,omitempty"`
-	DueDate    *openapi_types.Date `json:"due_date,omitempty"`
+	// Currency ISO 4217 currency code; defaults to USD
+	Currency   *string             `json:"currency,omitempty"`
+	CustomerId openapi_types.UUID  `json:"customer_id"`
+	Discount   *Discount           `json:"discount,omitempty"`
+	DueDate    openapi_types.Date  `json:"due_date"`
 	IssueDate  *openapi_types.Date `json:"issue_date,omitempty"`
 	Items      []Item              `json:"items"`
-	UserId     *openapi_types.UUID `json:"user_id,omitempty"`
+
+	// ShippingAmount Untaxed shipping charge added to the total
+	ShippingAmount *string `json:"shipping_amount,omitempty"`
 }
 
 // InvoiceResponseData defines model for InvoiceResponseData.
 type InvoiceResponseData struct {
-	Customer    string             `json:"customer"`
-	DueDate     openapi_types.Date `json:"due_date"`
-	Id          openapi_types.UUID `json:"id"`
-	Items       []Item             `json:"items"`
-	Sender      string             `json:"sender"`
-	Status      InvoiceStatusEnum  `json:"status"`
-	TotalAmount *float32           `json:"total_amount,omitempty"`
+	// AmountCredited Sum of the credit notes issued against the invoice
+	AmountCredited *string `json:"amount_credited,omitempty"`
+
+	// AmountDue Total amount minus amount paid and amount credited, negative when a refund is owed
+	AmountDue *string `json:"amount_due,omitempty"`
+
+	// AmountPaid Payments received minus refunds
+	AmountPaid *string `json:"amount_paid,omitempty"`
+
+	// Currency ISO 4217 currency code
+	Currency *string   `json:"currency,omitempty"`
+	Customer string    `json:"customer"`
+	Discount *Discount `json:"discount,omitempty"`
+
+	// DiscountAmount Item discounts plus the invoice discount
+	DiscountAmount *string            `json:"discount_amount,omitempty"`
+	DueDate        openapi_types.Date `json:"due_date"`
+
+	// EstimateId Estimate the invoice was converted from
+	EstimateId    *openapi_types.UUID `json:"estimate_id"`
+	Id            openapi_types.UUID  `json:"id"`
+	InvoiceNumber *string             `json:"invoice_number,omitempty"`
+	IssueDate     *openapi_types.Date `json:"issue_date,omitempty"`
+	Items         []Item              `json:"items"`
+
+	// RecurringInvoiceId Recurring invoice the invoice was generated from
+	RecurringInvoiceId *openapi_types.UUID `json:"recurring_invoice_id"`
+	Sender             string              `json:"sender"`
+	ShippingAmount     *string             `json:"shipping_amount,omitempty"`
+	Status             InvoiceStatusEnum   `json:"status"`
+
+	// Subtotal Sum of quantity multiplied by unit price over all items
+	Subtotal  *string `json:"subtotal,omitempty"`
+	TaxAmount *string `json:"tax_amount,omitempty"`
+
+	// TotalAmount Subtotal minus discount amount plus tax and shipping, rounded to the currency's minor units
+	TotalAmount *string `json:"total_amount,omitempty"`
 }
 
+// InvoiceSortEnum Sort field, prefixed with - for descending order. Cursors are only returned for -created_at.
+type InvoiceSortEnum string
+
 // InvoiceStatusEnum defines model for InvoiceStatusEnum.
 type InvoiceStatusEnum string
 
 // Item defines model for Item.
 type Item struct {
-	Description *string             `json:"description,omitempty"`
-	Id          *openapi_types.UUID `json:"id,omitempty"`
-	InvoiceId   *openapi_types.UUID `json:"invoice_id,omitempty"`
-	Quantity    *int                `json:"quantity,omitempty"`
-	TotalPrice  *float32            `json:"total_price,omitempty"`
-	UnitPrice   *float32            `json:"unit_price,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	Discount       *Discount           `json:"discount,omitempty"`
+	DiscountAmount *string             `json:"discount_amount,omitempty"`
+	Id             *openapi_types.UUID `json:"id,omitempty"`
+	InvoiceId      *openapi_types.UUID `json:"invoice_id,omitempty"`
+
+	// ProductId Product of the catalogue the item is filled from. Its description, unit price and tax rate are used unless the item sets them.
+	ProductId *openapi_types.UUID `json:"product_id"`
+	Quantity  *int                `json:"quantity,omitempty"`
+	TaxAmount *string             `json:"tax_amount,omitempty"`
+
+	// TaxRateIds Tax rates from the catalogue to apply to the item, in order
+	TaxRateIds *[]openapi_types.UUID `json:"tax_rate_ids,omitempty"`
+	Taxes      *[]ItemTax            `json:"taxes,omitempty"`
+
+	// TotalPrice Quantity multiplied by unit price, rounded to the invoice currency
+	TotalPrice *string `json:"total_price,omitempty"`
+
+	// UnitPrice Non-negative decimal amount with up to 4 decimal places
+	UnitPrice *string `json:"unit_price,omitempty"`
+}
+
+// ItemTax defines model for ItemTax.
+type ItemTax struct {
+	Amount    string              `json:"amount"`
+	Compound  bool                `json:"compound"`
+	Name      string              `json:"name"`
+	Rate      string              `json:"rate"`
+	TaxRateId *openapi_types.UUID `json:"tax_rate_id,omitempty"`
+}
+
+// LoginRequestBodyData defines model for LoginRequestBodyData.
+type LoginRequestBodyData struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
+
+// NumberingSettingsRequestBodyData defines model for NumberingSettingsRequestBodyData.
+type NumberingSettingsRequestBodyData struct {
+	Prefix      string          `json:"prefix"`
+	ResetPolicy ResetPolicyEnum `json:"reset_policy"`
+
+	// Template Supports {PREFIX}, {YYYY}, {YY}, {MM} and exactly one {SEQ} or {SEQ:width} placeholder
+	Template string `json:"template"`
+}
+
+// NumberingSettingsResponseData defines model for NumberingSettingsResponseData.
+type NumberingSettingsResponseData struct {
+	// NextNumber The number the next document will receive
+	NextNumber  string          `json:"next_number"`
+	Prefix      string          `json:"prefix"`
+	ResetPolicy ResetPolicyEnum `json:"reset_policy"`
+	Template    string          `json:"template"`
+}
+
+// PaginationMeta Page, page_count and total_count are returned when paginating by page number. Listings that support cursors return next_cursor while more items follow.
+type PaginationMeta struct {
+	// NextCursor Opaque cursor of the next page, pass it as the cursor parameter
+	NextCursor *string `json:"next_cursor,omitempty"`
+	Page       *int    `json:"page,omitempty"`
+
+	// PageCount Number of pages of page_size items
+	PageCount *int `json:"page_count,omitempty"`
+	PageSize  int  `json:"page_size"`
+
+	// TotalCount Number of items matching the filters, across all pages
+	TotalCount *int `json:"total_count,omitempty"`
+}
+
+// Payment defines model for Payment.
+type Payment struct {
+	Amount    string             `json:"amount"`
+	CreatedAt time.Time          `json:"created_at"`
+	Currency  string             `json:"currency"`
+	Id        openapi_types.UUID `json:"id"`
+	InvoiceId openapi_types.UUID `json:"invoice_id"`
+	Method    PaymentMethodEnum  `json:"method"`
+	PaidAt    time.Time          `json:"paid_at"`
+	Reference *string            `json:"reference,omitempty"`
+	Type      PaymentTypeEnum    `json:"type"`
+}
+
+// PaymentMethodEnum defines model for PaymentMethodEnum.
+type PaymentMethodEnum string
+
+// PaymentRequestBodyData defines model for PaymentRequestBodyData.
+type PaymentRequestBodyData struct {
+	// Amount Positive amount in the invoice currency
+	Amount string            `json:"amount"`
+	Method PaymentMethodEnum `json:"method"`
+
+	// PaidAt When the money was received; defaults to now
+	PaidAt    *time.Time       `json:"paid_at,omitempty"`
+	Reference *string          `json:"reference,omitempty"`
+	Type      *PaymentTypeEnum `json:"type,omitempty"`
+}
+
+// PaymentTypeEnum defines model for PaymentTypeEnum.
+type PaymentTypeEnum string
+
+// Product defines model for Product.
+type Product struct {
+	Active      bool                `json:"active"`
+	CreatedAt   time.Time           `json:"created_at"`
+	Description string              `json:"description"`
+	Id          openapi_types.UUID  `json:"id"`
+	Name        string              `json:"name"`
+	Sku         *string             `json:"sku"`
+	TaxRateId   *openapi_types.UUID `json:"tax_rate_id"`
+	Unit        string              `json:"unit"`
+	UnitPrice   string              `json:"unit_price"`
+}
+
+// ProductFilters defines model for ProductFilters.
+type ProductFilters struct {
+	Active *bool `json:"active,omitempty"`
+
+	// Search Case-insensitive search on the SKU, name and description
+	Search *string               `json:"search,omitempty"`
+	UserId *[]openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// ProductRequestBodyData defines model for ProductRequestBodyData.
+type ProductRequestBodyData struct {
+	// Active Inactive products cannot be added to new lines
+	Active *bool `json:"active,omitempty"`
+
+	// Description Description of the lines filled from the product, defaults to its name
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+
+	// Sku Stock keeping unit, unique among your products
+	Sku *string `json:"sku,omitempty"`
+
+	// TaxRateId Tax rate from the catalogue applied by default to the lines of the product
+	TaxRateId *openapi_types.UUID `json:"tax_rate_id,omitempty"`
+
+	// Unit Unit of measure
+	Unit *string `json:"unit,omitempty"`
+
+	// UnitPrice Non-negative decimal amount with up to 4 decimal places
+	UnitPrice string `json:"unit_price"`
+}
+
+// ProductSales defines model for ProductSales.
+type ProductSales struct {
+	Currency     string `json:"currency"`
+	InvoiceCount int64  `json:"invoice_count"`
+
+	// NetAmount Line totals after line discounts, before taxes and invoice discounts
+	NetAmount string `json:"net_amount"`
+	Quantity  int64  `json:"quantity"`
+}
+
+// ProductSalesFilters defines model for ProductSalesFilters.
+type ProductSalesFilters struct {
+	IssueDateFrom *openapi_types.Date `json:"issue_date_from,omitempty"`
+	IssueDateTo   *openapi_types.Date `json:"issue_date_to,omitempty"`
+}
+
+// RecurringFrequencyEnum defines model for RecurringFrequencyEnum.
+type RecurringFrequencyEnum string
+
+// RecurringInvoice defines model for RecurringInvoice.
+type RecurringInvoice struct {
+	AutoSend   bool               `json:"auto_send"`
+	CreatedAt  time.Time          `json:"created_at"`
+	Currency   string             `json:"currency"`
+	CustomerId openapi_types.UUID `json:"customer_id"`
+	Discount   *Discount          `json:"discount,omitempty"`
+
+	// GeneratedCount Number of invoices generated so far
+	GeneratedCount int                `json:"generated_count"`
+	Id             openapi_types.UUID `json:"id"`
+	Items          []RecurringItem    `json:"items"`
+
+	// NextRunOn Issue date of the next invoice, absent once the schedule is completed
+	NextRunOn        *openapi_types.Date `json:"next_run_on"`
+	PaymentTermsDays int                 `json:"payment_terms_days"`
+	Schedule         RecurringSchedule   `json:"schedule"`
+	ShippingAmount   string              `json:"shipping_amount"`
+	Status           RecurringStatusEnum `json:"status"`
+	UpdatedAt        time.Time           `json:"updated_at"`
+}
+
+// RecurringInvoiceFilters defines model for RecurringInvoiceFilters.
+type RecurringInvoiceFilters struct {
+	CustomerId *[]openapi_types.UUID  `json:"customer_id,omitempty"`
+	Status     *[]RecurringStatusEnum `json:"status,omitempty"`
+}
+
+// RecurringInvoiceRequestBodyData defines model for RecurringInvoiceRequestBodyData.
+type RecurringInvoiceRequestBodyData struct {
+	// AutoSend Email generated invoices to the customer and issue them right away
+	AutoSend *bool `json:"auto_send,omitempty"`
+
+	// Currency ISO 4217 currency code; defaults to USD
+	Currency   *string            `json:"currency,omitempty"`
+	CustomerId openapi_types.UUID `json:"customer_id"`
+	Discount   *Discount          `json:"discount,omitempty"`
+	Items      []RecurringItem    `json:"items"`
+
+	// PaymentTermsDays Days between the issue date and the due date of generated invoices
+	PaymentTermsDays *int              `json:"payment_terms_days,omitempty"`
+	Schedule         RecurringSchedule `json:"schedule"`
+
+	// ShippingAmount Untaxed shipping charge added to the total
+	ShippingAmount *string `json:"shipping_amount,omitempty"`
+}
+
+// RecurringItem defines model for RecurringItem.
+type RecurringItem struct {
+	Description string    `json:"description"`
+	Discount    *Discount `json:"discount,omitempty"`
+	Quantity    int       `json:"quantity"`
+
+	// TaxRateIds Tax rates from the catalogue to apply to the item, resolved when each invoice is generated
+	TaxRateIds *[]openapi_types.UUID `json:"tax_rate_ids,omitempty"`
+
+	// UnitPrice Non-negative decimal amount with up to 4 decimal places
+	UnitPrice string `json:"unit_price"`
+}
+
+// RecurringRun defines model for RecurringRun.
+type RecurringRun struct {
+	DueDate   openapi_types.Date `json:"due_date"`
+	IssueDate openapi_types.Date `json:"issue_date"`
+}
+
+// RecurringSchedule defines model for RecurringSchedule.
+type RecurringSchedule struct {
+	// Count Number of invoices to generate before the schedule completes
+	Count *int `json:"count,omitempty"`
+
+	// DayOfMonth Day of the month monthly and yearly invoices are issued on, moved back to the last day of shorter months. Defaults to the day of the start date.
+	DayOfMonth *int `json:"day_of_month,omitempty"`
+
+	// EndDate Last day an invoice may be issued on
+	EndDate   *openapi_types.Date    `json:"end_date,omitempty"`
+	Frequency RecurringFrequencyEnum `json:"frequency"`
+
+	// Interval Number of weeks, months or years between two invoices
+	Interval *int `json:"interval,omitempty"`
+
+	// StartDate Issue date of the first invoice
+	StartDate openapi_types.Date `json:"start_date"`
+}
+
+// RecurringStatusEnum defines model for RecurringStatusEnum.
+type RecurringStatusEnum string
+
+// RefreshTokenRequestBodyData defines model for RefreshTokenRequestBodyData.
+type RefreshTokenRequestBodyData struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RegisterRequestBodyData defines model for RegisterRequestBodyData.
+type RegisterRequestBodyData struct {
+	Email    openapi_types.Email `json:"email"`
+	Name     string              `json:"name"`
+	Password string              `json:"password"`
+}
+
+// ReminderRule defines model for ReminderRule.
+type ReminderRule struct {
+	// OffsetDays Days between the due date and the first reminder, negative before it
+	OffsetDays int `json:"offset_days"`
+
+	// RepeatEveryDays Days between the following reminders, a single reminder is sent when absent
+	RepeatEveryDays *int `json:"repeat_every_days"`
+}
+
+// ReminderSettings defines model for ReminderSettings.
+type ReminderSettings struct {
+	Rules []ReminderRule `json:"rules"`
+}
+
+// ResetPolicyEnum defines model for ResetPolicyEnum.
+type ResetPolicyEnum string
+
+// RoleEnum What the user may do. Viewers only read, accountants also manage customers, invoices, payments, products
+// and tax rates, and owners and admins may also change the settings and delete records. Registered users are
+// owners; other roles are assigned by an operator.
+type RoleEnum string
+
+// SendInvoiceRequestBodyData defines model for SendInvoiceRequestBodyData.
+type SendInvoiceRequestBodyData struct {
+	// Message Personal note included in the email
+	Message *string `json:"message,omitempty"`
+
+	// Recipient Send to this address instead of the customer email
+	Recipient *openapi_types.Email `json:"recipient,omitempty"`
+}
+
+// SentEstimate defines model for SentEstimate.
+type SentEstimate struct {
+	Estimate Estimate `json:"estimate"`
+
+	// PublicToken Token of the customer link, see the public estimate operations
+	PublicToken string `json:"public_token"`
+}
+
+// TaxId VAT number or other tax ID, validated against the format of the billing address country. VAT numbers are returned with their country prefix, e.g. DE123456789. An empty string clears it.
+type TaxId = string
+
+// TaxRate defines model for TaxRate.
+type TaxRate struct {
+	Compound  bool               `json:"compound"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+	Rate      string             `json:"rate"`
+}
+
+// TaxRateFilters defines model for TaxRateFilters.
+type TaxRateFilters struct {
+	UserId *[]openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// TaxRateRequestBodyData defines model for TaxRateRequestBodyData.
+type TaxRateRequestBodyData struct {
+	// Compound Compound taxes are charged on the item amount plus its non-compound taxes
+	Compound *bool  `json:"compound,omitempty"`
+	Name     string `json:"name"`
+
+	// Rate Percentage between 0 and 100
+	Rate string `json:"rate"`
+}
+
+// UpdateCustomer defines model for UpdateCustomer.
+type UpdateCustomer struct {
+	// BillingAddress Postal address. Line1 is required unless every field is empty, which clears the address.
+	BillingAddress *Address             `json:"billing_address,omitempty"`
+	Contacts       *Contacts            `json:"contacts,omitempty"`
+	Email          *openapi_types.Email `json:"email,omitempty"`
+	Name           *string              `json:"name,omitempty"`
+	Phone          *string              `json:"phone,omitempty"`
+
+	// ShippingAddress Postal address. Line1 is required unless every field is empty, which clears the address.
+	ShippingAddress *Address `json:"shipping_address,omitempty"`
+
+	// TaxId VAT number or other tax ID, validated against the format of the billing address country. VAT numbers are returned with their country prefix, e.g. DE123456789. An empty string clears it.
+	TaxId *TaxId `json:"tax_id,omitempty"`
 }
 
 // UpdateInvoice defines model for UpdateInvoice.
 type UpdateInvoice struct {
-	DueDate *openapi_types.Date  `json:"dueDate,omitempty"`
-	Status  *UpdateInvoiceStatus `json:"status,omitempty"`
+	DueDate *openapi_types.Date `json:"due_date,omitempty"`
+	Status  *InvoiceStatusEnum  `json:"status,omitempty"`
+}
+
+// UpdateProduct defines model for UpdateProduct.
+type UpdateProduct struct {
+	Active      *bool   `json:"active,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+
+	// Sku An empty string clears it.
+	Sku *string `json:"sku,omitempty"`
+
+	// TaxRateId ID of a tax rate from the catalogue. An empty string clears it.
+	TaxRateId *string `json:"tax_rate_id,omitempty"`
+	Unit      *string `json:"unit,omitempty"`
+	UnitPrice *string `json:"unit_price,omitempty"`
+}
+
+// UpdateTaxRate defines model for UpdateTaxRate.
+type UpdateTaxRate struct {
+	Compound *bool   `json:"compound,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Rate     *string `json:"rate,omitempty"`
+}
+
+// User defines model for User.
+type User struct {
+	Email openapi_types.Email `json:"email"`
+	Id    openapi_types.UUID  `json:"id"`
+	Name  string              `json:"name"`
+
+	// Role What the user may do. Viewers only read, accountants also manage customers, invoices, payments, products
+	// and tax rates, and owners and admins may also change the settings and delete records. Registered users are
+	// owners; other roles are assigned by an operator.
+	Role RoleEnum `json:"role"`
+}
+
+// ActivitiesResponse defines model for ActivitiesResponse.
+type ActivitiesResponse struct {
+	Data []Activity `json:"data"`
+}
+
+// ApiKeysResponse defines model for ApiKeysResponse.
+type ApiKeysResponse struct {
+	Data []ApiKey `json:"data"`
+}
+
+// AuthTokensResponse defines model for AuthTokensResponse.
+type AuthTokensResponse struct {
+	Data AuthTokens `json:"data"`
+}
+
+// BrandingSettingsResponse defines model for BrandingSettingsResponse.
+type BrandingSettingsResponse struct {
+	Data BrandingSettings `json:"data"`
 }
 
-// UpdateInvoiceStatus defines model for UpdateInvoice.Status.
-type UpdateInvoiceStatus string
+// CreatedApiKeyResponse defines model for CreatedApiKeyResponse.
+type CreatedApiKeyResponse struct {
+	Data CreatedApiKey `json:"data"`
+}
+
+// CreditNoteResponse defines model for CreditNoteResponse.
+type CreditNoteResponse struct {
+	Data CreditNote `json:"data"`
+}
+
+// CreditNotesResponse defines model for CreditNotesResponse.
+type CreditNotesResponse struct {
+	Data []CreditNote `json:"data"`
+
+	// Meta Page, page_count and total_count are returned when paginating by page number. Listings that support cursors return next_cursor while more items follow.
+	Meta PaginationMeta `json:"meta"`
+}
 
 // CustomerResponse defines model for CustomerResponse.
 type CustomerResponse struct {
@@ -138,6 +1001,29 @@ type CustomerResponse struct {
 // CustomersResponse defines model for CustomersResponse.
 type CustomersResponse struct {
 	Data []CustomerResponseData `json:"data"`
+
+	// Meta Page, page_count and total_count are returned when paginating by page number. Listings that support cursors return next_cursor while more items follow.
+	Meta PaginationMeta `json:"meta"`
+}
+
+// DeliveriesResponse defines model for DeliveriesResponse.
+type DeliveriesResponse struct {
+	Data []Delivery `json:"data"`
+}
+
+// EstimateResponse defines model for EstimateResponse.
+type EstimateResponse struct {
+	Data Estimate `json:"data"`
+}
+
+// EstimatesResponse defines model for EstimatesResponse.
+type EstimatesResponse struct {
+	Data []Estimate `json:"data"`
+}
+
+// InvoiceCreditNotesResponse defines model for InvoiceCreditNotesResponse.
+type InvoiceCreditNotesResponse struct {
+	Data []CreditNote `json:"data"`
 }
 
 // InvoiceResponse defines model for InvoiceResponse.
@@ -148,69 +1034,575 @@ type InvoiceResponse struct {
 // InvoicesResponse defines model for InvoicesResponse.
 type InvoicesResponse struct {
 	Data []InvoiceResponseData `json:"data"`
+
+	// Meta Page, page_count and total_count are returned when paginating by page number. Listings that support cursors return next_cursor while more items follow.
+	Meta PaginationMeta `json:"meta"`
 }
 
-// CreateCustomerRequestBody defines model for CreateCustomerRequestBody.
-type CreateCustomerRequestBody struct {
-	Data CustomerRequestBodyData `json:"data"`
+// NumberingSettingsResponse defines model for NumberingSettingsResponse.
+type NumberingSettingsResponse struct {
+	Data NumberingSettingsResponseData `json:"data"`
 }
 
-// CreateInvoiceRequestBody defines model for CreateInvoiceRequestBody.
-type CreateInvoiceRequestBody struct {
-	Data InvoiceRequestBodyData `json:"data"`
+// PaymentResponse defines model for PaymentResponse.
+type PaymentResponse struct {
+	Data Payment `json:"data"`
 }
 
-// V1GetCustomersParams defines parameters for V1GetCustomers.
-type V1GetCustomersParams struct {
-	Data *struct {
-		Filters *CustomerFilters `json:"filters,omitempty"`
-	} `json:"data,omitempty"`
+// PaymentsResponse defines model for PaymentsResponse.
+type PaymentsResponse struct {
+	Data []Payment `json:"data"`
 }
 
-// V1CreateCustomerJSONBody defines parameters for V1CreateCustomer.
-type V1CreateCustomerJSONBody struct {
-	Data CustomerRequestBodyData `json:"data"`
+// ProductResponse defines model for ProductResponse.
+type ProductResponse struct {
+	Data Product `json:"data"`
 }
 
-// V1GetInvoicesParams defines parameters for V1GetInvoices.
-type V1GetInvoicesParams struct {
-	// Data Filter invoices by status (paid, overdue, draft, etc.)
-	Data *struct {
-		Filters *InvoiceFilters `json:"filters,omitempty"`
+// ProductSalesResponse defines model for ProductSalesResponse.
+type ProductSalesResponse struct {
+	Data []ProductSales `json:"data"`
+}
 
-		// Page The page number
-		Page *int `json:"page,omitempty"`
+// ProductsResponse defines model for ProductsResponse.
+type ProductsResponse struct {
+	Data []Product `json:"data"`
+}
 
-		// PageSize The page size
-		PageSize *int `json:"page_size,omitempty"`
-	} `json:"data,omitempty"`
+// RecurringInvoicePreviewResponse defines model for RecurringInvoicePreviewResponse.
+type RecurringInvoicePreviewResponse struct {
+	Data []RecurringRun `json:"data"`
 }
 
-// V1CreateInvoiceJSONBody defines parameters for V1CreateInvoice.
-type V1CreateInvoiceJSONBody struct {
-	Data InvoiceRequestBodyData `json:"data"`
+// RecurringInvoiceResponse defines model for RecurringInvoiceResponse.
+type RecurringInvoiceResponse struct {
+	Data RecurringInvoice `json:"data"`
 }
 
-// V1CreateCustomerJSONRequestBody defines body for V1CreateCustomer for application/json ContentType.
-type V1CreateCustomerJSONRequestBody V1CreateCustomerJSONBody
+// RecurringInvoicesResponse defines model for RecurringInvoicesResponse.
+type RecurringInvoicesResponse struct {
+	Data []RecurringInvoice `json:"data"`
+}
 
-// V1CreateInvoiceJSONRequestBody defines body for V1CreateInvoice for application/json ContentType.
+// ReminderSettingsResponse defines model for ReminderSettingsResponse.
+type ReminderSettingsResponse struct {
+	Data ReminderSettings `json:"data"`
+}
+
+// SentEstimateResponse defines model for SentEstimateResponse.
+type SentEstimateResponse struct {
+	Data SentEstimate `json:"data"`
+}
+
+// TaxRateResponse defines model for TaxRateResponse.
+type TaxRateResponse struct {
+	Data TaxRate `json:"data"`
+}
+
+// TaxRatesResponse defines model for TaxRatesResponse.
+type TaxRatesResponse struct {
+	Data []TaxRate `json:"data"`
+}
+
+// ConvertEstimateRequestBody defines model for ConvertEstimateRequestBody.
+type ConvertEstimateRequestBody struct {
+	Data *ConvertEstimateRequestBodyData `json:"data,omitempty"`
+}
+
+// CreateApiKeyRequestBody defines model for CreateApiKeyRequestBody.
+type CreateApiKeyRequestBody struct {
+	Data ApiKeyRequestBodyData `json:"data"`
+}
+
+// CreateCreditNoteRequestBody defines model for CreateCreditNoteRequestBody.
+type CreateCreditNoteRequestBody struct {
+	Data CreditNoteRequestBodyData `json:"data"`
+}
+
+// CreateCustomerRequestBody defines model for CreateCustomerRequestBody.
+type CreateCustomerRequestBody struct {
+	Data CustomerRequestBodyData `json:"data"`
+}
+
+// CreateInvoiceRequestBody defines model for CreateInvoiceRequestBody.
+type CreateInvoiceRequestBody struct {
+	Data InvoiceRequestBodyData `json:"data"`
+}
+
+// CreatePaymentRequestBody defines model for CreatePaymentRequestBody.
+type CreatePaymentRequestBody struct {
+	Data PaymentRequestBodyData `json:"data"`
+}
+
+// CreateProductRequestBody defines model for CreateProductRequestBody.
+type CreateProductRequestBody struct {
+	Data ProductRequestBodyData `json:"data"`
+}
+
+// CreateTaxRateRequestBody defines model for CreateTaxRateRequestBody.
+type CreateTaxRateRequestBody struct {
+	Data TaxRateRequestBodyData `json:"data"`
+}
+
+// EstimateRequestBody defines model for EstimateRequestBody.
+type EstimateRequestBody struct {
+	Data EstimateRequestBodyData `json:"data"`
+}
+
+// LoginRequestBody defines model for LoginRequestBody.
+type LoginRequestBody struct {
+	Data LoginRequestBodyData `json:"data"`
+}
+
+// RecurringInvoiceRequestBody defines model for RecurringInvoiceRequestBody.
+type RecurringInvoiceRequestBody struct {
+	Data RecurringInvoiceRequestBodyData `json:"data"`
+}
+
+// RefreshTokenRequestBody defines model for RefreshTokenRequestBody.
+type RefreshTokenRequestBody struct {
+	Data RefreshTokenRequestBodyData `json:"data"`
+}
+
+// RegisterRequestBody defines model for RegisterRequestBody.
+type RegisterRequestBody struct {
+	Data RegisterRequestBodyData `json:"data"`
+}
+
+// SendInvoiceRequestBody defines model for SendInvoiceRequestBody.
+type SendInvoiceRequestBody struct {
+	Data *SendInvoiceRequestBodyData `json:"data,omitempty"`
+}
+
+// UpdateBrandingSettingsRequestBody defines model for UpdateBrandingSettingsRequestBody.
+type UpdateBrandingSettingsRequestBody struct {
+	Data BrandingSettings `json:"data"`
+}
+
+// UpdateCustomerRequestBody defines model for UpdateCustomerRequestBody.
+type UpdateCustomerRequestBody struct {
+	Data UpdateCustomer `json:"data"`
+}
+
+// UpdateInvoiceRequestBody defines model for UpdateInvoiceRequestBody.
+type UpdateInvoiceRequestBody struct {
+	Data UpdateInvoice `json:"data"`
+}
+
+// UpdateNumberingSettingsRequestBody defines model for UpdateNumberingSettingsRequestBody.
+type UpdateNumberingSettingsRequestBody struct {
+	Data NumberingSettingsRequestBodyData `json:"data"`
+}
+
+// UpdateProductRequestBody defines model for UpdateProductRequestBody.
+type UpdateProductRequestBody struct {
+	Data UpdateProduct `json:"data"`
+}
+
+// UpdateReminderSettingsRequestBody defines model for UpdateReminderSettingsRequestBody.
+type UpdateReminderSettingsRequestBody struct {
+	Data ReminderSettings `json:"data"`
+}
+
+// UpdateTaxRateRequestBody defines model for UpdateTaxRateRequestBody.
+type UpdateTaxRateRequestBody struct {
+	Data UpdateTaxRate `json:"data"`
+}
+
+// V1GetActivitiesParams defines parameters for V1GetActivities.
+type V1GetActivitiesParams struct {
+	Data *struct {
+		Filters *ActivityFilters `json:"filters,omitempty"`
+
+		// Page The page number
+		Page *int `json:"page,omitempty"`
+
+		// PageSize The page size
+		PageSize *int `json:"page_size,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+// V1CreateApiKeyJSONBody defines parameters for V1CreateApiKey.
+type V1CreateApiKeyJSONBody struct {
+	Data ApiKeyRequestBodyData `json:"data"`
+}
+
+// V1LoginJSONBody defines parameters for V1Login.
+type V1LoginJSONBody struct {
+	Data LoginRequestBodyData `json:"data"`
+}
+
+// V1LogoutJSONBody defines parameters for V1Logout.
+type V1LogoutJSONBody struct {
+	Data RefreshTokenRequestBodyData `json:"data"`
+}
+
+// V1RefreshTokenJSONBody defines parameters for V1RefreshToken.
+type V1RefreshTokenJSONBody struct {
+	Data RefreshTokenRequestBodyData `json:"data"`
+}
+
+// V1RegisterJSONBody defines parameters for V1Register.
+type V1RegisterJSONBody struct {
+	Data RegisterRequestBodyData `json:"data"`
+}
+
+// V1GetCreditNotesParams defines parameters for V1GetCreditNotes.
+type V1GetCreditNotesParams struct {
+	Data *struct {
+		Filters *CreditNoteFilters `json:"filters,omitempty"`
+
+		// Page The page number
+		Page *int `json:"page,omitempty"`
+
+		// PageSize The page size
+		PageSize *int `json:"page_size,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+// V1GetCustomersParams defines parameters for V1GetCustomers.
+type V1GetCustomersParams struct {
+	Data *struct {
+		Filters *CustomerFilters `json:"filters,omitempty"`
+
+		// Page The page number
+		Page *int `json:"page,omitempty"`
+
+		// PageSize The page size
+		PageSize *int `json:"page_size,omitempty"`
+
+		// Sort Sort field, prefixed with - for descending order
+		Sort *CustomerSortEnum `json:"sort,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+// V1CreateCustomerJSONBody defines parameters for V1CreateCustomer.
+type V1CreateCustomerJSONBody struct {
+	Data CustomerRequestBodyData `json:"data"`
+}
+
+// V1UpdateCustomerJSONBody defines parameters for V1UpdateCustomer.
+type V1UpdateCustomerJSONBody struct {
+	Data UpdateCustomer `json:"data"`
+}
+
+// V1GetEstimatesParams defines parameters for V1GetEstimates.
+type V1GetEstimatesParams struct {
+	Data *struct {
+		Filters *EstimateFilters `json:"filters,omitempty"`
+
+		// Page The page number
+		Page *int `json:"page,omitempty"`
+
+		// PageSize The page size
+		PageSize *int `json:"page_size,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+// V1CreateEstimateJSONBody defines parameters for V1CreateEstimate.
+type V1CreateEstimateJSONBody struct {
+	Data EstimateRequestBodyData `json:"data"`
+}
+
+// V1UpdateEstimateJSONBody defines parameters for V1UpdateEstimate.
+type V1UpdateEstimateJSONBody struct {
+	Data EstimateRequestBodyData `json:"data"`
+}
+
+// V1ConvertEstimateJSONBody defines parameters for V1ConvertEstimate.
+type V1ConvertEstimateJSONBody struct {
+	Data *ConvertEstimateRequestBodyData `json:"data,omitempty"`
+}
+
+// V1GetInvoicesParams defines parameters for V1GetInvoices.
+type V1GetInvoicesParams struct {
+	// Data Filter, sort and paginate the invoices. Without filters, all invoices are listed.
+	Data *struct {
+		// Cursor Continue after the page that returned this next_cursor instead of selecting a page by number. Invoices are listed newest first, and cursors stay stable while invoices are added. Cannot be combined with page.
+		Cursor *string `json:"cursor,omitempty"`
+
+		// Filters All filters are optional and combined with AND. Date bounds are inclusive unless noted.
+		Filters *InvoiceFilters `json:"filters,omitempty"`
+
+		// Page The page number
+		Page *int `json:"page,omitempty"`
+
+		// PageSize The page size
+		PageSize *int `json:"page_size,omitempty"`
+
+		// Sort Sort field, prefixed with - for descending order. Cursors are only returned for -created_at.
+		Sort *InvoiceSortEnum `json:"sort,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+// V1CreateInvoiceJSONBody defines parameters for V1CreateInvoice.
+type V1CreateInvoiceJSONBody struct {
+	Data InvoiceRequestBodyData `json:"data"`
+}
+
+// V1UpdateInvoiceJSONBody defines parameters for V1UpdateInvoice.
+type V1UpdateInvoiceJSONBody struct {
+	Data UpdateInvoice `json:"data"`
+}
+
+// V1CreateInvoiceCreditNoteJSONBody defines parameters for V1CreateInvoiceCreditNote.
+type V1CreateInvoiceCreditNoteJSONBody struct {
+	Data CreditNoteRequestBodyData `json:"data"`
+}
+
+// V1CreateInvoicePaymentJSONBody defines parameters for V1CreateInvoicePayment.
+type V1CreateInvoicePaymentJSONBody struct {
+	Data PaymentRequestBodyData `json:"data"`
+}
+
+// V1SendInvoiceJSONBody defines parameters for V1SendInvoice.
+type V1SendInvoiceJSONBody struct {
+	Data *SendInvoiceRequestBodyData `json:"data,omitempty"`
+}
+
+// V1GetProductsParams defines parameters for V1GetProducts.
+type V1GetProductsParams struct {
+	Data *struct {
+		Filters *ProductFilters `json:"filters,omitempty"`
+
+		// Page The page number
+		Page *int `json:"page,omitempty"`
+
+		// PageSize The page size
+		PageSize *int `json:"page_size,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+// V1CreateProductJSONBody defines parameters for V1CreateProduct.
+type V1CreateProductJSONBody struct {
+	Data ProductRequestBodyData `json:"data"`
+}
+
+// V1UpdateProductJSONBody defines parameters for V1UpdateProduct.
+type V1UpdateProductJSONBody struct {
+	Data UpdateProduct `json:"data"`
+}
+
+// V1GetProductSalesParams defines parameters for V1GetProductSales.
+type V1GetProductSalesParams struct {
+	Data *struct {
+		Filters *ProductSalesFilters `json:"filters,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+// V1GetRecurringInvoicesParams defines parameters for V1GetRecurringInvoices.
+type V1GetRecurringInvoicesParams struct {
+	Data *struct {
+		Filters *RecurringInvoiceFilters `json:"filters,omitempty"`
+
+		// Page The page number
+		Page *int `json:"page,omitempty"`
+
+		// PageSize The page size
+		PageSize *int `json:"page_size,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+// V1CreateRecurringInvoiceJSONBody defines parameters for V1CreateRecurringInvoice.
+type V1CreateRecurringInvoiceJSONBody struct {
+	Data RecurringInvoiceRequestBodyData `json:"data"`
+}
+
+// V1UpdateRecurringInvoiceJSONBody defines parameters for V1UpdateRecurringInvoice.
+type V1UpdateRecurringInvoiceJSONBody struct {
+	Data RecurringInvoiceRequestBodyData `json:"data"`
+}
+
+// V1PreviewRecurringInvoiceParams defines parameters for V1PreviewRecurringInvoice.
+type V1PreviewRecurringInvoiceParams struct {
+	// Count Number of runs to preview
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// V1UpdateBrandingSettingsJSONBody defines parameters for V1UpdateBrandingSettings.
+type V1UpdateBrandingSettingsJSONBody struct {
+	Data BrandingSettings `json:"data"`
+}
+
+// V1UpdateInvoiceNumberingSettingsJSONBody defines parameters for V1UpdateInvoiceNumberingSettings.
+type V1UpdateInvoiceNumberingSettingsJSONBody struct {
+	Data NumberingSettingsRequestBodyData `json:"data"`
+}
+
+// V1UpdateReminderSettingsJSONBody defines parameters for V1UpdateReminderSettings.
+type V1UpdateReminderSettingsJSONBody struct {
+	Data ReminderSettings `json:"data"`
+}
+
+// V1GetTaxRatesParams defines parameters for V1GetTaxRates.
+type V1GetTaxRatesParams struct {
+	Data *struct {
+		Filters *TaxRateFilters `json:"filters,omitempty"`
+
+		// Page The page number
+		Page *int `json:"page,omitempty"`
+
+		// PageSize The page size
+		PageSize *int `json:"page_size,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+// V1CreateTaxRateJSONBody defines parameters for V1CreateTaxRate.
+type V1CreateTaxRateJSONBody struct {
+	Data TaxRateRequestBodyData `json:"data"`
+}
+
+// V1UpdateTaxRateJSONBody defines parameters for V1UpdateTaxRate.
+type V1UpdateTaxRateJSONBody struct {
+	Data UpdateTaxRate `json:"data"`
+}
+
+// V1CreateApiKeyJSONRequestBody defines body for V1CreateApiKey for application/json ContentType.
+type V1CreateApiKeyJSONRequestBody V1CreateApiKeyJSONBody
+
+// V1LoginJSONRequestBody defines body for V1Login for application/json ContentType.
+type V1LoginJSONRequestBody V1LoginJSONBody
+
+// V1LogoutJSONRequestBody defines body for V1Logout for application/json ContentType.
+type V1LogoutJSONRequestBody V1LogoutJSONBody
+
+// V1RefreshTokenJSONRequestBody defines body for V1RefreshToken for application/json ContentType.
+type V1RefreshTokenJSONRequestBody V1RefreshTokenJSONBody
+
+// V1RegisterJSONRequestBody defines body for V1Register for application/json ContentType.
+type V1RegisterJSONRequestBody V1RegisterJSONBody
+
+// V1CreateCustomerJSONRequestBody defines body for V1CreateCustomer for application/json ContentType.
+type V1CreateCustomerJSONRequestBody V1CreateCustomerJSONBody
+
+// V1UpdateCustomerJSONRequestBody defines body for V1UpdateCustomer for application/json ContentType.
+type V1UpdateCustomerJSONRequestBody V1UpdateCustomerJSONBody
+
+// V1CreateEstimateJSONRequestBody defines body for V1CreateEstimate for application/json ContentType.
+type V1CreateEstimateJSONRequestBody V1CreateEstimateJSONBody
+
+// V1UpdateEstimateJSONRequestBody defines body for V1UpdateEstimate for application/json ContentType.
+type V1UpdateEstimateJSONRequestBody V1UpdateEstimateJSONBody
+
+// V1ConvertEstimateJSONRequestBody defines body for V1ConvertEstimate for application/json ContentType.
+type V1ConvertEstimateJSONRequestBody V1ConvertEstimateJSONBody
+
+// V1CreateInvoiceJSONRequestBody defines body for V1CreateInvoice for application/json ContentType.
 type V1CreateInvoiceJSONRequestBody V1CreateInvoiceJSONBody
 
 // V1UpdateInvoiceJSONRequestBody defines body for V1UpdateInvoice for application/json ContentType.
-type V1UpdateInvoiceJSONRequestBody = UpdateInvoice
+type V1UpdateInvoiceJSONRequestBody V1UpdateInvoiceJSONBody
+
+// V1CreateInvoiceCreditNoteJSONRequestBody defines body for V1CreateInvoiceCreditNote for application/json ContentType.
+type V1CreateInvoiceCreditNoteJSONRequestBody V1CreateInvoiceCreditNoteJSONBody
+
+// V1CreateInvoicePaymentJSONRequestBody defines body for V1CreateInvoicePayment for application/json ContentType.
+type V1CreateInvoicePaymentJSONRequestBody V1CreateInvoicePaymentJSONBody
+
+// V1SendInvoiceJSONRequestBody defines body for V1SendInvoice for application/json ContentType.
+type V1SendInvoiceJSONRequestBody V1SendInvoiceJSONBody
+
+// V1CreateProductJSONRequestBody defines body for V1CreateProduct for application/json ContentType.
+type V1CreateProductJSONRequestBody V1CreateProductJSONBody
+
+// V1UpdateProductJSONRequestBody defines body for V1UpdateProduct for application/json ContentType.
+type V1UpdateProductJSONRequestBody V1UpdateProductJSONBody
+
+// V1CreateRecurringInvoiceJSONRequestBody defines body for V1CreateRecurringInvoice for application/json ContentType.
+type V1CreateRecurringInvoiceJSONRequestBody V1CreateRecurringInvoiceJSONBody
+
+// V1UpdateRecurringInvoiceJSONRequestBody defines body for V1UpdateRecurringInvoice for application/json ContentType.
+type V1UpdateRecurringInvoiceJSONRequestBody V1UpdateRecurringInvoiceJSONBody
+
+// V1UpdateBrandingSettingsJSONRequestBody defines body for V1UpdateBrandingSettings for application/json ContentType.
+type V1UpdateBrandingSettingsJSONRequestBody V1UpdateBrandingSettingsJSONBody
+
+// V1UpdateInvoiceNumberingSettingsJSONRequestBody defines body for V1UpdateInvoiceNumberingSettings for application/json ContentType.
+type V1UpdateInvoiceNumberingSettingsJSONRequestBody V1UpdateInvoiceNumberingSettingsJSONBody
+
+// V1UpdateReminderSettingsJSONRequestBody defines body for V1UpdateReminderSettings for application/json ContentType.
+type V1UpdateReminderSettingsJSONRequestBody V1UpdateReminderSettingsJSONBody
+
+// V1CreateTaxRateJSONRequestBody defines body for V1CreateTaxRate for application/json ContentType.
+type V1CreateTaxRateJSONRequestBody V1CreateTaxRateJSONBody
+
+// V1UpdateTaxRateJSONRequestBody defines body for V1UpdateTaxRate for application/json ContentType.
+type V1UpdateTaxRateJSONRequestBody V1UpdateTaxRateJSONBody
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get recent activities
 	// (GET /v1/activities)
-	V1GetActivities(w http.ResponseWriter, r *http.Request)
+	V1GetActivities(w http.ResponseWriter, r *http.Request, params V1GetActivitiesParams)
+	// List API keys
+	// (GET /v1/api-keys)
+	V1GetApiKeys(w http.ResponseWriter, r *http.Request)
+	// Create an API key
+	// (POST /v1/api-keys)
+	V1CreateApiKey(w http.ResponseWriter, r *http.Request)
+	// Revoke an API key
+	// (DELETE /v1/api-keys/{apiKeyId})
+	V1RevokeApiKey(w http.ResponseWriter, r *http.Request, apiKeyId openapi_types.UUID)
+	// Log in
+	// (POST /v1/auth/login)
+	V1Login(w http.ResponseWriter, r *http.Request)
+	// Log out
+	// (POST /v1/auth/logout)
+	V1Logout(w http.ResponseWriter, r *http.Request)
+	// Refresh tokens
+	// (POST /v1/auth/refresh)
+	V1RefreshToken(w http.ResponseWriter, r *http.Request)
+	// Register
+	// (POST /v1/auth/register)
+	V1Register(w http.ResponseWriter, r *http.Request)
+	// List credit notes
+	// (GET /v1/credit-notes)
+	V1GetCreditNotes(w http.ResponseWriter, r *http.Request, params V1GetCreditNotesParams)
+	// Get a credit note
+	// (GET /v1/credit-notes/{creditNoteId})
+	V1GetCreditNote(w http.ResponseWriter, r *http.Request, creditNoteId openapi_types.UUID)
+	// Download a credit note as PDF
+	// (GET /v1/credit-notes/{creditNoteId}/pdf)
+	V1GetCreditNotePdf(w http.ResponseWriter, r *http.Request, creditNoteId openapi_types.UUID)
 	// List all customers
 	// (GET /v1/customers)
 	V1GetCustomers(w http.ResponseWriter, r *http.Request, params V1GetCustomersParams)
 	// Create a new customer
 	// (POST /v1/customers)
 	V1CreateCustomer(w http.ResponseWriter, r *http.Request)
+	// Delete a customer
+	// (DELETE /v1/customers/{customerId})
+	V1DeleteCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID)
+	// Get a customer
+	// (GET /v1/customers/{customerId})
+	V1GetCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID)
+	// Update a customer
+	// (PATCH /v1/customers/{customerId})
+	V1UpdateCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID)
+	// Restore a customer
+	// (POST /v1/customers/{customerId}/restore)
+	V1RestoreCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID)
+	// List estimates
+	// (GET /v1/estimates)
+	V1GetEstimates(w http.ResponseWriter, r *http.Request, params V1GetEstimatesParams)
+	// Create an estimate
+	// (POST /v1/estimates)
+	V1CreateEstimate(w http.ResponseWriter, r *http.Request)
+	// Delete an estimate
+	// (DELETE /v1/estimates/{estimateId})
+	V1DeleteEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID)
+	// Get an estimate
+	// (GET /v1/estimates/{estimateId})
+	V1GetEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID)
+	// Update an estimate
+	// (PUT /v1/estimates/{estimateId})
+	V1UpdateEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID)
+	// Convert an estimate into an invoice
+	// (POST /v1/estimates/{estimateId}/convert)
+	V1ConvertEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID)
+	// Send an estimate
+	// (POST /v1/estimates/{estimateId}/send)
+	V1SendEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID)
 	// List all invoices
 	// (GET /v1/invoices)
 	V1GetInvoices(w http.ResponseWriter, r *http.Request, params V1GetInvoicesParams)
@@ -219,82 +1611,2120 @@ type ServerInterface interface {
 	V1CreateInvoice(w http.ResponseWriter, r *http.Request)
 	// Delete an invoice
 	// (DELETE /v1/invoices/{invoiceId})
-	V1DeleteInvoice(w http.ResponseWriter, r *http.Request, invoiceId string)
+	V1DeleteInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
 	// Get details of a specific invoice
 	// (GET /v1/invoices/{invoiceId})
 	V1GetInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
 	// Update an invoice
 	// (PATCH /v1/invoices/{invoiceId})
-	V1UpdateInvoice(w http.ResponseWriter, r *http.Request, invoiceId string)
+	V1UpdateInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
+	// List the credit notes of an invoice
+	// (GET /v1/invoices/{invoiceId}/credit-notes)
+	V1GetInvoiceCreditNotes(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
+	// Issue a credit note
+	// (POST /v1/invoices/{invoiceId}/credit-notes)
+	V1CreateInvoiceCreditNote(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
+	// List the deliveries of an invoice
+	// (GET /v1/invoices/{invoiceId}/deliveries)
+	V1GetInvoiceDeliveries(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
+	// Mark an invoice as paid
+	// (POST /v1/invoices/{invoiceId}/mark-paid)
+	V1MarkInvoicePaid(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
+	// List the payments of an invoice
+	// (GET /v1/invoices/{invoiceId}/payments)
+	V1GetInvoicePayments(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
+	// Record a payment
+	// (POST /v1/invoices/{invoiceId}/payments)
+	V1CreateInvoicePayment(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
+	// Download an invoice as PDF
+	// (GET /v1/invoices/{invoiceId}/pdf)
+	V1GetInvoicePdf(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
+	// Send an invoice
+	// (POST /v1/invoices/{invoiceId}/send)
+	V1SendInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
+	// Void an invoice
+	// (POST /v1/invoices/{invoiceId}/void)
+	V1VoidInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID)
+	// List products
+	// (GET /v1/products)
+	V1GetProducts(w http.ResponseWriter, r *http.Request, params V1GetProductsParams)
+	// Create a product
+	// (POST /v1/products)
+	V1CreateProduct(w http.ResponseWriter, r *http.Request)
+	// Delete a product
+	// (DELETE /v1/products/{productId})
+	V1DeleteProduct(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Get a product
+	// (GET /v1/products/{productId})
+	V1GetProduct(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Update a product
+	// (PATCH /v1/products/{productId})
+	V1UpdateProduct(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Get the sales of a product
+	// (GET /v1/products/{productId}/sales)
+	V1GetProductSales(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID, params V1GetProductSalesParams)
+	// View an estimate as the customer
+	// (GET /v1/public/estimates/{token})
+	V1GetPublicEstimate(w http.ResponseWriter, r *http.Request, token string)
+	// Accept an estimate
+	// (POST /v1/public/estimates/{token}/accept)
+	V1AcceptPublicEstimate(w http.ResponseWriter, r *http.Request, token string)
+	// Decline an estimate
+	// (POST /v1/public/estimates/{token}/decline)
+	V1DeclinePublicEstimate(w http.ResponseWriter, r *http.Request, token string)
+	// List recurring invoices
+	// (GET /v1/recurring-invoices)
+	V1GetRecurringInvoices(w http.ResponseWriter, r *http.Request, params V1GetRecurringInvoicesParams)
+	// Create a recurring invoice
+	// (POST /v1/recurring-invoices)
+	V1CreateRecurringInvoice(w http.ResponseWriter, r *http.Request)
+	// Delete a recurring invoice
+	// (DELETE /v1/recurring-invoices/{recurringInvoiceId})
+	V1DeleteRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID)
+	// Get a recurring invoice
+	// (GET /v1/recurring-invoices/{recurringInvoiceId})
+	V1GetRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID)
+	// Update a recurring invoice
+	// (PUT /v1/recurring-invoices/{recurringInvoiceId})
+	V1UpdateRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID)
+	// Pause a recurring invoice
+	// (POST /v1/recurring-invoices/{recurringInvoiceId}/pause)
+	V1PauseRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID)
+	// Preview upcoming runs
+	// (GET /v1/recurring-invoices/{recurringInvoiceId}/preview)
+	V1PreviewRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID, params V1PreviewRecurringInvoiceParams)
+	// Resume a recurring invoice
+	// (POST /v1/recurring-invoices/{recurringInvoiceId}/resume)
+	V1ResumeRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID)
+	// Get branding settings
+	// (GET /v1/settings/branding)
+	V1GetBrandingSettings(w http.ResponseWriter, r *http.Request)
+	// Update branding settings
+	// (PUT /v1/settings/branding)
+	V1UpdateBrandingSettings(w http.ResponseWriter, r *http.Request)
+	// Get invoice numbering settings
+	// (GET /v1/settings/invoice-numbering)
+	V1GetInvoiceNumberingSettings(w http.ResponseWriter, r *http.Request)
+	// Update invoice numbering settings
+	// (PUT /v1/settings/invoice-numbering)
+	V1UpdateInvoiceNumberingSettings(w http.ResponseWriter, r *http.Request)
+	// Get payment reminder settings
+	// (GET /v1/settings/reminders)
+	V1GetReminderSettings(w http.ResponseWriter, r *http.Request)
+	// Update payment reminder settings
+	// (PUT /v1/settings/reminders)
+	V1UpdateReminderSettings(w http.ResponseWriter, r *http.Request)
+	// List tax rates
+	// (GET /v1/tax-rates)
+	V1GetTaxRates(w http.ResponseWriter, r *http.Request, params V1GetTaxRatesParams)
+	// Create a tax rate
+	// (POST /v1/tax-rates)
+	V1CreateTaxRate(w http.ResponseWriter, r *http.Request)
+	// Delete a tax rate
+	// (DELETE /v1/tax-rates/{taxRateId})
+	V1DeleteTaxRate(w http.ResponseWriter, r *http.Request, taxRateId openapi_types.UUID)
+	// Get a tax rate
+	// (GET /v1/tax-rates/{taxRateId})
+	V1GetTaxRate(w http.ResponseWriter, r *http.Request, taxRateId openapi_types.UUID)
+	// Update a tax rate
+	// (PATCH /v1/tax-rates/{taxRateId})
+	V1UpdateTaxRate(w http.ResponseWriter, r *http.Request, taxRateId openapi_types.UUID)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Get recent activities
+// (GET /v1/activities)
+func (_ Unimplemented) V1GetActivities(w http.ResponseWriter, r *http.Request, params V1GetActivitiesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List API keys
+// (GET /v1/api-keys)
+func (_ Unimplemented) V1GetApiKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an API key
+// (POST /v1/api-keys)
+func (_ Unimplemented) V1CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke an API key
+// (DELETE /v1/api-keys/{apiKeyId})
+func (_ Unimplemented) V1RevokeApiKey(w http.ResponseWriter, r *http.Request, apiKeyId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// Log in
+// (POST /v1/auth/login)
+func (_ Unimplemented) V1Login(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log out
+// (POST /v1/auth/logout)
+func (_ Unimplemented) V1Logout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Refresh tokens
+// (POST /v1/auth/refresh)
+func (_ Unimplemented) V1RefreshToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register
+// (POST /v1/auth/register)
+func (_ Unimplemented) V1Register(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List credit notes
+// (GET /v1/credit-notes)
+func (_ Unimplemented) V1GetCreditNotes(w http.ResponseWriter, r *http.Request, params V1GetCreditNotesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a credit note
+// (GET /v1/credit-notes/{creditNoteId})
+func (_ Unimplemented) V1GetCreditNote(w http.ResponseWriter, r *http.Request, creditNoteId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a credit note as PDF
+// (GET /v1/credit-notes/{creditNoteId}/pdf)
+func (_ Unimplemented) V1GetCreditNotePdf(w http.ResponseWriter, r *http.Request, creditNoteId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all customers
+// (GET /v1/customers)
+func (_ Unimplemented) V1GetCustomers(w http.ResponseWriter, r *http.Request, params V1GetCustomersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new customer
+// (POST /v1/customers)
+func (_ Unimplemented) V1CreateCustomer(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a customer
+// (DELETE /v1/customers/{customerId})
+func (_ Unimplemented) V1DeleteCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a customer
+// (GET /v1/customers/{customerId})
+func (_ Unimplemented) V1GetCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a customer
+// (PATCH /v1/customers/{customerId})
+func (_ Unimplemented) V1UpdateCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore a customer
+// (POST /v1/customers/{customerId}/restore)
+func (_ Unimplemented) V1RestoreCustomer(w http.ResponseWriter, r *http.Request, customerId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List estimates
+// (GET /v1/estimates)
+func (_ Unimplemented) V1GetEstimates(w http.ResponseWriter, r *http.Request, params V1GetEstimatesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an estimate
+// (POST /v1/estimates)
+func (_ Unimplemented) V1CreateEstimate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an estimate
+// (DELETE /v1/estimates/{estimateId})
+func (_ Unimplemented) V1DeleteEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get an estimate
+// (GET /v1/estimates/{estimateId})
+func (_ Unimplemented) V1GetEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update an estimate
+// (PUT /v1/estimates/{estimateId})
+func (_ Unimplemented) V1UpdateEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Convert an estimate into an invoice
+// (POST /v1/estimates/{estimateId}/convert)
+func (_ Unimplemented) V1ConvertEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send an estimate
+// (POST /v1/estimates/{estimateId}/send)
+func (_ Unimplemented) V1SendEstimate(w http.ResponseWriter, r *http.Request, estimateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all invoices
+// (GET /v1/invoices)
+func (_ Unimplemented) V1GetInvoices(w http.ResponseWriter, r *http.Request, params V1GetInvoicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new invoice
+// (POST /v1/invoices)
+func (_ Unimplemented) V1CreateInvoice(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an invoice
+// (DELETE /v1/invoices/{invoiceId})
+func (_ Unimplemented) V1DeleteInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get details of a specific invoice
+// (GET /v1/invoices/{invoiceId})
+func (_ Unimplemented) V1GetInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update an invoice
+// (PATCH /v1/invoices/{invoiceId})
+func (_ Unimplemented) V1UpdateInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the credit notes of an invoice
+// (GET /v1/invoices/{invoiceId}/credit-notes)
+func (_ Unimplemented) V1GetInvoiceCreditNotes(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Issue a credit note
+// (POST /v1/invoices/{invoiceId}/credit-notes)
+func (_ Unimplemented) V1CreateInvoiceCreditNote(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the deliveries of an invoice
+// (GET /v1/invoices/{invoiceId}/deliveries)
+func (_ Unimplemented) V1GetInvoiceDeliveries(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark an invoice as paid
+// (POST /v1/invoices/{invoiceId}/mark-paid)
+func (_ Unimplemented) V1MarkInvoicePaid(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the payments of an invoice
+// (GET /v1/invoices/{invoiceId}/payments)
+func (_ Unimplemented) V1GetInvoicePayments(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record a payment
+// (POST /v1/invoices/{invoiceId}/payments)
+func (_ Unimplemented) V1CreateInvoicePayment(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download an invoice as PDF
+// (GET /v1/invoices/{invoiceId}/pdf)
+func (_ Unimplemented) V1GetInvoicePdf(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send an invoice
+// (POST /v1/invoices/{invoiceId}/send)
+func (_ Unimplemented) V1SendInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Void an invoice
+// (POST /v1/invoices/{invoiceId}/void)
+func (_ Unimplemented) V1VoidInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List products
+// (GET /v1/products)
+func (_ Unimplemented) V1GetProducts(w http.ResponseWriter, r *http.Request, params V1GetProductsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a product
+// (POST /v1/products)
+func (_ Unimplemented) V1CreateProduct(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a product
+// (DELETE /v1/products/{productId})
+func (_ Unimplemented) V1DeleteProduct(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a product
+// (GET /v1/products/{productId})
+func (_ Unimplemented) V1GetProduct(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a product
+// (PATCH /v1/products/{productId})
+func (_ Unimplemented) V1UpdateProduct(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the sales of a product
+// (GET /v1/products/{productId}/sales)
+func (_ Unimplemented) V1GetProductSales(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID, params V1GetProductSalesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// View an estimate as the customer
+// (GET /v1/public/estimates/{token})
+func (_ Unimplemented) V1GetPublicEstimate(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Accept an estimate
+// (POST /v1/public/estimates/{token}/accept)
+func (_ Unimplemented) V1AcceptPublicEstimate(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Decline an estimate
+// (POST /v1/public/estimates/{token}/decline)
+func (_ Unimplemented) V1DeclinePublicEstimate(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List recurring invoices
+// (GET /v1/recurring-invoices)
+func (_ Unimplemented) V1GetRecurringInvoices(w http.ResponseWriter, r *http.Request, params V1GetRecurringInvoicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a recurring invoice
+// (POST /v1/recurring-invoices)
+func (_ Unimplemented) V1CreateRecurringInvoice(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a recurring invoice
+// (DELETE /v1/recurring-invoices/{recurringInvoiceId})
+func (_ Unimplemented) V1DeleteRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a recurring invoice
+// (GET /v1/recurring-invoices/{recurringInvoiceId})
+func (_ Unimplemented) V1GetRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a recurring invoice
+// (PUT /v1/recurring-invoices/{recurringInvoiceId})
+func (_ Unimplemented) V1UpdateRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Pause a recurring invoice
+// (POST /v1/recurring-invoices/{recurringInvoiceId}/pause)
+func (_ Unimplemented) V1PauseRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Preview upcoming runs
+// (GET /v1/recurring-invoices/{recurringInvoiceId}/preview)
+func (_ Unimplemented) V1PreviewRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID, params V1PreviewRecurringInvoiceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resume a recurring invoice
+// (POST /v1/recurring-invoices/{recurringInvoiceId}/resume)
+func (_ Unimplemented) V1ResumeRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get branding settings
+// (GET /v1/settings/branding)
+func (_ Unimplemented) V1GetBrandingSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update branding settings
+// (PUT /v1/settings/branding)
+func (_ Unimplemented) V1UpdateBrandingSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get invoice numbering settings
+// (GET /v1/settings/invoice-numbering)
+func (_ Unimplemented) V1GetInvoiceNumberingSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update invoice numbering settings
+// (PUT /v1/settings/invoice-numbering)
+func (_ Unimplemented) V1UpdateInvoiceNumberingSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get payment reminder settings
+// (GET /v1/settings/reminders)
+func (_ Unimplemented) V1GetReminderSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update payment reminder settings
+// (PUT /v1/settings/reminders)
+func (_ Unimplemented) V1UpdateReminderSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List tax rates
+// (GET /v1/tax-rates)
+func (_ Unimplemented) V1GetTaxRates(w http.ResponseWriter, r *http.Request, params V1GetTaxRatesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a tax rate
+// (POST /v1/tax-rates)
+func (_ Unimplemented) V1CreateTaxRate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a tax rate
+// (DELETE /v1/tax-rates/{taxRateId})
+func (_ Unimplemented) V1DeleteTaxRate(w http.ResponseWriter, r *http.Request, taxRateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a tax rate
+// (GET /v1/tax-rates/{taxRateId})
+func (_ Unimplemented) V1GetTaxRate(w http.ResponseWriter, r *http.Request, taxRateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a tax rate
+// (PATCH /v1/tax-rates/{taxRateId})
+func (_ Unimplemented) V1UpdateTaxRate(w http.ResponseWriter, r *http.Request, taxRateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// V1GetActivities operation middleware
+func (siw *ServerInterfaceWrapper) V1GetActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1GetActivitiesParams
+
+	// ------------- Optional query parameter "data" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "data", r.URL.Query(), &params.Data)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "data", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetActivities(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) V1GetApiKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetApiKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1CreateApiKey operation middleware
+func (siw *ServerInterfaceWrapper) V1CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1CreateApiKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1RevokeApiKey operation middleware
+func (siw *ServerInterfaceWrapper) V1RevokeApiKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "apiKeyId" -------------
+	var apiKeyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyId", chi.URLParam(r, "apiKeyId"), &apiKeyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apiKeyId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1RevokeApiKey(w, r, apiKeyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1Login operation middleware
+func (siw *ServerInterfaceWrapper) V1Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1Login(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1Logout operation middleware
+func (siw *ServerInterfaceWrapper) V1Logout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1Logout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1RefreshToken operation middleware
+func (siw *ServerInterfaceWrapper) V1RefreshToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1RefreshToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1Register operation middleware
+func (siw *ServerInterfaceWrapper) V1Register(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1Register(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetCreditNotes operation middleware
+func (siw *ServerInterfaceWrapper) V1GetCreditNotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1GetCreditNotesParams
+
+	// ------------- Optional query parameter "data" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "data", r.URL.Query(), &params.Data)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "data", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetCreditNotes(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetCreditNote operation middleware
+func (siw *ServerInterfaceWrapper) V1GetCreditNote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "creditNoteId" -------------
+	var creditNoteId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "creditNoteId", chi.URLParam(r, "creditNoteId"), &creditNoteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "creditNoteId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetCreditNote(w, r, creditNoteId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetCreditNotePdf operation middleware
+func (siw *ServerInterfaceWrapper) V1GetCreditNotePdf(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "creditNoteId" -------------
+	var creditNoteId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "creditNoteId", chi.URLParam(r, "creditNoteId"), &creditNoteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "creditNoteId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetCreditNotePdf(w, r, creditNoteId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetCustomers operation middleware
+func (siw *ServerInterfaceWrapper) V1GetCustomers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1GetCustomersParams
+
+	// ------------- Optional query parameter "data" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "data", r.URL.Query(), &params.Data)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "data", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetCustomers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1CreateCustomer operation middleware
+func (siw *ServerInterfaceWrapper) V1CreateCustomer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1CreateCustomer(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1DeleteCustomer operation middleware
+func (siw *ServerInterfaceWrapper) V1DeleteCustomer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "customerId" -------------
+	var customerId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "customerId", chi.URLParam(r, "customerId"), &customerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "customerId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1DeleteCustomer(w, r, customerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetCustomer operation middleware
+func (siw *ServerInterfaceWrapper) V1GetCustomer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "customerId" -------------
+	var customerId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "customerId", chi.URLParam(r, "customerId"), &customerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "customerId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetCustomer(w, r, customerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1UpdateCustomer operation middleware
+func (siw *ServerInterfaceWrapper) V1UpdateCustomer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "customerId" -------------
+	var customerId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "customerId", chi.URLParam(r, "customerId"), &customerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "customerId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1UpdateCustomer(w, r, customerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1RestoreCustomer operation middleware
+func (siw *ServerInterfaceWrapper) V1RestoreCustomer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "customerId" -------------
+	var customerId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "customerId", chi.URLParam(r, "customerId"), &customerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "customerId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1RestoreCustomer(w, r, customerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetEstimates operation middleware
+func (siw *ServerInterfaceWrapper) V1GetEstimates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1GetEstimatesParams
+
+	// ------------- Optional query parameter "data" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "data", r.URL.Query(), &params.Data)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "data", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetEstimates(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1CreateEstimate operation middleware
+func (siw *ServerInterfaceWrapper) V1CreateEstimate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1CreateEstimate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1DeleteEstimate operation middleware
+func (siw *ServerInterfaceWrapper) V1DeleteEstimate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "estimateId" -------------
+	var estimateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "estimateId", chi.URLParam(r, "estimateId"), &estimateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "estimateId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1DeleteEstimate(w, r, estimateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetEstimate operation middleware
+func (siw *ServerInterfaceWrapper) V1GetEstimate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "estimateId" -------------
+	var estimateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "estimateId", chi.URLParam(r, "estimateId"), &estimateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "estimateId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetEstimate(w, r, estimateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1UpdateEstimate operation middleware
+func (siw *ServerInterfaceWrapper) V1UpdateEstimate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "estimateId" -------------
+	var estimateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "estimateId", chi.URLParam(r, "estimateId"), &estimateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "estimateId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1UpdateEstimate(w, r, estimateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1ConvertEstimate operation middleware
+func (siw *ServerInterfaceWrapper) V1ConvertEstimate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "estimateId" -------------
+	var estimateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "estimateId", chi.URLParam(r, "estimateId"), &estimateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "estimateId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1ConvertEstimate(w, r, estimateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1SendEstimate operation middleware
+func (siw *ServerInterfaceWrapper) V1SendEstimate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "estimateId" -------------
+	var estimateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "estimateId", chi.URLParam(r, "estimateId"), &estimateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "estimateId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1SendEstimate(w, r, estimateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetInvoices operation middleware
+func (siw *ServerInterfaceWrapper) V1GetInvoices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1GetInvoicesParams
+
+	// ------------- Optional query parameter "data" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "data", r.URL.Query(), &params.Data)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "data", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetInvoices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1CreateInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1CreateInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1CreateInvoice(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1DeleteInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1DeleteInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1DeleteInvoice(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1GetInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetInvoice(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1UpdateInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1UpdateInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1UpdateInvoice(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetInvoiceCreditNotes operation middleware
+func (siw *ServerInterfaceWrapper) V1GetInvoiceCreditNotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetInvoiceCreditNotes(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1CreateInvoiceCreditNote operation middleware
+func (siw *ServerInterfaceWrapper) V1CreateInvoiceCreditNote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1CreateInvoiceCreditNote(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetInvoiceDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) V1GetInvoiceDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetInvoiceDeliveries(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1MarkInvoicePaid operation middleware
+func (siw *ServerInterfaceWrapper) V1MarkInvoicePaid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1MarkInvoicePaid(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetInvoicePayments operation middleware
+func (siw *ServerInterfaceWrapper) V1GetInvoicePayments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetInvoicePayments(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1CreateInvoicePayment operation middleware
+func (siw *ServerInterfaceWrapper) V1CreateInvoicePayment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1CreateInvoicePayment(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetInvoicePdf operation middleware
+func (siw *ServerInterfaceWrapper) V1GetInvoicePdf(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetInvoicePdf(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1SendInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1SendInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1SendInvoice(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1VoidInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1VoidInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "invoiceId" -------------
+	var invoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1VoidInvoice(w, r, invoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetProducts operation middleware
+func (siw *ServerInterfaceWrapper) V1GetProducts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1GetProductsParams
+
+	// ------------- Optional query parameter "data" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "data", r.URL.Query(), &params.Data)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "data", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetProducts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1CreateProduct operation middleware
+func (siw *ServerInterfaceWrapper) V1CreateProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1CreateProduct(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1DeleteProduct operation middleware
+func (siw *ServerInterfaceWrapper) V1DeleteProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1DeleteProduct(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetProduct operation middleware
+func (siw *ServerInterfaceWrapper) V1GetProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetProduct(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1UpdateProduct operation middleware
+func (siw *ServerInterfaceWrapper) V1UpdateProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1UpdateProduct(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetProductSales operation middleware
+func (siw *ServerInterfaceWrapper) V1GetProductSales(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1GetProductSalesParams
+
+	// ------------- Optional query parameter "data" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "data", r.URL.Query(), &params.Data)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "data", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetProductSales(w, r, productId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetPublicEstimate operation middleware
+func (siw *ServerInterfaceWrapper) V1GetPublicEstimate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetPublicEstimate(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1AcceptPublicEstimate operation middleware
+func (siw *ServerInterfaceWrapper) V1AcceptPublicEstimate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1AcceptPublicEstimate(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1DeclinePublicEstimate operation middleware
+func (siw *ServerInterfaceWrapper) V1DeclinePublicEstimate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1DeclinePublicEstimate(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetRecurringInvoices operation middleware
+func (siw *ServerInterfaceWrapper) V1GetRecurringInvoices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1GetRecurringInvoicesParams
+
+	// ------------- Optional query parameter "data" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "data", r.URL.Query(), &params.Data)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "data", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetRecurringInvoices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1CreateRecurringInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1CreateRecurringInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1CreateRecurringInvoice(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1DeleteRecurringInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1DeleteRecurringInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "recurringInvoiceId" -------------
+	var recurringInvoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recurringInvoiceId", chi.URLParam(r, "recurringInvoiceId"), &recurringInvoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurringInvoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1DeleteRecurringInvoice(w, r, recurringInvoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetRecurringInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1GetRecurringInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "recurringInvoiceId" -------------
+	var recurringInvoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recurringInvoiceId", chi.URLParam(r, "recurringInvoiceId"), &recurringInvoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurringInvoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetRecurringInvoice(w, r, recurringInvoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1UpdateRecurringInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1UpdateRecurringInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "recurringInvoiceId" -------------
+	var recurringInvoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recurringInvoiceId", chi.URLParam(r, "recurringInvoiceId"), &recurringInvoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurringInvoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1UpdateRecurringInvoice(w, r, recurringInvoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1PauseRecurringInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1PauseRecurringInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "recurringInvoiceId" -------------
+	var recurringInvoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recurringInvoiceId", chi.URLParam(r, "recurringInvoiceId"), &recurringInvoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurringInvoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1PauseRecurringInvoice(w, r, recurringInvoiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1PreviewRecurringInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1PreviewRecurringInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "recurringInvoiceId" -------------
+	var recurringInvoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recurringInvoiceId", chi.URLParam(r, "recurringInvoiceId"), &recurringInvoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurringInvoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1PreviewRecurringInvoiceParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1PreviewRecurringInvoice(w, r, recurringInvoiceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1ResumeRecurringInvoice operation middleware
+func (siw *ServerInterfaceWrapper) V1ResumeRecurringInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "recurringInvoiceId" -------------
+	var recurringInvoiceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recurringInvoiceId", chi.URLParam(r, "recurringInvoiceId"), &recurringInvoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurringInvoiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type Unimplemented struct{}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-// Get recent activities
-// (GET /v1/activities)
-func (_ Unimplemented) V1GetActivities(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1ResumeRecurringInvoice(w, r, recurringInvoiceId)
+	}))
 
-// List all customers
-// (GET /v1/customers)
-func (_ Unimplemented) V1GetCustomers(w http.ResponseWriter, r *http.Request, params V1GetCustomersParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Create a new customer
-// (POST /v1/customers)
-func (_ Unimplemented) V1CreateCustomer(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// List all invoices
-// (GET /v1/invoices)
-func (_ Unimplemented) V1GetInvoices(w http.ResponseWriter, r *http.Request, params V1GetInvoicesParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// V1GetBrandingSettings operation middleware
+func (siw *ServerInterfaceWrapper) V1GetBrandingSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// Create a new invoice
-// (POST /v1/invoices)
-func (_ Unimplemented) V1CreateInvoice(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// Delete an invoice
-// (DELETE /v1/invoices/{invoiceId})
-func (_ Unimplemented) V1DeleteInvoice(w http.ResponseWriter, r *http.Request, invoiceId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-// Get details of a specific invoice
-// (GET /v1/invoices/{invoiceId})
-func (_ Unimplemented) V1GetInvoice(w http.ResponseWriter, r *http.Request, invoiceId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1GetBrandingSettings(w, r)
+	}))
 
-// Update an invoice
-// (PATCH /v1/invoices/{invoiceId})
-func (_ Unimplemented) V1UpdateInvoice(w http.ResponseWriter, r *http.Request, invoiceId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// V1UpdateBrandingSettings operation middleware
+func (siw *ServerInterfaceWrapper) V1UpdateBrandingSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// V1GetActivities operation middleware
-func (siw *ServerInterfaceWrapper) V1GetActivities(w http.ResponseWriter, r *http.Request) {
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1UpdateBrandingSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetInvoiceNumberingSettings operation middleware
+func (siw *ServerInterfaceWrapper) V1GetInvoiceNumberingSettings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.V1GetActivities(w, r)
+		siw.Handler.V1GetInvoiceNumberingSettings(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -304,25 +3734,35 @@ func (siw *ServerInterfaceWrapper) V1GetActivities(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// V1GetCustomers operation middleware
-func (siw *ServerInterfaceWrapper) V1GetCustomers(w http.ResponseWriter, r *http.Request) {
+// V1UpdateInvoiceNumberingSettings operation middleware
+func (siw *ServerInterfaceWrapper) V1UpdateInvoiceNumberingSettings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params V1GetCustomersParams
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	// ------------- Optional query parameter "data" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1UpdateInvoiceNumberingSettings(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("deepObject", true, false, "data", r.URL.Query(), &params.Data)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "data", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1GetReminderSettings operation middleware
+func (siw *ServerInterfaceWrapper) V1GetReminderSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.V1GetCustomers(w, r, params)
+		siw.Handler.V1GetReminderSettings(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -332,12 +3772,16 @@ func (siw *ServerInterfaceWrapper) V1GetCustomers(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// V1CreateCustomer operation middleware
-func (siw *ServerInterfaceWrapper) V1CreateCustomer(w http.ResponseWriter, r *http.Request) {
+// V1UpdateReminderSettings operation middleware
+func (siw *ServerInterfaceWrapper) V1UpdateReminderSettings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.V1CreateCustomer(w, r)
+		siw.Handler.V1UpdateReminderSettings(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -347,14 +3791,18 @@ func (siw *ServerInterfaceWrapper) V1CreateCustomer(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// V1GetInvoices operation middleware
-func (siw *ServerInterfaceWrapper) V1GetInvoices(w http.ResponseWriter, r *http.Request) {
+// V1GetTaxRates operation middleware
+func (siw *ServerInterfaceWrapper) V1GetTaxRates(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params V1GetInvoicesParams
+	var params V1GetTaxRatesParams
 
 	// ------------- Optional query parameter "data" -------------
 
@@ -365,7 +3813,7 @@ func (siw *ServerInterfaceWrapper) V1GetInvoices(w http.ResponseWriter, r *http.
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.V1GetInvoices(w, r, params)
+		siw.Handler.V1GetTaxRates(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -375,12 +3823,16 @@ func (siw *ServerInterfaceWrapper) V1GetInvoices(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// V1CreateInvoice operation middleware
-func (siw *ServerInterfaceWrapper) V1CreateInvoice(w http.ResponseWriter, r *http.Request) {
+// V1CreateTaxRate operation middleware
+func (siw *ServerInterfaceWrapper) V1CreateTaxRate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.V1CreateInvoice(w, r)
+		siw.Handler.V1CreateTaxRate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -390,23 +3842,27 @@ func (siw *ServerInterfaceWrapper) V1CreateInvoice(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// V1DeleteInvoice operation middleware
-func (siw *ServerInterfaceWrapper) V1DeleteInvoice(w http.ResponseWriter, r *http.Request) {
+// V1DeleteTaxRate operation middleware
+func (siw *ServerInterfaceWrapper) V1DeleteTaxRate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "invoiceId" -------------
-	var invoiceId string
+	// ------------- Path parameter "taxRateId" -------------
+	var taxRateId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "taxRateId", chi.URLParam(r, "taxRateId"), &taxRateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taxRateId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.V1DeleteInvoice(w, r, invoiceId)
+		siw.Handler.V1DeleteTaxRate(w, r, taxRateId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -416,23 +3872,27 @@ func (siw *ServerInterfaceWrapper) V1DeleteInvoice(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// V1GetInvoice operation middleware
-func (siw *ServerInterfaceWrapper) V1GetInvoice(w http.ResponseWriter, r *http.Request) {
+// V1GetTaxRate operation middleware
+func (siw *ServerInterfaceWrapper) V1GetTaxRate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "invoiceId" -------------
-	var invoiceId openapi_types.UUID
+	// ------------- Path parameter "taxRateId" -------------
+	var taxRateId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "taxRateId", chi.URLParam(r, "taxRateId"), &taxRateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taxRateId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.V1GetInvoice(w, r, invoiceId)
+		siw.Handler.V1GetTaxRate(w, r, taxRateId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -442,23 +3902,27 @@ func (siw *ServerInterfaceWrapper) V1GetInvoice(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// V1UpdateInvoice operation middleware
-func (siw *ServerInterfaceWrapper) V1UpdateInvoice(w http.ResponseWriter, r *http.Request) {
+// V1UpdateTaxRate operation middleware
+func (siw *ServerInterfaceWrapper) V1UpdateTaxRate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "invoiceId" -------------
-	var invoiceId string
+	// ------------- Path parameter "taxRateId" -------------
+	var taxRateId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "invoiceId", chi.URLParam(r, "invoiceId"), &invoiceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "taxRateId", chi.URLParam(r, "taxRateId"), &taxRateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invoiceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taxRateId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.V1UpdateInvoice(w, r, invoiceId)
+		siw.Handler.V1UpdateTaxRate(w, r, taxRateId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -584,12 +4048,75 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/activities", wrapper.V1GetActivities)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/api-keys", wrapper.V1GetApiKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/api-keys", wrapper.V1CreateApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/api-keys/{apiKeyId}", wrapper.V1RevokeApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/auth/login", wrapper.V1Login)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/auth/logout", wrapper.V1Logout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/auth/refresh", wrapper.V1RefreshToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/auth/register", wrapper.V1Register)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credit-notes", wrapper.V1GetCreditNotes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credit-notes/{creditNoteId}", wrapper.V1GetCreditNote)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/credit-notes/{creditNoteId}/pdf", wrapper.V1GetCreditNotePdf)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/customers", wrapper.V1GetCustomers)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/customers", wrapper.V1CreateCustomer)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/customers/{customerId}", wrapper.V1DeleteCustomer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/customers/{customerId}", wrapper.V1GetCustomer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/customers/{customerId}", wrapper.V1UpdateCustomer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/customers/{customerId}/restore", wrapper.V1RestoreCustomer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/estimates", wrapper.V1GetEstimates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/estimates", wrapper.V1CreateEstimate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/estimates/{estimateId}", wrapper.V1DeleteEstimate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/estimates/{estimateId}", wrapper.V1GetEstimate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/estimates/{estimateId}", wrapper.V1UpdateEstimate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/estimates/{estimateId}/convert", wrapper.V1ConvertEstimate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/estimates/{estimateId}/send", wrapper.V1SendEstimate)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/invoices", wrapper.V1GetInvoices)
 	})
@@ -605,6 +4132,117 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/v1/invoices/{invoiceId}", wrapper.V1UpdateInvoice)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/invoices/{invoiceId}/credit-notes", wrapper.V1GetInvoiceCreditNotes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/invoices/{invoiceId}/credit-notes", wrapper.V1CreateInvoiceCreditNote)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/invoices/{invoiceId}/deliveries", wrapper.V1GetInvoiceDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/invoices/{invoiceId}/mark-paid", wrapper.V1MarkInvoicePaid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/invoices/{invoiceId}/payments", wrapper.V1GetInvoicePayments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/invoices/{invoiceId}/payments", wrapper.V1CreateInvoicePayment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/invoices/{invoiceId}/pdf", wrapper.V1GetInvoicePdf)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/invoices/{invoiceId}/send", wrapper.V1SendInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/invoices/{invoiceId}/void", wrapper.V1VoidInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/products", wrapper.V1GetProducts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/products", wrapper.V1CreateProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/products/{productId}", wrapper.V1DeleteProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/products/{productId}", wrapper.V1GetProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/products/{productId}", wrapper.V1UpdateProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/products/{productId}/sales", wrapper.V1GetProductSales)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/public/estimates/{token}", wrapper.V1GetPublicEstimate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/public/estimates/{token}/accept", wrapper.V1AcceptPublicEstimate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/public/estimates/{token}/decline", wrapper.V1DeclinePublicEstimate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/recurring-invoices", wrapper.V1GetRecurringInvoices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/recurring-invoices", wrapper.V1CreateRecurringInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/recurring-invoices/{recurringInvoiceId}", wrapper.V1DeleteRecurringInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/recurring-invoices/{recurringInvoiceId}", wrapper.V1GetRecurringInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/recurring-invoices/{recurringInvoiceId}", wrapper.V1UpdateRecurringInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/recurring-invoices/{recurringInvoiceId}/pause", wrapper.V1PauseRecurringInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/recurring-invoices/{recurringInvoiceId}/preview", wrapper.V1PreviewRecurringInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/recurring-invoices/{recurringInvoiceId}/resume", wrapper.V1ResumeRecurringInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/settings/branding", wrapper.V1GetBrandingSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/settings/branding", wrapper.V1UpdateBrandingSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/settings/invoice-numbering", wrapper.V1GetInvoiceNumberingSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/settings/invoice-numbering", wrapper.V1UpdateInvoiceNumberingSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/settings/reminders", wrapper.V1GetReminderSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/settings/reminders", wrapper.V1UpdateReminderSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/tax-rates", wrapper.V1GetTaxRates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/tax-rates", wrapper.V1CreateTaxRate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/tax-rates/{taxRateId}", wrapper.V1DeleteTaxRate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/tax-rates/{taxRateId}", wrapper.V1GetTaxRate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/tax-rates/{taxRateId}", wrapper.V1UpdateTaxRate)
+	})
 
 	return r
 }
@@ -612,37 +4250,228 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaWW8juRH+KwSThwRoWYft8VhP0a69AwFZjzHHAsFAEOjuaombbrKHZCurGP3fAx59",
-	"U5fH6/jBT6PhUcWq+upsP+KQpxlnwJTE00cs4HsOUv3EIwpm4WcBRMHPuVQ8BfGp2t7qzZAzBUzpnyTL",
-	"EhoSRTkb/i4502syXENK9K9M8AyEcjQjoszqXwXEeIr/MqzfMLR35NDD8UZfK4rAPJIKiPD0m6W1CLDa",
-	"ZoCnmD/8DqHChT4WgQwFzfST8NQJgkq6yBFGRpYicPtztuE0hJeTs8/wWcR0ZDtSGqIy40w641ZKtosv",
-	"ZlPL7sckhT9ImiWASomMER0H+YwSUQWpfJpolRRECLJ9uqhhKVZL2Ao6L2K8Drcfs92tx3aOwYubzivY",
-	"c1muL2gRuGebh81CRTdUbfsyhMaRo5kRPuYiJQpPNWMYKJoCrphLJShb4S7rx/4+jVq08pxGPjJ2oXe/",
-	"6Ilb+9svNFEgZF+KXIJYWr6VMXYwbKh6J59umOzxI1EkQPrZQEpo4t1hJAXvRrbmzL/TEOyAQjvQKS86",
-	"ruWzSl5BJcJirx4aYO0pYbegRyLgZH10ZNwtnk+oWyG48DgAj/yPiEDtki8Fq48eD6mIypuwoEzBCoSB",
-	"H1XJEULZYxX7imZgX9qTLMB/DFyOMozLUDCuhcMSxAbEEowGasnwZxAbnb4VpBkXRNBki3JGNoQm5CGB",
-	"AAlQYosSokDfK8UOSS4hWj5sdSGQECnvtA0a4l+ORpW8hgkIZJmb2GUs0Yy/7WBW7iC1Jgrp2Ewok0it",
-	"ASVUKsRjS0zrpINIu3xsSLaIOBSEHdFFLVH7/R6ouVi/M1yVibYbspwd9VNI+P4iJqPBeQjjwQW5ehi8",
-	"P4/PBxOILt6dx2E0Cse7g2opSemJT2NwfRQDK+qS5ekDCD+z8eT6/OLy3dX76yMI1i50Slr9bG7dsjz1",
-	"0fSlh5NUMTmsimI3Dg6mkw4eDgbOKIelTtG9nO07TKU86XipoOPUr+CQxk/LWxJYZIJNqRNcPsmIfaNf",
-	"vdin6n0Zq6Lpjfcnaek4Qz2PMp1OfI+uveV0J+GKJEuS8py1i7844UTV0jjP9ibffdaq8lal2JLnzPLc",
-	"Y8bGS7Wrmn+/4fvbu5v53Yfl/exfv97efcEB/vjb7aebr7c4wDefZr/olfvZ/KYZq1sEfSYySu+X9s9T",
-	"45bR8cjj33PClCvTPQWEUV4maAjH2CvAOaPq+PO+APY1i+pphUdLziGP8ZgaqaU5M2K0wDcgotyUPILE",
-	"TVjsbgkKo9uYl80bCVWjIsXhmogEZJhQpjibjEbn/1jprbOQp70OBs/u5yjmAqWEkRVlK+SsJgNUdcQB",
-	"IixCxLZRFOQZ7kEM/arvQwpModn9HAd4A0JaFuOz0dlIc+YZMJJRPMXnZinAGVFro5bhZjysGeiVFRip",
-	"tMZNQzqP8BT/Nv4Aalaf68xaJqPRSR3tUYGpah/7Wa+vzapIExBqXTRkMl1pnqZE6NLxAyjPmQArspIa",
-	"Hw0hF/qmVlBlkP36qQY0RsGCpGALsW+PmOpHfs9BbMvmYWr77GBnnx/Xddwxw5my7PN6lFRbA5oIIPvo",
-	"Vhd+G/p4VeeG/RlUEeCLE61/sDquifct/ROJysGf4T2ZvBzvrywTPAQpdZ+Cbm3ULAJ8+ZIKmDMFgpEE",
-	"uRbH9RMtkP9TOwNJkjqUNBBew3Shu14uvXhuz8Zx0Jieb3fjpDFgH+6erhc96I2Ph94b8l4z8tyIniAG",
-	"/0GN+swHPhddy8TXCK5tvhWaq5OBL/zO691O9G2Ts5GyIoYetsiWCehvujgIkKsNAmRKgwCBCs/+rmvM",
-	"Py2Id1p37ZZk5YYUMckThafjrjW+rAHpU8iVUgFOKaOprnLGgaeQ02eXkv63TXZyuZOuObuf6p+aa3oz",
-	"8zeHf82ppuGcpbNXS81E0/mGwKKMU6aQ4sh+FSgJeZy89Rnz6SnJ8x30SRmp+43qDZ+vPSHV0PJAtJOO",
-	"ho/u1zwqLG4TsF1nF5Y3ZqeG5d7sM7/RXYpaN3FuEotuyeq8UvHGzQGIEjk0k023Ze3H2Yu+y5WdoxUo",
-	"QjIPtSHjPEm2bxh+jRi2AEOE7Qdw4C+fdMfrTulax0Av2ltCvSiG65F4FJGHq3fx1SC+vroeXJBxPLi+",
-	"Iu8HV+OrSwIkvH430aQOjXZ/pNh4i+Wv2Q80ku1nRKkBSJDMIKQxDQ/5RUZUuPaF7vao8f8eup/2h1n7",
-	"9N4WsGjP0/WbCr+3+FNGbqi9pYzX7yrW7gdThrlkqPgAfy94lIf6P8gewgHORYKneK1UJqfDIcnomSNH",
-	"ssxNurtkPis74d5BQ9rtMx+tRfXqLtGPpSdLJCAxqFS82YS0vVF63mVH59WUArle3l2sJxX9m18ECf9d",
-	"JdXWHNndboyRi0XxvwAAAP//3YR0uIoqAAA=",
+	"H4sIAAAAAAAC/+y9e3fbNrY3/FWwdM5a75wZyZZ8ieN0nfUe13a7PE1dj+12JqfJ44FISMKYIlgAtK3J",
+	"4+/+LFwJkuBFlyhOwv7RyBIJYAMbP+wb9v7YC8g8ITGKOeu9+dij6I8UMf49CTGSX5yS+AFRfs44nkOO",
+	"ru3vC/FrQGKOYi4+wiSJcAA5JvHuvxiJxXcsmKE5FJ8SShJEuW40hFx++58UTXpvev+xmw1iV73Ddqs7",
+	"PhNvPz/3e3yRoN6bHhn/CwW89yy+ChELKE7EKHpvzOCBaQToVoAc/3O/d0oR5OgkwT+hxfZIK/VnKRLT",
+	"jykKe29+Vy19aEOlJAKcXF2An9AiT6PbJKcpsjSfUhRifkm2uqS+PjdCu2oZiKbb0p8yTuaIbpH6co+b",
+	"oV23W8HcF/EDwcEWV7nc4UbI1M1WUHkFF3MU8+1RWe5wI1TqZlsy8RUlYRpsk+pSh5uhWjXbkupb+HS9",
+	"1aOo3OFGqL6FT+AatkCs7R++dafuSiT7T2APrW/JFMfbI7TY3XpUytYaSbxGQUopjqdbh+aantcj3Dbs",
+	"h2nvJEwoYrNbco/ibU6At9d1iZeNAtlqC8KnmPFtCh6eHtclWDXYSOsNisOtM7m/0/ZKg3i/Utr4NQkh",
+	"R99TGIc4nt4gznE8Zdsjrtjz6ouoKAGmQWBabFxU9eL25ed8v2sT7hebK+ndOhvnul2b2rawrB6/TOdj",
+	"RD8Lg9d1vR5q6ZmwHSzL8VsXtnPdrk13WxlbPX6N5jgOEd0+AxR7Xptw0+Cy6711NSPX7dpkt9QuZC8s",
+	"ITFTAz0JOH7AYtjX+usNUI05mrNGq5jqWR6zmkhIKVysPhPQ0gIMjaJxZX7bPn2y281RJ+x992hRoC3l",
+	"SrrdJHm1VNkO11inlM8Al43kiCmLWVshaXMy1tgIV8xAj0ueMgOExhq8Fdpyfa5OWKCaAZoHi2RZK++2",
+	"aNIdrkWQMCDHhKMKYraOFy5ZRczo9+aojY1yimM5sp9RleSkW1pyjgqMbHWB7ax3obv1xEL0BOdJhLwE",
+	"bX/NvaS9jNU3c5KbqTMU4QdEP4O4oHve4IEaWlpyJGa20K1wt+luDY7WLXip2Po6ZfRsap0Mffll0hru",
+	"CwXslUWjKAI53CUTAGOAjVnA0r0l7iz0tqZTwAO9uoOtL56XsBcBvL5Z8lhJtrL8lf2uxwixadYvKFtf",
+	"61Zo1L2tTk2iGvCRsHW2tsRsCpA0cYUFMqax7SzQuhaxRDXgI+EGRtvHHrfvDa6UppKJZn20fi46N05i",
+	"nrqik/OKogeMHrdNrB3GdRpvjuI0CchcACVNYyUMAGo6cqWCsqd3K1uz2O3qhJaoql1j9tlW9yKb882s",
+	"cInwIncXDfNbWthNWeWpbsl/1N+gmG9Z4XK7XJ0sJk58r+Zl/QhboWZt9wGHT4BW0LD1bWap2dTuMtS5",
+	"XPfc14N1HSCL8si10fMOSponhM7FJ9E3GnA8Rz3bP+NiA4tRG6vJHQ5zL6UpDn3P50b7sfx7y2Y0dLTt",
+	"VX3Rzj1zu0jQeZzOS2uQNZ0no+9OXHmN+nbGf8ARR5TVTPyEI1qae++06zfGaEIoavdKfqUse7acvExF",
+	"zM/96u2YRVnKeZatjmfHVM68fevNxx6S//5uydBT2csIS5Ow8E2IIpT/hqGY9/oW7M3fjEOesrtgBuOp",
+	"fF6rE3cUBYSGha8maay+UhaQu5hwdIcZS+WXBmxN2/ZvGAQo4flnQhREOM5/F6jbAaoHs/oZufarjF77",
+	"VUaw/YoixglFoWRxzCPUe5ObX9+Sn4QhRUwuLgxDLLYLjK4c3p/AiKEihl0RxmEEoHp5B7zFMRoBzIDZ",
+	"jSCNI8QYQMI+CiYYRaH4Gc0TvuiDxxkOZiCIEKQM8BmyDYm5z+87DYNz+PQWxVM+673ZOzz07R2SxpzK",
+	"Z/NDvbj5BeyPXr0ajACMkhkc7AH9LAhIiHp9Y37vvemdncvF5xxR8er/+dPvJ4P/hYN/f/i49/xf//9/",
+	"+uZPLOmo1QjFk3utnkzk7N7J4eWf39/zPE7RVGN1IYqMQ476IKHkAccBAoQqyhe9ftMYvDtVOew2ciah",
+	"pwRTxJZ6p+VBEkHG71K25IBiOEfe0y6haIKfPGwVopjjCUaKgYUH8hHzGUk5oOgBwUiKzzzHXjh+uNuf",
+	"HMNhMPIOnaIHcr/kwFlAEsTag7RcxBvxUqNEI+dXToydBttf84nqvWBUYp5VGMGsVTax59dXAD0lhPI8",
+	"a4+Gw35vjmP7d+0E2vbsycPeUARdiGVv/iyoXWWq5zi+UK+NGuZdT7keWPXkqqZLnHkCEkTnmDFMYsDS",
+	"YAYgA//MUfTPvgCDf75Ph8P9gCJGUhog+Rd68+d/ggmhGridlkgsFX31bJGtc5PlAOjvcPDvuw9/efMn",
+	"8eHDX/7v+/d//i8vjDoxHCUeEYcpY3cyOqNM7l//fgs4AQzFoSRUtEQo/rdUBd6A7xGkiAJFqmxCfkT/",
+	"rIMl7OnnLZ4gwZHC4iGPLDkqFTMCcAwYCkgcMndmjodD2wmOOZoiqra5DNauIugGx9MIDVKGdOOcADLm",
+	"EIsFiNGj/jaBmPpokL/eGcktWyU1Eb43UoZoEzP/yjzxrrmFyfWcm8oixbpHH2OX4l68zBDzu4BEhJbn",
+	"7pREJKVyieA4QmCGYCjbcfn1P/YOX+2ff5/n1P/4fTg4Phn8AAeTDx9fPXuZFGayUtbaCPwsluaGU4T4",
+	"+/gmEU9LgafnFVPmCYwXdwbDCmIVFWwi+ViwmOBpRIF4tm94Thzg8hshToljDjzOUKwEqxyVJ8Ecgbc8",
+	"bD7s+z00hzjKkzXGkTjC/gcGc7TDEeNt2pkQwhG94+iJ51u7ncH4HixIKrFlIdZonDIcI8by7R4Oh552",
+	"IzIl5dn6HjL06gCgWAhKIbi6/FGg2l+vzn+UVkkO5oRxcDja+0mstT1bxgupfcVpFAkeUUGInk6TGYmL",
+	"8teeb3QJxXNIFy14UsjkDMA4BJxwGBXYcvTD3vH+0bJsWdiTOQbLWNassSGrOOp+fmPll9K3U09JzGHA",
+	"G/WGgqwY+OYnWUjuDlST4qQRfxqjqxw4A9K6xYl60gTOG6L08MaERAjGOZa26154tixPNMvl7TiisCKq",
+	"35o5bC+96RfEu3P4pMWJvWFRnuj3Gm6uly1iKbqTVonS6pylCIhfzLGnV6UPQjSBacTFCQj2hyCECwak",
+	"dQRgzoBUkYE2dDQaPuTTFQO4YKzNEDgJ4aK5L59ikw9ILJ84Cb67R4umtclia/XDeTJuJZ4HFHGhK+yA",
+	"Cw4CGMeEgzECFHGK0YNA/inE8U6l1nB3OP/bMP7H497i3RH96Y/j2+n3o+C3/fB/X8/eHvzr6hU7GaIf",
+	"9tKLY3LdiBSGLjXgD/6JMdEkm7FEOjYU5W/PnxKnl0P538j7ckopioNFQfL/9XoTNs9PY9PMs3XzLjAQ",
+	"sGSoj8ABn/WOIsgqbLhshpMEx9M7OBcCRX5OhzvDoW98LB3LYyv/9P7xzvFr3+McPnmbf13RvGzbeaMg",
+	"FevOQRKlDAgbujhGDR19wKEQislEC+eyFRCmRdDI7ayDI+/QfWqws/J57vJytcOtdhly7GAW25nU3ISV",
+	"V6gwP436d8Ye1Tbtl2Vufq6lQjJ5+dzasK+Co3nbzR0jXsmsathI8CeklgWFAVAfkSFmUpLPS4CVW+mP",
+	"FMZcW0LL+uTSGw0+oVVx5hY++TggjTG/SygOCkrn6Hjn+HjJHabXoOjBsXOQ6y23DoUtpOj80MhWgqby",
+	"qb/EhMpZS+PQWR5HEq00LVJ9MGQd7Pmh128fogpIbOd9M+Z6ioWpvkzuKjvA5crC/a9YyIB55FXsz4mO",
+	"Je1Jixiep3PXIGa5usghJeawnddT2yj51omeZ8vLl6uf4nJdak9xR51+JCDCAYpVaAZPqfIr5fSSNrbP",
+	"shhQOHX1A9m69YWty11W56TLBnio+Ni1BA4Hxx/+8qf373fkp4+j/oHfpVJYeU2+d5X1+VV5wDEEaTDz",
+	"wDNkaIBjhmKGOX5AQD1oFE9lb5FqmxQypNoH7NFeVBMb5jhla5+wzzXENzK4NuTcOcarWjVGPyZhLVNP",
+	"W2ilLKd2lwxbwtgA9GDU5Paz8CJxShoFX2LDEg4ao5TXcPfSpItzBIdNL9zCp4uwCp7z9pYPtUvoBDC/",
+	"lPVbVZT6XOvUQsLwLoxtw5mvusW6IZSbOAVtf+i9MU0XwJNQrlzffaD8Z8Jai/kMDKQZVDyN1OVMQkOJ",
+	"LSbyQbc3KAx5YD44wn+/N/CqAnom+r2ngWh18ACpaI2J5l1iLlUXxa/OEAsKX5/rzkvfeZ41NhVe9b18",
+	"50N2fWtTjmVKfTbYv8+UiVHfr1qACcQR2qIpgKIAJ1jHqjWbJJkwxS5Dt4pqaXth7UY+bYJ0WKq4vN0u",
+	"ymnCGVlZM3YwjTqqZzhO9E+i9kZPzYYQvdSaOcEt+QZ883KmFa0yd7UJODNvuyFNDzBKfa4bRAMUczhF",
+	"YIz4I0IxGErpQfighV8CAgUA2jTRRooabUiGkr+akXsXokhnbhkMYWIJBAm5FXDe9M3/udmPha2tQ1tK",
+	"z4eIVx1A5t5TafQZ73t0YzXOJs5Wj9nuXRYWIy3NmURVtU6yY3NBapQR12OIPiB6pxApo6x3g+iDWHKO",
+	"5gmhkOJoAdIYPkAs/VF9aQ1egAhyJW5qsgMog1rGCyHARpAxCdwO+cp1ptflRnYOVOfPz2Yl3ODZYkIt",
+	"9QvgM8iVKwbHTNstGBfKnGyMlUK09NetL2OqITXEQOhGHU7Lj9/DxDaAekNRs5/Q3Bw6qNQGf9x3vKae",
+	"0V6V0daGGvoM7uc3tzUWd+nCXyxhv17l5Cw4e9Rvku3MyMEjZMAGSgIcc9Lrl7ppdOd+UmN8lQleXpj1",
+	"G38kJ4eWK4vyCorznk4TUQqk4KgiSXPT1Ov7ObtxYmp9AZV81U7gMHuyJHB4HAmjveGw2mLpZ/vDYUtn",
+	"gttN5Us6ynYJnPAJSMUdV/QXZEdLdubnXAPuvvM6CopQ4PUW5CyhedeB4sqCFuEQ/6EGXT+9NyHjrKUu",
+	"9+dZrNmA0to37p4E5dDig73RkZXeZETxdzm/9K83Z71+6QRxhToTYrzvDzraxtlSQPpC4BtkXLj383g0",
+	"hwuNSbUotKy3fwsm10aszhbrikrr1AOMcChVdqmnM5BEMEAhUFc7FP1yBk3AQ8kOu7zl9ddYuC8yDycI",
+	"ZpBOZaQ8Ck0cjAGEbMRHO4cbUhryoGVQyOWUOpjwq3Rn1yc/3Pb6vZvzS/HPyenp+dXtudggZ+enby8u",
+	"5cfzf1xdXJ+flU0ZWWoGB4QKsa9RBCbqR2lTJIkKTJKaWEDmYxwbG8zJ5dkOOBPyxVi4T9TzOA6ilAmT",
+	"sL6/IJgi9FxLKF4H8mXXDrWzj88wExuoD9BTEKXqPskK94f8fVgmXKmTCtDOGArC4PXBBA4H+wEaDQ7g",
+	"0Xjwen+yP9hD4cGr/UkQDoORRxxrRHkTcXQ3oWTeSiKzb3CyhDi6GknHq5BkBNtM0i53Pto73j84fHX0",
+	"+rhNg4wtPUvOOy3naQ6f7qw4luexn+GT8NApnNGWizoHUDXs/KXi+swcx5Wd4/gTd04eEA19xpxf4miR",
+	"OSekRizRRD0vzTmM4ygCJEGx3eUIUxlxkp9oxxNs71XfbeqC3ooeLjN7ilP7+ZNdBhfLGFXxJEdz4LTN",
+	"lneALSnPaYivE+f8TrWlNvje8hvcJ0RWJPn+FmXIcCnt+nMo41+qzGVn9kMdB9b5EBXBd4EOS/JF181N",
+	"uEYu1ZdcJx2VynhlIF2VQq379WLsrQPrYI7jlJk/EohDCUD6bzPsPojRFEo4k3ceIFD3c8VNCPKIwtyQ",
+	"RsNhw6hENx47fpZcKEBYhOSqsamu8vFa1ZaEZfe7Z3tXbmK/3XwzFsXCWCX466eYjrx0jg/bab+VKXIp",
+	"hLBWFN8i2VIu7mjyZkIpMK1iJlzOiJnJei8D46pEjKpaKdgxuLoTOUUxonCdiVSXl5aPPl7T4ugVH1yD",
+	"oxf4TDQZmKcRx0mEhWIl3DKYAxloKGU/IFIhZprwVgyXFVHQCpTMBrTI6Y+NpiSNnRPMIM//x0QzhEoy",
+	"iyQdtgpFVAZNtdIOQjlWS2PsDDP7piLxpDpY0ayhN9RikLNarhdxsQNOU8qIMRYIqd9E08nnnb52nOiM",
+	"yuiLgiF3kPvLmYFBaTasbXZQ+LsANKLR/DftIj6cKXVDM3xf62gO5yd5BedMDdf3dfmNM9/zZ1VPS1Hg",
+	"xFDs/8EzKvXx0kxM1U820qQMDY5p6ur88uzi8se7q5N3Pyvr1C+/nV+f/Xre61uz1dXJ9e3Fydu37+6u",
+	"Ti7O5Bfyn99+kf+cnlyenr99e37mui1znfo2/GqB7Rv3IOqITYpgKLTfTZ2PLR/XWfS8h5WpGmLkU8hh",
+	"RKYpyvRTzITRL9KHlbhbxVydte/iuFRsTX4psevlHVpt7bMtMsTlX/OdVY4+NzC6Lsi55nwYqrj5xuUQ",
+	"DVAlJjGPjG1TTYmJKU4fASJp1sIcC4JwaV8xwWgrmiX6vUeKOcqGvfSVA+eiQcUEZBmKJFramwd58v/W",
+	"dKqXjsb6oObjneOjNouSvw2RH9MliQdWjQlRgOeZFiRPqjQRgzmwv0lfQ+F01rcqVtQ4y+ftMpcgDGtu",
+	"/RZEjtlbsOUGbk14SzOWZmmJq8YJZOyR0BYRq6YJ+4ZvfI2VqkpjzdLZZPN9cflb0WfljWBkiN8lJMLB",
+	"ojlVI0P8Sj5qLXhonkRel99NmiSEcgY+Xl2f/3Dxj+c++Pju3bt36l/x/59/fpa4jZ5gwKMFIDECH2/O",
+	"//YsDLHiw5tHHPLZs9orMxIp/MooNC0PVMMD+c7hsydZTP2i2Cw4lprCvLRcpDpbTYyeuKNWlm8Rq9/U",
+	"TQX0xEFIglQme34UFmlttsiRf3H522BvuPdqMBwOD/b8569hi0+57mtObj83N76pLqQ99xh3pqgPEjhF",
+	"d1pvMqkYzN8UZRqANDIlusl4Ko4O8apeAJH0jInvtYOAKTYGgdYoVDNyie7UdyLtWYTAnFB12DIwIVFE",
+	"HnfexyUHo/Oaxz2RwD9SpHsycpHkhUQTyBjA3KQR0c8lkMI54v4cMOJFfwRlNluew0yxIpnIjpn5cMfw",
+	"v5FVlCuaFM/4e3QWpK5LNYVzyIOZWB1BqXb99gEMKGFM6utyZL3GG2bZmPyMpXKYtzoha8yCnzoQ8dNI",
+	"53PEZyRsmef9Z/mw2f7CyLoUuRRNkKDXLyy0CdrW46hPEpqLYtfx0dnV6kz606RnhDQGtZenwdEyxzC+",
+	"v+MUxmyiLCaQhvIfNhP/zFBwL/taJNLHQPgMUVebzDXum76KmvA1fFtK7KgchkuEqVt+X0Ue3RR3VcRP",
+	"zkksMvPBzJ6fd63F5LEqcLKeNZ3LmNf/UKfraDgYvR7oI7YxmcwmWNlyrJ7DGn50Q/szY5pOdOrYtrJv",
+	"lMfDw31Vsf5aUfcwm8hBivx6wSqQuKFb/5UqCbtPxfeNen6DPtL4vtAT87w0IyntNWqUzc6Wmutv+Vv1",
+	"uav04o9e3yxXM9Kp9a6MCK1b9hUDF25++rWfBSjkSXkZ93LLJXorANhOjt2NikuKAfHqQWDrTGRZg6xb",
+	"WiQFjHDsijnOVBd2SzHe0v7lpqnIGdTkt7r/fNIlzBnQbNUiS+cpiZkwxMTT5VdLb8liglsS3IN7hKSz",
+	"XnCvNPUJ2RjOSTxViebMzOXOrNNfLm8Gw1F+IK8OGsdR2PJ+c5vP2gYTa3/SM2hsTmq+9eTrwbaJTjHo",
+	"UU69IBqbI8hSmtcDNbo0afqf3Hy1t6FwCc16znhrYEqVtqmNzGkWr01CciM82UXCMX914FV26vLEvFUp",
+	"MTiMTO40mSTDutz7NrpT2E8l5hV974WpPTio0j1co3TjsEtxKdlNhdwUOM3mKG1ah8ozYxshjz7Yth7x",
+	"HwTdgtai6P6I0H0kVQIS85n8tECQRgtXQio34xtwqXJL+XRIObljqMqY+lVcaLMhBi1UfROKad8BjIAJ",
+	"pN4d13LMywVcZGtWdX1AWGxoGt/5jtlyEkPxeJbJEI5l9gsS61AM0WuYRjLJqxiMSfVf5OzmJKa6kgFH",
+	"dM7uQriouCdrOmw9DTfmhaZ4Dh0dt2I4R9ZfLqBjMze1/OF2DtiVr1XZefJObd/ZuE7sQ5HTl7t5VUSL",
+	"F3cDq2KNmmXlcoWwJqHZhUUrN3srVMjEFA5eZNHchRSy8lCV21P4ewHF0xkH8BEuvML0NxDIuyYu1uS5",
+	"r4Iju5L7w+Iynom0siahgli3LLGsjVEPHWgtL7gSeJUPfv/VoZN2zJucfdNA+EVEGFui6/Fna5EqywVQ",
+	"bDT+gSJGogebVh0GMytyY0f+WD084uXHB7j80iYHYy3TiNKTtemmN3tXoHjMewPwagd84wBAMWlJWzGV",
+	"E8sp7iVRK9UZkY41ZEHs90K4uCOTO6lzeKw3cGHkSfkE0LqJhEalneRzrOl4fhETNSeCy8cwuLeGCHPR",
+	"l0wAmxHKEVUNsh2Qu5c7Q+Yx8ZFxSLkE4J0c1o6aaENx2HTlOKszLi8cjx0K2lxvnFgtrC2a59U/qfJz",
+	"RB9M6LA+p0b9Sh4QaiLr63kT8QkLWeTKHmGPxHsyHe41zZac5tY50ieYMu7c1Vhu20wc7dXpt37feMMq",
+	"rS05kelrdOSNUmd8enN1nOS1qiEii8Q0CoqlEiv19OYf95M5xYy3SLdYHQzU6BGqqkPQYJJ0Y4qcV4/2",
+	"cm++bmlMaxV3ZEqxXntxkkwmDHFHuqsV6MKiOKdY15Ttcy79aCTNF9Ua7FfKdwP5p6/+ToIgv5MVjtqO",
+	"UkVqyKrHemAiygAwWavHfidEBKnIq/tJY1MW0Az2qHKso0pdvsom585y3RpVl9KhaYSWEfSdNc8VoBgN",
+	"G5I5qY78g8wHDDnIEYv1qTCy2Ze8QEEix9OZ9wpDdYctZTqDRUh2wG8YPSLKTPg/DPumzg6MuYghYQTM",
+	"YSwif2wZsCx1aR9onYb1rafhfexGGQtGiUNAHmPRi/gIwzmOmRyAbF3VolSHqV4t7d8SQAlUYUq2AwwI",
+	"iYBlJhuj6H2sGv4OyGABQEmkz3rIGJ7GyuUAYyBWHnJCVbSRmWX5sqr+oinu9XsPckbEt2Kg3kQMNygO",
+	"22rtc8SYDi8q5cxjMjtDTLjOvRBKzU1OhQ83/ck0cskVCy4iFGu9CjNTZRLgmHEEQxtRXqwm0xTH6bNl",
+	"5Eo4l08F55c2yWwkrqfjCAdVVcLkOViiIMLxfR8wpJhJtZAl0lIsoG9x158GKMvnkhuHbxOrNLilEf52",
+	"cmtiFQnV3Cn2xMVZX2VUgbxwtVTNuyHK5Ao2q6ardu6ArGFWCNgT+pG6h68f1reA+gDtTHfA2floT2df",
+	"2AEnumgWUDNgqpFirjZIU9FNUxbaoyXUhUGvYrlfN5xh1TzzbtRAOWy6IThAz0+lsfITud9tpfOmC/nO",
+	"KtUbEk/1k8YHR5E22oQ2mwJH89wNPOkWJ/EgyL3qtSeWveS/ndwuX8SSQr5kTtJ8BNdmHbK0Sln4VVq6",
+	"T52LzLW1w+Y4dr8d9V9GauwlKoo1SO4vIXV5xSI5rsm11mgpU8/KV3yr6XCC0taioy6YqckEuqJi5415",
+	"qT62yrEsy0WvXJzJsonZ7biyzbTu1KwLUVku4mRT15oUAzgH9VoM0O5604q4vTGSdSnXlS8orS1rkBau",
+	"E6OgtcnSLxssnyVie6AgpZgvhMF2bkv1/YQWogKwd+OcXF3IQt1SRffUClYl/HSt4Hu0yCoFY9HCDEF1",
+	"sUhR38u9nc0StIUAxwhSRM1w1F8/mKn9699vtedFNjYulOedcZ6oxM04nhDFgLbipl7QnhBEIsRENlhO",
+	"4r3hcP9/puKnnYDMS8DUE+SLW+1SmXWSP7C+q9hKBVWAHRb1/3d6md5tkvP+LN5H8srRydWF0BcRZaqL",
+	"0c5wZyh6JgmKYYJFUSv5lRQvZnKRdh9Gu1kH4psp8sZGaa1AP7sAE4TCvirqSlEgupemol6/ZxUboYf0",
+	"fhv9iPhJ1kO/Z6++iLvwH9Vq/pEiusgWM4QcmvXwyIuTTI6tPYf1WI3Y69ysqTYei0tdzsWiRp9A7vqM",
+	"bXbvsLJd+axjejLIVFd9yZNlfSG5IEQo+UV/+8HkMWZqlvaGw6oZss/tZitjs3k/93sHw6Fhcq3Iy4jJ",
+	"QC7r7r90CaRseRpTjGeNP5d2wvcwBFpFUH2Pttf3rzHUuIFC1fn+9jr/gdAxDkMkz6yDvb1tkp1QEiDG",
+	"ZF3uc+VGfO73Dre57hcxR1QYnHR6/HOdHr/fY+l8Dumi96b3I7LwAl0M4VDYUH/vOcDyQbwp4SzBg3u0",
+	"aAFm+giy8b4pUybuR8Q0nAkz3wO5R6F6zmYlDVUeUh/UyQOH9VbajOrdbid2O/HF7US5acyGcXfg1QWQ",
+	"DC+SuiSE8arkuMLsbWQ+IfioihwDTgbqEwhgFLH+svLgDhAnq2gUK6/B+9iaIKXtGjNgdtgOOBV9gDkM",
+	"kbJPYnXLOMJzWSFU36JgAUlsaXiJDELwdVFi5318IjvVhjfVGoyVYdWQKXwK0pExRmCKH1BsWpZ3lMUT",
+	"UwpjzpSRswgmatoU2T0lm2srWjWSmEcwYrvu+44JrvdcgqZRMzTlynJ3ANUB1IsDqBLK+EGqICTsflQa",
+	"4kX4rIArQtxbkEdIAU7jWjCQLhG5k/mj0MZYGgQIhcwnHKg27H4uqEE+848joxi1VyhumZ5kxt5zVXfl",
+	"sM5m3olcDEaTYXi4Nzgcj9DgAB4cDF5PhmiwFx5N9icjeDx+NWy+7ORRNA48Gr7GQKrkpw4qPg9UDA+2",
+	"1/Ml4eAHaZXrQKoCpEo4Ug9SKZ/tRiLtkRicX7g6f9IBCzB2q+TqgCEpacFYFgdhDEi3sXwAAh1mpb7z",
+	"wJVMt7SK3FHM0+QRONroQilX4WWdOtRtp8J20rbm3pvfP+RUFCKsqO6OEsbe0m4iKa/eTmaDttkeoqEV",
+	"9kdF7KRnmxz4c07lhgakftOdsd0GabdBFNPW7BDNXW1OnAInyrNG5j9QfycQU6WelzmWcBVwFIdODoWU",
+	"mRgkr/icbZtPu+2606nbfJvffNfuHmBNe1DFllZvQqPtquhZHTAqt5OIMxV2rYpNpBteaQOVou5XsyV1",
+	"m6egHR5vr/NTEk8iHPBu17bdtXbD+PerqocysPUK6z1Obj2XnPdc3A1Tl6lq3Oin8u1LwrfrR8+67Tzp",
+	"tRZyszgdrHX28ZfpwHPxx4E0xbtAIYsX2nY/Bpa/ta3ci3TCYQ/dboyfjdmkaLXA1t4w7vThN467A25p",
+	"ID8cD9HryX4wOJgIA/nkCA6O4eH+YBgehqNgf3yE9kYrGciXwo8OPjqbeQdcuRggWNjuKwPXbhJOKsHr",
+	"Gsl7owV0EcEIEFyd/ZAld0+ZSXI9plCVMLJ3BWUybHnzVMWn1sLdVTj5mhGvgon0GmTjsc2PcQyp5yLp",
+	"87NPCnQXyaxNr69Dg+U4TtUABmeYJTKZsi8f2E06nSImy6vhCMkcmvqOGuQcBjPR7HfyN/HTf7/vnV4O",
+	"5X+jnSScvO/lJOzSwDsQ70C8A/Ez8hhHBIYF4RAyAazNgG4i4ps17EgkJ3Aj6JWuCMUZwvEciewpMuNF",
+	"9pAMARMm6AgzXhnYeWrHsE3VW3f6hSve/R4jlLcl1pYa/MQKu1nQTt7u1PWXqa6LkimBgzsWJe13btSt",
+	"P4D0NKsDumIIqWlh/SBS21C34T6rbLRFh8OJjom2WTFmuhASg3OdVkRkpJC3r82B1YFCQ4yrdLI7BX59",
+	"uFAUnXY/mo8Nsa43ZMJNthtoexGFPEGIGUwSecnY3kcWYWvjlAs3vvDhU8Q4oSjsuwnSmawLIPLYyaxN",
+	"6oJyEa6UXObAVVu1OHvDpxNboltqxMOx0If30OAVfDUaHIj42ONwFAwO0B48Gh+Gx5PR/qaCZA2xerpD",
+	"FT/M2CSNokWHjp3m2GFe76yIRJVyUJ1Dwmy08UJiBg7r9MCJWJEGNfDrw6dhJ8B1ENVB1OoeiiZ8SkR1",
+	"TI8QkCUcVJcEJxhFIVP5X5xGi2BUyN/0NeDRkuppfgbWDurs0O1bR7dOMf5CEVghAYBrKcW7Wnetjrr9",
+	"NQ51xnUUIVOmz9WRr2UL6laoXVh52xszKVoaRU8sdkwAmkxQwP23ReVYOnGzA+QOkDtA/vIuukr4ao3I",
+	"JsVxCw+vfbQYQG1ycdREUJ/bbrboxDWddtHT1VBvF6bD+s4Z+zKdscjBDoNkGZ40pz8CIYWTrBnhUNFB",
+	"0dLwKDOuhiDC9yhfiTgru7NTmSXoPMsQv7QWbd5d272bNdRt4W4Lv9j0QE41Bd82Lgokux/Nxwa/qXFU",
+	"xM4Wtw2DgMQPiKpaiJy4tbTE5r9HSY1P1NndLZVAh0aPEpgR1DZKeDJEr4MRHOyNj8LBQbB/PHiNDiaD",
+	"V3AUHo/3gyN0uLcpn6gh1qjKHYp0Sl93H/bz+18bgbPOAZu9nHlg6zW0rw/rhp0E1WFf519d1b/aAn+S",
+	"1HupSxbllVAhK13LwDRXu8rEtV9EslappjETz4ZCXHEnQPkcviq8+iSaY4d7He51Mt8X6U5dT1ne1Tpv",
+	"iyxGyjRmNGKVTDuK8nYwFzt1YZQAJXmfgEo3ZtoRQcfi7q4oxGgyeedayH2hHzC2NnCGRCkXnaQMPSUC",
+	"iO3TzElbZlV7Xa/FEXaxq/hDrhwWJK4w5aknv+UTpTAFa5skbVXU7lzpzpXuXPnsRli1vfMQmTeHrnTQ",
+	"MBSH1afMz5DeF7BfVwYXcK2qRcjfuVvGVx0abj1fdd4wEQQQqrOBiUR74rRS545Nb6mrV+syFALvvxMd",
+	"hiZrRDYQkewSUKWiyNbEkEIsV1JFHyQUPWCSSlfRve/YEFWVv2mriVvtuUP6Duk7pP/8SC9LvbfWH8w1",
+	"wfrgH6ER2Ce9xuOL7NdaGFSROH3ACOU6X/4Ux1IJyDQAtgP+jvmMpKIWWMRVNglnCLpykEkZsUL8UJBS",
+	"RqhHLSIxx3GKAJxwnY9IhuzIQFJ7rMjSRjF64neqHbeSPkMRCriKQ5Wvjhc6kGgHXJTHn6t5prQY1SYD",
+	"jENRX1by2+NM5MfJ0Q9DUQoNnDrK0HyMbf130beqblQqTtoyeEqP9ptIgGFqKW8n/4Xhg+7I7MI1Xm76",
+	"CwfwzQFiv6qOuTqPw4TgmAujjgoLdfQLfwjVhf19xTQZ1tbQ2Sy6JBnL9Xw7M2dVLpVgIsvlSwuktWmq",
+	"x5Qo8AgZgBFFMDTZoztAaZU6o2xrcDClIJPuftSfWod/maXiZIrkjQKbAhdzNGeVYV4ZArXU4TMyPCq8",
+	"HXVLDT4M4fjo1eRoMDk+Oh4cwNFkcHwEXw+ORkeHEMHg+NVeuKkYL01pl/ai09w7vGoKu6oFq5qgKwND",
+	"DQFXXynqDDtBqwOeDnhWC7YKEYc40ikoWIICPMFBExBVpLfQsQTy1nSKgPyDUGFS4mnhfktlkNXXg1Er",
+	"ZbhopVl2gNcBXucj+TKjrFbVR9eoPmXKTUkPNOPOKPqARKH1BtQKjbUFqb4V+bEr/NQhawdwlUb8EvCQ",
+	"iR/yCqn/a65Ti8cYmevY0L76rNAFU/BHCuWUCClTdM5mOEmEXVV3rHAvAzv11OOMRLKRR52tJ0ITDmQx",
+	"F/A4QzGIEZbGPGnDAzGhtuE7OFfVN6nO4KaigRTRAmLVzwGZS+oVCuovw9SMPBvR4wwHMzCHCzBG4iUQ",
+	"oynkoq6BHIjzbM4CnEAcvpG/hngyQRTFgQxA5eIl8ohCE+GaBTQxVcO3Ittlzq2xSomur0UK12nQnQJd",
+	"6yZC72p9dbJ4J4u/oKPqQpwKS5YZ80rkIRIVaChuksfRA6ILADlH84SLqwEywZQJEPWK4v3MkaR7WWgz",
+	"Sq2QfpaN6NuT0TPiO6TtZPMO8MqyeQZYlZJ5S2PEHNL7gZBCq0Pxr1FAaCiDEheykmJAHlTQQ1km9sjq",
+	"ogcsC0gI2VV2VUY+Ee6voe9KPdE5tjrE62TLTrb8TFAr7x+5CZ8sdC0JsBo0W1h6zZP6hpPQ8BmgEnzX",
+	"tflemUF8e7hqSO+AtRMlO3wri5IWdVoIkv0mCVEEA8p8y5ByDCPTuDTYGquluqqSZhEGjgQpfsmCDPI4",
+	"VGvp1Lv8WzVzavLXtnHadjqs7ITQTgj9/AnZ85r3CtJnOKkUPK9RHOqbio6UC+29ylCU+bY1+kHKjMo/",
+	"pjAOc5cepMtN3s8PEa2XRMPJVymEVjCNnv9sPLb5MY4hXXg68F40MQtkVqPX780QDPVdzFPV+eAMs4Qw",
+	"rN4rlQhNp1PEVK79CAExi32AdqY7wqANg5lo9jv5m/jpv9/3Li5/G4r/DvZ2knDyvpe7FFsadXdUdGJ1",
+	"h9hn5DGOCAwLpoOrsx+Wx+76BCnnsraJC97FSAHreBIwrva4uPZ95qbtUhfClclWlOZ40Bg/F61dnV+e",
+	"XVz+eHd18u7n88vb7wqmXaYutJkCnAxxHqEQLJCtK6zytQjDRVUOlG82SNghvgsR7qC4k9q/vjQqK/vk",
+	"HkidO+43gsOyn80pWAciEk+RMMIsxFR5kFc00V0h6+Cyg8sOLj87XFo8awGXKrlCK2eaftJW2IQcRmSa",
+	"yuDhEFEUyvRKcO7Dxx8RvzI9bbHmnO6zKzlXY6DWy9KBd5f/6GX61JIMOAyOWSyp9qCdhKF0kKWKyCkh",
+	"obxwi+iDq9obEKt0h+muVk+IpBtY35Nk2un26beSEKkTdVrnM0rsNvVgREHW2f2oPzXkMrpGc/LgNA4m",
+	"lMzzsLED3soEyBMcCUudfABzW8wOjFPu6I+BdMtz8ghpyJR/B0aIfQdCBAOOH4z/3vRnUllyIpP4i9/m",
+	"1fXxMqRqqX9mc+bRP+0UtdQ/RYrjvWAfDV5PDsaDAzSEg+PgKBzsjQ/RaLIPj8PXB5tKnKQp7WrjdZ6S",
+	"DgtLuZLqsbAmVZKBnYZUSV8pzgw7EazDnQ53VqxL1wQ69WmR7Osl45IRsMz17oKgZcQiTIHTsEpmTmX2",
+	"8spsSl8PjK2UTamVWtphYoeJne3/S8ymtLJCvCtV0kp3wE06L9ejs+Bd9KGyPkiE3ptSiuJgoUNl+uBB",
+	"eShCEMA4QFHkvKHqMcisIynfqZVAb+RIv2j87m/B+yGnybpAtuFMkD12J0InJXfAnJOSBQpJeFUpRVtB",
+	"dDqOcOBWfJPl1Z4rAdr0kxVXy+IXZTE3WRGCAU52wCUBgl1RzPXEyHAXhEIRupiVgptSKN6AMjt5FSTL",
+	"cbYtwHbrlpizI5XDkxGOhcBLP2TLwdXC9Vdajb7b2C9gY6MgpWJIb37/kIu9wOgxV9gRsiIr11QAq9rt",
+	"u6roYnUI20m5CHD1xu+DNOY61BnFoblrIov7LmRqYs8eVz102/wb2OadRvdy8UVv9NY1BSsRRddurYYU",
+	"Xfj7U2KK7qIDlQ5UOlD5jKBitnprVKGiIbExBu1qlooNad9xjENzwrj4AcU8WugSdWFd9pVr00h1jdNP",
+	"Z08pdt6FlVYjX2mhOnNQF1/6MuNLy8DkgJ/lY1swuD4ptxKSOJonkVNuU1m1pyhGVCEcJXNAYgCBICdM",
+	"I1QoSJw9ChkIhdGciecRDGaAprEUqFTaF5kw1ZPVmuLpjAP4CBcqXzZMObljQjTDDDDEq1NdF7fuKqGv",
+	"xTbWjn4tN9jBSQcnLzUatQQpTYhSKVftfqQFzm9ZgLM8BpWP3yJSAY2cqNXqEFMPNLR0vvlmxKOXlYlt",
+	"6Y8bjveDvfBgNHgFh5PBweQIDY6Dw8PBfjhCB/B4fDTZG24q/PS6SEsXiNq5ujoYLAeiLg2DNcGpntaa",
+	"AlW/QbQadpJUB14deK0dzboCciWpNyNcEsFAGdGtUihzcmq9T0UCeGS1TB3Uka+OSqjzAwUzGE+RCqQS",
+	"HcToiUvtEKssx/MkteIdJyFcVAfDfhtQ+UmV2A56O+jtvCFfdtDsJ9bbdxOYshqX6w0nicF5ZxDM8a2W",
+	"hWAJ9iydI58UfCU67OTgDow7MO7A+MsAYwlZ28Biih4wemx2XGNVFC8ObeV+e/FBStxO8k4fPj/iKLLC",
+	"uw+i1Ti+epDuFym4lI5wQQVNYwY4AWZJ+l4nvsyekPPiW7f4oeP33jtocHtv5LSwq9YdGp3xpMNug91q",
+	"V4A0CchcIJLY2RvGbSXs1hXXE7/LrPspQ6HPtnIt8GaOmfj5cSZyt4vy1pCZVyBFgN3jJEHeitCqh06q",
+	"7qTqDiA7qfpLKXyiUXFVsdpUJtk1tUoab6Cp2iUgRBziiPVBRKakDwISkZSqknwTQjiigAspOqE4FtZq",
+	"EtuSHBUFnb/XA7jRI+qtglPFRjqc6uKJXqQvqlQayNmvdgdU+p9OZTggZujT7UhlPPVuypUSk5R35ppe",
+	"l26rd1v9S3FBtNztxTNZH+UDdbegzeFsndFS4eFEX0sAMXp07mdwMkV8ZirfQGOgyZm/7H2G6hJll2ZY",
+	"ax3ZpVa6jdxt5Bd5ZhvTr92OKx7eJJ7gaUpNUlg0wU/9fBgJRQxxkJAIB4u6rbwDTnS4iCprQhHjkHKm",
+	"xYI/UhSLeDoUkUf9ehZ4YmpZsTSYAchUn2LoAIKP7969e/ecjWmBII0WAE44osKoEiMxT/KNPpjDe8Ts",
+	"5MjbXpjEYCLKbkmAORgeA6PK7YBfmcpjJMgGhGadSAoY0qOPkTPkBFKbNrc62qUWllYSWjzYtKbU0qFd",
+	"h3ZfjNiyLOAV5ReK5jg2tTdr5RaaRoiZ6DnRkS7kCmwTYEIoSOME4tC90OWND1avrCWVFBvptmm3TV+k",
+	"UFLcKKvJJG5Aa/Ne3AFme6hrjeayotiihVqYfXB1cn17cfL27bu7q5OLMyne/PLb+fXZr+eORiLedErc",
+	"My6866EoQgS5uk5OhCQDQQgXMvegdfqcxADNE74AkXTspzRmDmiQyaQuOtYDFCvJCWW0WDuktIOfDn6+",
+	"DClhSQTSQgKHTwMKeZsUExw+AerG5yxbo+wWPl3LrraYVUL32SWTqAY5sywduHXg9jJzSFjkcaDsFj6B",
+	"a8hrM0YUqpSZZtqXJ9NbY/XyZLqBtRM02Ha6Ldpt0Zeal8Hsr4pdWpQ4dj9yxdVtC4XZ/eurFFa60Zcy",
+	"GYaRFfyHkwkKuD/oTF2qzrZ7y1gzh2JfGjxDXsvIsv3JMDiCr18NRuFrNDgYHx4PjuHB/mAyQq8m+/Ao",
+	"2BuPNpVl4dbMpZr1UNifBbNN0ihadPDSxdt2wJZlWmgAtpq8CubNpmQKXyvyDDvRpsOeDntWTZTQCDxN",
+	"hb8s/pBJe4HJlP1Sb/IZWoBHRJHNICq8ydXm3K8IylYyQrdS+Tpc7HCxw8V1kgk0KZv5hMwfe2MEKaIn",
+	"KZ+J/MzPfUEJ/gkt7DciZ7Ps14dWunQMJjFQD/X6vZRGvTe9GecJe7O7CxO8o71hMEl2AjLvlS9l3nA4",
+	"Vf57bxtM/bzja+uDpbPY6C8GhhmgKJIIzYnrnNdIar8pj+tnGMOpk95U2971i6f6a9+btxQG96YzIOts",
+	"Y2mit2+fZN+VX8+CsGbkMQuEzmdpzdqy/otKEnKHnLz9WrT+OUPLGKbc3o+6dzuR+YyyygaR5aLNGvVc",
+	"8/DRTSkKeLGgm1ATAopCLKgUtMwBjoHQyQGh4mMCKXcWRj4KLomfhL+lhGeLqkLQAxI/IMqBKnmCQpuS",
+	"nAEce9kmS1C+wqRPCQlVx4Ll8w3bckzldq/RFDOOqAyiF3SLFuaqM4YYE8zucJjYwrWDO7m6APdoIT1X",
+	"au8NOBmoT0B6WfQOchq9ugA/oQXrPX94/n8DAHSBgOWx9AEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
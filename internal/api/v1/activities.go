@@ -1,7 +1,16 @@
 package v1
 
 import (
+	"context"
+	"net/http"
+
+	"invoice-backend/internal/api/server"
 	"invoice-backend/internal/repositories/activities"
+	"invoice-backend/internal/repositories/activities/enums"
+
+	"github.com/go-chi/render"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
 )
 
 type ActivitiesHandler struct {
@@ -13,3 +22,98 @@ func NewActivitiesHandler(activitiesRepo activities.Repository) *ActivitiesHandl
 		activitiesRepo: activitiesRepo,
 	}
 }
+
+// RecordActivity stores an entry in the activity feed. Failures are logged rather than
+// returned so that a broken feed never fails the mutation that triggered it.
+func (h *ActivitiesHandler) RecordActivity(ctx context.Context, activity *activities.Activity) {
+	err := h.activitiesRepo.CreateActivity(ctx, activity)
+	if err != nil {
+		log.Ctx(ctx).Error().
+			Err(err).
+			Str("activity_type", activity.Type.String()).
+			Msg("failed to record activity")
+	}
+}
+
+func (a *API) V1GetActivities(w http.ResponseWriter, r *http.Request, params server.V1GetActivitiesParams) {
+	var (
+		activityFilter *activities.ActivityDBFilter
+		page           = getDefaultPage()
+		pageSize       = getDefaultPageSize()
+	)
+
+	if params.Data != nil {
+		if params.Data.Filters != nil {
+			filter, prepareErr := prepareActivityFilter(lo.FromPtr(params.Data.Filters))
+			if prepareErr != nil {
+				server.BadRequestError(prepareErr, w, r)
+
+				return
+			}
+
+			activityFilter = filter
+		}
+
+		if params.Data.Page != nil {
+			page = params.Data.Page
+		}
+
+		if params.Data.PageSize != nil {
+			pageSize = params.Data.PageSize
+		}
+	}
+
+	result, err := a.activitiesHandler.activitiesRepo.ListActivities(r.Context(), activityFilter, preparePagination(pageSize, page))
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.ActivitiesResponse{
+		Data: lo.Map(result, func(activity *activities.Activity, _ int) server.Activity {
+			return serializeActivityToAPIResponse(activity)
+		}),
+	})
+}
+
+func prepareActivityFilter(filter server.ActivityFilters) (*activities.ActivityDBFilter, error) {
+	activityTypes := make([]*enums.ActivityType, 0)
+
+	for _, rawType := range lo.FromPtr(filter.Type) {
+		activityType, parseErr := enums.ParseActivityType(string(rawType))
+		if parseErr != nil {
+			return nil, parseErr
+		}
+
+		activityTypes = append(activityTypes, lo.ToPtr(activityType))
+	}
+
+	dbFilter := &activities.ActivityDBFilter{
+		Type:       activityTypes,
+		InvoiceID:  lo.ToSlicePtr(lo.FromPtr(filter.InvoiceId)),
+		CustomerID: lo.ToSlicePtr(lo.FromPtr(filter.CustomerId)),
+	}
+
+	if filter.CreatedAfter != nil {
+		dbFilter.CreatedAfter = lo.ToPtr(filter.CreatedAfter.String())
+	}
+
+	if filter.CreatedBefore != nil {
+		dbFilter.CreatedBefore = lo.ToPtr(filter.CreatedBefore.String())
+	}
+
+	return dbFilter, nil
+}
+
+func serializeActivityToAPIResponse(activity *activities.Activity) server.Activity {
+	return server.Activity{
+		CreatedAt:   activity.CreatedAt,
+		CustomerId:  activity.CustomerID,
+		Description: activity.Description,
+		Id:          activity.ID,
+		InvoiceId:   activity.InvoiceID,
+		Type:        server.ActivityTypeEnum(activity.Type),
+	}
+}
@@ -1,19 +1,52 @@
 package v1
 
 type API struct {
-	activitiesHandler *ActivitiesHandler
-	customersHandler  *CustomersHandler
-	invoicesHandler   *InvoiceHandler
+	activitiesHandler        *ActivitiesHandler
+	apiKeysHandler           *APIKeysHandler
+	authHandler              *AuthHandler
+	creditNotesHandler       *CreditNotesHandler
+	customersHandler         *CustomersHandler
+	deliveriesHandler        *DeliveriesHandler
+	documentsHandler         *DocumentsHandler
+	estimatesHandler         *EstimatesHandler
+	invoicesHandler          *InvoiceHandler
+	paymentsHandler          *PaymentsHandler
+	productsHandler          *ProductsHandler
+	recurringInvoicesHandler *RecurringInvoicesHandler
+	settingsHandler          *SettingsHandler
+	taxRatesHandler          *TaxRatesHandler
 }
 
 func NewAPI(
 	activitiesHandler *ActivitiesHandler,
+	apiKeysHandler *APIKeysHandler,
+	authHandler *AuthHandler,
+	creditNotesHandler *CreditNotesHandler,
 	customersHandler *CustomersHandler,
+	deliveriesHandler *DeliveriesHandler,
+	documentsHandler *DocumentsHandler,
+	estimatesHandler *EstimatesHandler,
 	invoicesHandler *InvoiceHandler,
+	paymentsHandler *PaymentsHandler,
+	productsHandler *ProductsHandler,
+	recurringInvoicesHandler *RecurringInvoicesHandler,
+	settingsHandler *SettingsHandler,
+	taxRatesHandler *TaxRatesHandler,
 ) *API {
 	return &API{
-		activitiesHandler: activitiesHandler,
-		customersHandler:  customersHandler,
-		invoicesHandler:   invoicesHandler,
+		activitiesHandler:        activitiesHandler,
+		apiKeysHandler:           apiKeysHandler,
+		authHandler:              authHandler,
+		creditNotesHandler:       creditNotesHandler,
+		customersHandler:         customersHandler,
+		deliveriesHandler:        deliveriesHandler,
+		documentsHandler:         documentsHandler,
+		estimatesHandler:         estimatesHandler,
+		invoicesHandler:          invoicesHandler,
+		paymentsHandler:          paymentsHandler,
+		productsHandler:          productsHandler,
+		recurringInvoicesHandler: recurringInvoicesHandler,
+		settingsHandler:          settingsHandler,
+		taxRatesHandler:          taxRatesHandler,
 	}
 }
@@ -0,0 +1,187 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/apikeys"
+	"invoice-backend/internal/repositories/users"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+)
+
+type APIKeysHandler struct {
+	apiKeysRepo apikeys.Repository
+	usersRepo   users.Repository
+}
+
+func NewAPIKeysHandler(apiKeysRepo apikeys.Repository, usersRepo users.Repository) *APIKeysHandler {
+	return &APIKeysHandler{
+		apiKeysRepo: apiKeysRepo,
+		usersRepo:   usersRepo,
+	}
+}
+
+// AuthenticateAPIKey resolves the principal of an API key. The principal carries the current role of the key's
+// user and the scopes of the key, and the key's last use is recorded.
+func (h *APIKeysHandler) AuthenticateAPIKey(ctx context.Context, key string) (*auth.Principal, error) {
+	prefix, ok := auth.APIKeyPrefix(key)
+	if !ok {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	apiKey, err := h.apiKeysRepo.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	if apiKey == nil || !auth.VerifyAPIKey(key, apiKey.KeyHash) || !apiKey.IsActive(now) {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	user, err := h.usersRepo.GetUserByID(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	err = h.apiKeysRepo.TouchAPIKey(ctx, apiKey.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	principal := principalOf(user)
+	principal.Scopes = apiKey.Scopes
+
+	return principal, nil
+}
+
+func (a *API) V1GetApiKeys(w http.ResponseWriter, r *http.Request) {
+	list, err := a.apiKeysHandler.apiKeysRepo.ListAPIKeys(r.Context())
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.ApiKeysResponse{
+		Data: lo.Map(list, func(apiKey *apikeys.APIKey, _ int) server.ApiKey {
+			return serializeAPIKeyToAPIResponse(apiKey)
+		}),
+	})
+}
+
+func (a *API) V1CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	reqBody := new(server.V1CreateApiKeyJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	apiKeyData := reqBody.Data
+	principal, _ := auth.PrincipalFromContext(r.Context())
+
+	for _, scope := range apiKeyData.Scopes {
+		err = auth.ValidateScope(scope)
+		if err != nil {
+			server.BadRequestError(err, w, r)
+
+			return
+		}
+
+		err = principal.DelegateScope(scope)
+		if err != nil {
+			server.ForbiddenError(err, w, r)
+
+			return
+		}
+	}
+
+	if apiKeyData.ExpiresAt != nil && !apiKeyData.ExpiresAt.After(time.Now()) {
+		server.BadRequestError(fmt.Errorf("expires_at must be in the future"), w, r)
+
+		return
+	}
+
+	key, prefix, hash, err := auth.NewAPIKey()
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	apiKey, err := a.apiKeysHandler.apiKeysRepo.CreateAPIKey(r.Context(), &apikeys.APIKey{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      apiKeyData.Name,
+		Prefix:    prefix,
+		KeyHash:   hash,
+		Scopes:    lo.Uniq(apiKeyData.Scopes),
+		ExpiresAt: apiKeyData.ExpiresAt,
+	})
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, server.CreatedApiKeyResponse{
+		Data: server.CreatedApiKey{
+			ApiKey: serializeAPIKeyToAPIResponse(apiKey),
+			Key:    key,
+		},
+	})
+}
+
+func (a *API) V1RevokeApiKey(w http.ResponseWriter, r *http.Request, apiKeyID openapi_types.UUID) {
+	err := a.apiKeysHandler.apiKeysRepo.RevokeAPIKey(r.Context(), apiKeyID)
+	if err != nil {
+		if errors.Is(err, apikeys.ErrAPIKeyNotFound) {
+			server.NotFoundError(w, r)
+
+			return
+		}
+
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.NoContent(w, r)
+}
+
+func serializeAPIKeyToAPIResponse(apiKey *apikeys.APIKey) server.ApiKey {
+	return server.ApiKey{
+		CreatedAt:  apiKey.CreatedAt,
+		ExpiresAt:  apiKey.ExpiresAt,
+		Id:         apiKey.ID,
+		LastUsedAt: apiKey.LastUsedAt,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		RevokedAt:  apiKey.RevokedAt,
+		Scopes:     apiKey.Scopes,
+	}
+}
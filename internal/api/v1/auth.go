@@ -0,0 +1,286 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/refreshtokens"
+	"invoice-backend/internal/repositories/users"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const tokenType = "Bearer"
+
+var errAuthenticationRequired = errors.New("authentication required")
+
+type AuthHandler struct {
+	refreshTokensRepo refreshtokens.Repository
+	tokenManager      *auth.TokenManager
+	usersRepo         users.Repository
+}
+
+func NewAuthHandler(
+	refreshTokensRepo refreshtokens.Repository,
+	tokenManager *auth.TokenManager,
+	usersRepo users.Repository,
+) *AuthHandler {
+	return &AuthHandler{
+		refreshTokensRepo: refreshTokensRepo,
+		tokenManager:      tokenManager,
+		usersRepo:         usersRepo,
+	}
+}
+
+// IssueTokens signs an access token for the user and stores a new refresh token.
+func (h *AuthHandler) IssueTokens(ctx context.Context, user *users.User) (*server.AuthTokens, error) {
+	now := time.Now()
+
+	accessToken, err := h.tokenManager.IssueAccessToken(principalOf(user), now)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, refreshTokenHash, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = h.refreshTokensRepo.CreateRefreshToken(ctx, &refreshtokens.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		TokenHash: refreshTokenHash,
+		ExpiresAt: now.Add(h.tokenManager.RefreshTokenTTL()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &server.AuthTokens{
+		AccessToken:  accessToken,
+		ExpiresIn:    int(h.tokenManager.AccessTokenTTL().Seconds()),
+		RefreshToken: refreshToken,
+		TokenType:    tokenType,
+		User:         serializeUserToAPIResponse(user),
+	}, nil
+}
+
+// RotateRefreshToken revokes a refresh token and issues a new token pair for its user. Presenting a token that
+// was revoked already means it leaked or was replayed, so every session of the user is revoked.
+func (h *AuthHandler) RotateRefreshToken(ctx context.Context, token string) (*server.AuthTokens, error) {
+	stored, err := h.refreshTokensRepo.GetRefreshTokenByHash(ctx, auth.HashRefreshToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if stored == nil || stored.IsExpired(time.Now()) {
+		return nil, auth.ErrInvalidToken
+	}
+
+	err = h.refreshTokensRepo.RevokeRefreshToken(ctx, stored.ID)
+	if errors.Is(err, refreshtokens.ErrRefreshTokenRevoked) {
+		err = h.refreshTokensRepo.RevokeUserRefreshTokens(ctx, stored.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, auth.ErrInvalidToken
+	} else if err != nil {
+		return nil, err
+	}
+
+	user, err := h.usersRepo.GetUserByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, auth.ErrInvalidToken
+	}
+
+	return h.IssueTokens(ctx, user)
+}
+
+func (a *API) V1Register(w http.ResponseWriter, r *http.Request) {
+	reqBody := new(server.V1RegisterJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	registration := reqBody.Data
+
+	err = auth.ValidatePassword(registration.Password)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	passwordHash, err := auth.HashPassword(registration.Password)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	user, err := a.authHandler.usersRepo.CreateUser(r.Context(), &users.User{
+		ID:           uuid.New(),
+		Name:         registration.Name,
+		Email:        string(registration.Email),
+		PasswordHash: passwordHash,
+		Role:         users.DefaultRole,
+	})
+	if err != nil {
+		if errors.Is(err, users.ErrEmailTaken) {
+			server.ConflictError(err, nil, w, r)
+
+			return
+		}
+
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	a.renderTokens(w, r, user, http.StatusCreated)
+}
+
+func (a *API) V1Login(w http.ResponseWriter, r *http.Request) {
+	reqBody := new(server.V1LoginJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	user, err := a.authHandler.usersRepo.GetUserByEmail(r.Context(), string(reqBody.Data.Email))
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	passwordHash := ""
+	if user != nil {
+		passwordHash = user.PasswordHash
+	}
+
+	err = auth.VerifyPassword(passwordHash, reqBody.Data.Password)
+	if err != nil {
+		server.UnauthorizedError(err, w, r)
+
+		return
+	}
+
+	a.renderTokens(w, r, user, http.StatusOK)
+}
+
+func (a *API) V1RefreshToken(w http.ResponseWriter, r *http.Request) {
+	reqBody := new(server.V1RefreshTokenJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	tokens, err := a.authHandler.RotateRefreshToken(r.Context(), reqBody.Data.RefreshToken)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) {
+			server.UnauthorizedError(err, w, r)
+
+			return
+		}
+
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.AuthTokensResponse{Data: *tokens})
+}
+
+// V1Logout revokes the refresh token. Unknown and already revoked tokens are accepted, so logging out twice is harmless.
+func (a *API) V1Logout(w http.ResponseWriter, r *http.Request) {
+	reqBody := new(server.V1LogoutJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	stored, err := a.authHandler.refreshTokensRepo.GetRefreshTokenByHash(r.Context(), auth.HashRefreshToken(reqBody.Data.RefreshToken))
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if stored != nil {
+		err = a.authHandler.refreshTokensRepo.RevokeRefreshToken(r.Context(), stored.ID)
+		if err != nil && !errors.Is(err, refreshtokens.ErrRefreshTokenRevoked) {
+			server.ProcessingError(err, w, r)
+
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *API) renderTokens(w http.ResponseWriter, r *http.Request, user *users.User, status int) {
+	tokens, err := a.authHandler.IssueTokens(r.Context(), user)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, status)
+	render.JSON(w, r, server.AuthTokensResponse{Data: *tokens})
+}
+
+// requireUserID returns the ID of the authenticated user, rendering 401 when the request is anonymous.
+func requireUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	principal, ok := auth.PrincipalFromContext(r.Context())
+	if !ok {
+		server.UnauthorizedError(errAuthenticationRequired, w, r)
+
+		return uuid.Nil, false
+	}
+
+	return principal.UserID, true
+}
+
+func principalOf(user *users.User) *auth.Principal {
+	return &auth.Principal{
+		UserID: user.ID,
+		Email:  user.Email,
+		Role:   user.Role,
+	}
+}
+
+func serializeUserToAPIResponse(user *users.User) server.User {
+	return server.User{
+		Email: openapi_types.Email(user.Email),
+		Id:    user.ID,
+		Name:  user.Name,
+		Role:  server.RoleEnum(user.Role),
+	}
+}
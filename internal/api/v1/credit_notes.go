@@ -0,0 +1,375 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/repositories/activities"
+	activityEnums "invoice-backend/internal/repositories/activities/enums"
+	"invoice-backend/internal/repositories/creditnotes"
+	"invoice-backend/internal/repositories/invoices"
+	sequenceEnums "invoice-backend/internal/repositories/sequences/enums"
+	"invoice-backend/internal/repositories/unitofwork"
+	"invoice-backend/pkg/money"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+var ErrInvoiceNotCreditable = errors.New("invoice cannot be credited")
+
+type CreditNotesHandler struct {
+	creditNotesRepo creditnotes.Repository
+	unitOfWork      unitofwork.UnitOfWork
+}
+
+func NewCreditNotesHandler(creditNotesRepo creditnotes.Repository, unitOfWork unitofwork.UnitOfWork) *CreditNotesHandler {
+	return &CreditNotesHandler{
+		creditNotesRepo: creditNotesRepo,
+		unitOfWork:      unitOfWork,
+	}
+}
+
+// IssueCreditNote credits the given lines and shipping of an invoice, or whatever is left of it when both are nil,
+// then takes the credited amount off its balance and records the matching activities. The invoice row stays locked
+// for the whole transaction, so concurrent credit notes and payments see each other's amounts.
+func (h *CreditNotesHandler) IssueCreditNote(
+	ctx context.Context,
+	invoiceID uuid.UUID,
+	note *creditnotes.CreditNote,
+	lines []creditnotes.Line,
+	shippingAmount *decimal.Decimal,
+) (*creditnotes.CreditNote, error) {
+	var result *creditnotes.CreditNote
+
+	err := h.unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		invoice, err := repos.Invoices.LockInvoice(ctx, invoiceID)
+		if err != nil {
+			return err
+		}
+
+		if invoice == nil {
+			return invoices.ErrInvoiceNotFound
+		}
+
+		if !invoices.IsCreditableStatus(invoice.Status) {
+			return fmt.Errorf("%w: invoice is %s", ErrInvoiceNotCreditable, invoice.Status)
+		}
+
+		items, err := repos.InvoiceItems.GetInvoiceItemsByInvoiceID(ctx, invoice.ID)
+		if err != nil {
+			return err
+		}
+
+		invoice.Items = lo.ToSlicePtr(items)
+
+		issued, err := repos.CreditNotes.ListCreditNotesByInvoiceID(ctx, invoice.ID)
+		if err != nil {
+			return err
+		}
+
+		credited := lo.FromPtr(shippingAmount)
+		if lines == nil && shippingAmount == nil {
+			lines, credited = creditnotes.Remaining(invoice, issued)
+		}
+
+		err = creditnotes.Build(note, invoice, issued, lines, credited)
+		if err != nil {
+			return err
+		}
+
+		note.CreditNoteNumber, err = repos.Sequences.NextNumber(ctx, invoice.UserID, sequenceEnums.DocumentTypeCreditNote, note.IssueDate)
+		if err != nil {
+			return err
+		}
+
+		_, err = repos.CreditNotes.CreateCreditNote(ctx, note)
+		if err != nil {
+			return err
+		}
+
+		from := invoice.Status
+		invoice.AmountCredited = invoice.AmountCredited.Add(note.TotalAmount)
+		invoice.AmountDue = invoice.TotalAmount.Sub(invoice.AmountPaid).Sub(invoice.AmountCredited)
+		invoice.Status = invoices.StatusAfterPayment(from, invoice.TotalAmount, invoice.AmountPaid.Add(invoice.AmountCredited))
+
+		err = repos.Invoices.UpdateBalance(ctx, invoice.ID, invoice.Status, invoice.AmountPaid, invoice.AmountCredited, invoice.AmountDue)
+		if err != nil {
+			return err
+		}
+
+		err = repos.Activities.CreateActivity(ctx, activities.NewInvoiceActivity(
+			activityEnums.ActivityTypeCreditNoteIssued,
+			invoice.UserID,
+			invoice.ID,
+			fmt.Sprintf(
+				"Credit note %s of %s %s issued for invoice %s",
+				note.CreditNoteNumber,
+				invoice.Currency.Format(note.TotalAmount),
+				invoice.Currency,
+				invoice.InvoiceNumber,
+			),
+		))
+		if err != nil {
+			return err
+		}
+
+		if invoice.Status != from {
+			err = repos.Activities.CreateActivity(ctx, activities.NewInvoiceActivity(
+				activityEnums.ActivityTypeStatusChanged,
+				invoice.UserID,
+				invoice.ID,
+				fmt.Sprintf("Invoice %s status changed from %s to %s", invoice.InvoiceNumber, from, invoice.Status),
+			))
+			if err != nil {
+				return err
+			}
+		}
+
+		result = note
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (a *API) V1GetCreditNotes(w http.ResponseWriter, r *http.Request, params server.V1GetCreditNotesParams) {
+	var (
+		creditNoteFilter *creditnotes.CreditNoteDBFilter
+		page             = getDefaultPage()
+		pageSize         = getDefaultPageSize()
+	)
+
+	if params.Data != nil {
+		if filters := params.Data.Filters; filters != nil {
+			creditNoteFilter = &creditnotes.CreditNoteDBFilter{
+				InvoiceID:  lo.ToSlicePtr(lo.FromPtr(filters.InvoiceId)),
+				CustomerID: lo.ToSlicePtr(lo.FromPtr(filters.CustomerId)),
+			}
+		}
+
+		page = lo.CoalesceOrEmpty(params.Data.Page, page)
+		pageSize = lo.CoalesceOrEmpty(params.Data.PageSize, pageSize)
+	}
+
+	result, err := a.creditNotesHandler.creditNotesRepo.ListCreditNotes(r.Context(), creditNoteFilter, preparePagination(pageSize, page))
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.CreditNotesResponse{
+		Data: lo.Map(result.CreditNotes, func(note *creditnotes.CreditNote, _ int) server.CreditNote {
+			return serializeCreditNoteToAPIResponse(note)
+		}),
+		Meta: server.PaginationMeta{
+			Page:       lo.ToPtr(int(result.Page)),
+			PageSize:   int(result.PageSize),
+			PageCount:  lo.ToPtr(int(result.PageCount)),
+			TotalCount: lo.ToPtr(int(result.TotalCount)),
+		},
+	})
+}
+
+func (a *API) V1GetCreditNote(w http.ResponseWriter, r *http.Request, creditNoteID openapi_types.UUID) {
+	note, err := a.creditNotesHandler.creditNotesRepo.GetCreditNoteByID(r.Context(), creditNoteID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if note == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.CreditNoteResponse{Data: serializeCreditNoteToAPIResponse(note)})
+}
+
+func (a *API) V1GetCreditNotePdf(w http.ResponseWriter, r *http.Request, creditNoteID openapi_types.UUID) {
+	note, err := a.creditNotesHandler.creditNotesRepo.GetCreditNoteByID(r.Context(), creditNoteID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if note == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	invoice, err := a.invoicesHandler.invoicesRepo.GetInvoiceByID(r.Context(), note.InvoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if invoice == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	pdf, err := a.documentsHandler.RenderCreditNotePDF(r.Context(), note, invoice.InvoiceNumber)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, CreditNoteFileName(note)))
+	w.Header().Set("Content-Length", fmt.Sprint(len(pdf)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(pdf)
+}
+
+func (a *API) V1GetInvoiceCreditNotes(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	invoice, err := a.invoicesHandler.invoicesRepo.GetInvoiceByID(r.Context(), invoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if invoice == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	list, err := a.creditNotesHandler.creditNotesRepo.ListCreditNotesByInvoiceID(r.Context(), invoice.ID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.InvoiceCreditNotesResponse{
+		Data: lo.Map(list, func(note *creditnotes.CreditNote, _ int) server.CreditNote {
+			return serializeCreditNoteToAPIResponse(note)
+		}),
+	})
+}
+
+func (a *API) V1CreateInvoiceCreditNote(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	reqBody := new(server.V1CreateInvoiceCreditNoteJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	noteData := reqBody.Data
+
+	var shippingAmount *decimal.Decimal
+
+	if noteData.ShippingAmount != nil {
+		amount, parseErr := money.ParseAmount(*noteData.ShippingAmount)
+		if parseErr != nil {
+			server.BadRequestError(parseErr, w, r)
+
+			return
+		}
+
+		shippingAmount = &amount
+	}
+
+	var lines []creditnotes.Line
+
+	if noteData.Items != nil {
+		lines = lo.Map(*noteData.Items, func(line server.CreditNoteLine, _ int) creditnotes.Line {
+			return creditnotes.Line{InvoiceItemID: line.InvoiceItemId, Quantity: line.Quantity}
+		})
+	}
+
+	note := &creditnotes.CreditNote{
+		ID:        uuid.New(),
+		Reason:    noteData.Reason,
+		IssueDate: lo.FromPtrOr(dateToTime(noteData.IssueDate), time.Now()),
+	}
+
+	_, err = a.creditNotesHandler.IssueCreditNote(r.Context(), invoiceID, note, lines, shippingAmount)
+	if err != nil {
+		renderCreditNoteError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, server.CreditNoteResponse{Data: serializeCreditNoteToAPIResponse(note)})
+}
+
+func renderCreditNoteError(err error, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case errors.Is(err, invoices.ErrInvoiceNotFound):
+		server.NotFoundError(w, r)
+	case errors.Is(err, creditnotes.ErrInvalidCredit),
+		errors.Is(err, money.ErrInvalidAmount):
+		server.BadRequestError(err, w, r)
+	case errors.Is(err, ErrInvoiceNotCreditable):
+		server.ConflictError(err, nil, w, r)
+	default:
+		server.ProcessingError(err, w, r)
+	}
+}
+
+func serializeCreditNoteToAPIResponse(note *creditnotes.CreditNote) server.CreditNote {
+	items := lo.Map(note.Items, func(item *creditnotes.CreditNoteItem, _ int) server.CreditNoteItem {
+		return server.CreditNoteItem{
+			Description:   item.Description,
+			Id:            item.ID,
+			InvoiceItemId: item.InvoiceItemID,
+			NetAmount:     note.Currency.Format(item.NetAmount),
+			Quantity:      item.Quantity,
+			TaxAmount:     note.Currency.Format(item.TaxAmount),
+			Taxes: lo.Map(item.Taxes, func(tax creditnotes.Tax, _ int) server.CreditNoteItemTax {
+				return server.CreditNoteItemTax{
+					Amount:   note.Currency.Format(tax.Amount),
+					Compound: tax.Compound,
+					Name:     tax.Name,
+					Rate:     formatRate(tax.Rate),
+				}
+			}),
+			UnitPrice: note.Currency.Format(item.UnitPrice),
+		}
+	})
+
+	return server.CreditNote{
+		CreatedAt:        note.CreatedAt,
+		CreditNoteNumber: note.CreditNoteNumber,
+		Currency:         note.Currency.String(),
+		CustomerId:       note.CustomerID,
+		Id:               note.ID,
+		InvoiceId:        note.InvoiceID,
+		IssueDate:        openapi_types.Date{Time: note.IssueDate},
+		Items:            items,
+		Reason:           note.Reason,
+		ShippingAmount:   note.Currency.Format(note.ShippingAmount),
+		Subtotal:         note.Currency.Format(note.Subtotal),
+		TaxAmount:        note.Currency.Format(note.TaxAmount),
+		TotalAmount:      note.Currency.Format(note.TotalAmount),
+	}
+}
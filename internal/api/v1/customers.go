@@ -2,15 +2,27 @@ package v1
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/repositories/activities"
+	activityEnums "invoice-backend/internal/repositories/activities/enums"
 	"invoice-backend/internal/repositories/customers"
+	"invoice-backend/internal/shared"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/render"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/samber/lo"
 )
 
+var ErrUnknownCustomer = errors.New("unknown customer")
+
+// customerSortColumns are the columns customers can be listed by, see CustomerSortEnum.
+var customerSortColumns = []string{"name", "email", "created_at"}
+
 type CustomersHandler struct {
 	customersRepo customers.Repository
 }
@@ -21,26 +33,76 @@ func NewCustomersHandler(customersRepo customers.Repository) *CustomersHandler {
 	}
 }
 
-func (h *CustomersHandler) GetCustomers(ctx context.Context, filter *customers.CustomerDBFilter) ([]server.CustomerResponseData, error) {
-	list, fetchCustomerErr := h.customersRepo.ListCustomers(ctx, filter)
+func (h *CustomersHandler) GetCustomers(
+	ctx context.Context,
+	filter *customers.CustomerDBFilter,
+	sort shared.Sort,
+	pagination shared.Pagination,
+) (*server.CustomersResponse, error) {
+	result, fetchCustomerErr := h.customersRepo.ListCustomers(ctx, filter, sort, pagination)
 	if fetchCustomerErr != nil {
 		return nil, fetchCustomerErr
 	}
 
-	return lo.Map(list, func(customer *customers.Customer, _ int) server.CustomerResponseData {
-		return serializeCustomerToAPIResponse(customer)
-	}), nil
+	return &server.CustomersResponse{
+		Data: lo.Map(result.Customers, func(customer *customers.Customer, _ int) server.CustomerResponseData {
+			return serializeCustomerToAPIResponse(customer)
+		}),
+		Meta: server.PaginationMeta{
+			Page:       lo.ToPtr(int(result.Page)),
+			PageSize:   int(result.PageSize),
+			PageCount:  lo.ToPtr(int(result.PageCount)),
+			TotalCount: lo.ToPtr(int(result.TotalCount)),
+		},
+	}, nil
+}
+
+// RequireCustomer checks that the customer exists for the caller. Customers of other users are reported as unknown,
+// like customers that do not exist at all.
+func (h *CustomersHandler) RequireCustomer(ctx context.Context, customerID uuid.UUID) error {
+	customer, err := h.customersRepo.GetCustomerByID(ctx, customerID)
+	if err != nil {
+		return err
+	}
+
+	if customer == nil {
+		return fmt.Errorf("%w: %s", ErrUnknownCustomer, customerID)
+	}
+
+	return nil
 }
 
 func (a *API) V1GetCustomers(w http.ResponseWriter, r *http.Request, params server.V1GetCustomersParams) {
-	var customerFilter *customers.CustomerDBFilter
+	var (
+		customerFilter *customers.CustomerDBFilter
+		sortParam      = server.CustomerSortName
+		page           = getDefaultPage()
+		pageSize       = getDefaultPageSize()
+	)
 
-	if params.Data != nil && params.Data.Filters != nil {
-		filter := prepareCustomerFilter(*params.Data.Filters)
-		customerFilter = filter
+	if params.Data != nil {
+		if params.Data.Filters != nil {
+			customerFilter = prepareCustomerFilter(*params.Data.Filters)
+		}
+
+		sortParam = lo.FromPtrOr(params.Data.Sort, sortParam)
+		page = lo.CoalesceOrEmpty(params.Data.Page, page)
+		pageSize = lo.CoalesceOrEmpty(params.Data.PageSize, pageSize)
 	}
 
-	customerList, fetchCustomerErr := a.customersHandler.GetCustomers(r.Context(), customerFilter)
+	sort, err := shared.ParseSort(string(sortParam), customerSortColumns...)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	response, fetchCustomerErr := a.customersHandler.GetCustomers(
+		r.Context(),
+		customerFilter,
+		sort,
+		preparePagination(pageSize, page),
+	)
 	if fetchCustomerErr != nil {
 		server.ProcessingError(fetchCustomerErr, w, r)
 
@@ -48,10 +110,15 @@ func (a *API) V1GetCustomers(w http.ResponseWriter, r *http.Request, params serv
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, server.CustomersResponse{Data: customerList})
+	render.JSON(w, r, response)
 }
 
 func (a *API) V1CreateCustomer(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	reqBody := new(server.V1CreateCustomerJSONRequestBody)
 
 	err := render.DecodeJSON(r.Body, reqBody)
@@ -62,37 +129,281 @@ func (a *API) V1CreateCustomer(w http.ResponseWriter, r *http.Request) {
 
 	customerData := reqBody.Data
 
-	newCustomer := &customers.DBCustomer{
-		ID:      uuid.New(),
-		UserID:  customerData.UserId,
-		Name:    customerData.Name,
-		Email:   customerData.Email,
-		Phone:   customerData.Phone,
-		Address: customerData.Address,
+	newCustomer := &customers.Customer{
+		ID:              uuid.New(),
+		UserID:          userID,
+		Name:            customerData.Name,
+		Email:           customerData.Email,
+		Phone:           customerData.Phone,
+		BillingAddress:  addressFromAPI(customerData.BillingAddress),
+		ShippingAddress: addressFromAPI(customerData.ShippingAddress),
+		TaxID:           lo.FromPtr(customerData.TaxId),
+		Contacts:        contactsFromAPI(lo.FromPtr(customerData.Contacts)),
 	}
 
-	result, err := a.customersHandler.customersRepo.CreateCustomer(r.Context(), newCustomer)
+	err = newCustomer.Validate()
 	if err != nil {
 		server.BadRequestError(err, w, r)
 		return
 	}
 
+	result, err := a.customersHandler.customersRepo.CreateCustomer(r.Context(), customers.ToDBCustomer(newCustomer))
+	if err != nil {
+		renderCustomerError(err, w, r)
+		return
+	}
+
+	a.activitiesHandler.RecordActivity(r.Context(), activities.NewCustomerActivity(
+		activityEnums.ActivityTypeCustomerCreated,
+		result.UserID,
+		result.ID,
+		fmt.Sprintf("Customer %s created", result.Name),
+	))
+
 	response := serializeCustomerToAPIResponse(result)
 	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, response)
+	render.JSON(w, r, server.CustomerResponse{Data: response})
+}
+
+func (a *API) V1GetCustomer(w http.ResponseWriter, r *http.Request, customerID openapi_types.UUID) {
+	customer, err := a.customersHandler.customersRepo.GetCustomerByID(r.Context(), customerID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if customer == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.CustomerResponse{Data: serializeCustomerToAPIResponse(customer)})
+}
+
+func (a *API) V1UpdateCustomer(w http.ResponseWriter, r *http.Request, customerID openapi_types.UUID) {
+	reqBody := new(server.V1UpdateCustomerJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	customer, err := a.customersHandler.customersRepo.GetCustomerByID(r.Context(), customerID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if customer == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	updateData := reqBody.Data
+
+	if updateData.Name != nil {
+		customer.Name = lo.FromPtr(updateData.Name)
+	}
+
+	if updateData.Email != nil {
+		customer.Email = string(lo.FromPtr(updateData.Email))
+	}
+
+	if updateData.Phone != nil {
+		customer.Phone = lo.FromPtr(updateData.Phone)
+	}
+
+	if updateData.BillingAddress != nil {
+		customer.BillingAddress = addressFromAPI(updateData.BillingAddress)
+	}
+
+	if updateData.ShippingAddress != nil {
+		customer.ShippingAddress = addressFromAPI(updateData.ShippingAddress)
+	}
+
+	if updateData.TaxId != nil {
+		customer.TaxID = lo.FromPtr(updateData.TaxId)
+	}
+
+	if updateData.Contacts != nil {
+		customer.Contacts = contactsFromAPI(lo.FromPtr(updateData.Contacts))
+	}
+
+	err = customer.Validate()
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	err = a.customersHandler.customersRepo.UpdateCustomer(r.Context(), customer.ID, customer)
+	if err != nil {
+		renderCustomerError(err, w, r)
+
+		return
+	}
+
+	a.activitiesHandler.RecordActivity(r.Context(), activities.NewCustomerActivity(
+		activityEnums.ActivityTypeCustomerUpdated,
+		customer.UserID,
+		customer.ID,
+		fmt.Sprintf("Customer %s updated", customer.Name),
+	))
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.CustomerResponse{Data: serializeCustomerToAPIResponse(customer)})
+}
+
+func (a *API) V1DeleteCustomer(w http.ResponseWriter, r *http.Request, customerID openapi_types.UUID) {
+	customer, err := a.customersHandler.customersRepo.GetCustomerByID(r.Context(), customerID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if customer == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	err = a.customersHandler.customersRepo.DeleteCustomer(r.Context(), customer.ID)
+	if err != nil {
+		renderCustomerError(err, w, r)
+
+		return
+	}
+
+	a.activitiesHandler.RecordActivity(r.Context(), activities.NewCustomerActivity(
+		activityEnums.ActivityTypeCustomerDeleted,
+		customer.UserID,
+		customer.ID,
+		fmt.Sprintf("Customer %s deleted", customer.Name),
+	))
+
+	render.NoContent(w, r)
+}
+
+func (a *API) V1RestoreCustomer(w http.ResponseWriter, r *http.Request, customerID openapi_types.UUID) {
+	err := a.customersHandler.customersRepo.RestoreCustomer(r.Context(), customerID)
+	if err != nil {
+		renderCustomerError(err, w, r)
+
+		return
+	}
+
+	customer, err := a.customersHandler.customersRepo.GetCustomerByID(r.Context(), customerID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if customer == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	a.activitiesHandler.RecordActivity(r.Context(), activities.NewCustomerActivity(
+		activityEnums.ActivityTypeCustomerRestored,
+		customer.UserID,
+		customer.ID,
+		fmt.Sprintf("Customer %s restored", customer.Name),
+	))
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.CustomerResponse{Data: serializeCustomerToAPIResponse(customer)})
+}
+
+func renderCustomerError(err error, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case errors.Is(err, customers.ErrCustomerNotFound):
+		server.NotFoundError(w, r)
+	case errors.Is(err, customers.ErrDuplicateEmail),
+		errors.Is(err, customers.ErrDuplicatePhone):
+		server.ConflictError(err, nil, w, r)
+	default:
+		server.ProcessingError(err, w, r)
+	}
 }
 
 func prepareCustomerFilter(filters server.CustomerFilters) *customers.CustomerDBFilter {
 	return &customers.CustomerDBFilter{
-		UserID: lo.FromPtrOr(filters.UserId, []string{}),
+		UserID: lo.FromPtr(filters.UserId),
+		Search: strings.TrimSpace(lo.FromPtr(filters.Search)),
 	}
 }
 
 func serializeCustomerToAPIResponse(customer *customers.Customer) server.CustomerResponseData {
 	return server.CustomerResponseData{
-		Email: customer.Email,
-		Id:    customer.ID,
-		Name:  customer.Name,
-		Phone: customer.Phone,
+		BillingAddress:  serializeAddress(customer.BillingAddress),
+		Contacts:        serializeContacts(customer.Contacts),
+		Email:           customer.Email,
+		Id:              customer.ID,
+		Name:            customer.Name,
+		Phone:           customer.Phone,
+		ShippingAddress: serializeAddress(customer.ShippingAddress),
+		TaxId:           customer.TaxID,
 	}
 }
+
+func addressFromAPI(address *server.Address) customers.Address {
+	if address == nil {
+		return customers.Address{}
+	}
+
+	return customers.Address{
+		Line1:      strings.TrimSpace(lo.FromPtr(address.Line1)),
+		Line2:      strings.TrimSpace(lo.FromPtr(address.Line2)),
+		City:       strings.TrimSpace(lo.FromPtr(address.City)),
+		Region:     strings.TrimSpace(lo.FromPtr(address.Region)),
+		PostalCode: strings.TrimSpace(lo.FromPtr(address.PostalCode)),
+		Country:    strings.TrimSpace(lo.FromPtr(address.Country)),
+	}
+}
+
+func serializeAddress(address customers.Address) *server.Address {
+	if address.IsZero() {
+		return nil
+	}
+
+	return &server.Address{
+		Line1:      lo.ToPtr(address.Line1),
+		Line2:      lo.EmptyableToPtr(address.Line2),
+		City:       lo.EmptyableToPtr(address.City),
+		Region:     lo.EmptyableToPtr(address.Region),
+		PostalCode: lo.EmptyableToPtr(address.PostalCode),
+		Country:    lo.EmptyableToPtr(address.Country),
+	}
+}
+
+func contactsFromAPI(contacts server.Contacts) customers.Contacts {
+	return lo.Map(contacts, func(contact server.Contact, _ int) customers.Contact {
+		return customers.Contact{
+			Name:  strings.TrimSpace(lo.FromPtr(contact.Name)),
+			Email: strings.TrimSpace(string(contact.Email)),
+			Phone: strings.TrimSpace(lo.FromPtr(contact.Phone)),
+			CC:    lo.FromPtr(contact.Cc),
+		}
+	})
+}
+
+func serializeContacts(contacts customers.Contacts) server.Contacts {
+	return lo.Map(contacts, func(contact customers.Contact, _ int) server.Contact {
+		return server.Contact{
+			Cc:    lo.ToPtr(contact.CC),
+			Email: openapi_types.Email(contact.Email),
+			Name:  lo.EmptyableToPtr(contact.Name),
+			Phone: lo.EmptyableToPtr(contact.Phone),
+		}
+	})
+}
@@ -0,0 +1,282 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/documents"
+	"invoice-backend/internal/emails"
+	"invoice-backend/internal/repositories/customers"
+	"invoice-backend/internal/repositories/deliveries"
+	deliveryEnums "invoice-backend/internal/repositories/deliveries/enums"
+	"invoice-backend/internal/repositories/invoices"
+	invoiceEnums "invoice-backend/internal/repositories/invoices/enums"
+	"invoice-backend/internal/repositories/reminders"
+	"invoice-backend/pkg/mailer"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+)
+
+const emailDateLayout = "02 Jan 2006"
+
+var (
+	ErrNoRecipient    = errors.New("customer has no email address, set a recipient")
+	ErrDeliveryFailed = errors.New("email delivery failed")
+)
+
+// SendOptions customise a single invoice email.
+type SendOptions struct {
+	Recipient string // Overrides the customer email when set; the customer's CC contacts are then not copied
+	Message   string
+}
+
+type DeliveriesHandler struct {
+	deliveriesRepo   deliveries.Repository
+	customersRepo    customers.Repository
+	documentsHandler *DocumentsHandler
+	mailer           mailer.Mailer
+	from             mail.Address
+}
+
+func NewDeliveriesHandler(
+	deliveriesRepo deliveries.Repository,
+	customersRepo customers.Repository,
+	documentsHandler *DocumentsHandler,
+	mailer mailer.Mailer,
+	from mail.Address,
+) *DeliveriesHandler {
+	return &DeliveriesHandler{
+		deliveriesRepo:   deliveriesRepo,
+		customersRepo:    customersRepo,
+		documentsHandler: documentsHandler,
+		mailer:           mailer,
+		from:             from,
+	}
+}
+
+// SendInvoice emails an invoice, loaded together with its items, with its PDF attached. Every attempt is tracked as
+// a delivery; a transport failure marks the delivery as failed and is returned wrapped in ErrDeliveryFailed.
+func (h *DeliveriesHandler) SendInvoice(
+	ctx context.Context,
+	invoice *invoices.Invoice,
+	options SendOptions,
+) (*deliveries.Delivery, error) {
+	doc, template, err := h.documentsHandler.PrepareInvoice(ctx, invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := emails.Render(emails.TemplateInvoice, emails.InvoiceEmail{
+		SenderName:    doc.Sender.Name,
+		SenderEmail:   doc.Sender.Email,
+		CustomerName:  doc.Customer.Name,
+		InvoiceNumber: doc.Number,
+		Currency:      doc.Currency.String(),
+		TotalAmount:   doc.Currency.Format(doc.TotalAmount),
+		AmountDue:     doc.Currency.Format(doc.AmountDue),
+		DueDate:       doc.DueDate.Format(emailDateLayout),
+		Message:       options.Message,
+		PrimaryColor:  template.PrimaryColor.Hex(),
+		FooterText:    template.FooterText,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h.sendDocument(ctx, invoice, doc, template, emails.TemplateInvoice, content, options.Recipient)
+}
+
+// SendReminder emails a payment reminder for an invoice, loaded together with its items, to the customer and its
+// CC contacts. The invoice PDF is attached and the attempt is tracked as a delivery like SendInvoice does.
+func (h *DeliveriesHandler) SendReminder(ctx context.Context, invoice *invoices.Invoice) error {
+	doc, template, err := h.documentsHandler.PrepareInvoice(ctx, invoice)
+	if err != nil {
+		return err
+	}
+
+	content, err := emails.Render(emails.TemplateReminder, emails.ReminderEmail{
+		SenderName:    doc.Sender.Name,
+		SenderEmail:   doc.Sender.Email,
+		CustomerName:  doc.Customer.Name,
+		InvoiceNumber: doc.Number,
+		Currency:      doc.Currency.String(),
+		AmountDue:     doc.Currency.Format(doc.AmountDue),
+		DueDate:       doc.DueDate.Format(emailDateLayout),
+		Overdue:       isPastDue(invoice, time.Now()),
+		PrimaryColor:  template.PrimaryColor.Hex(),
+		FooterText:    template.FooterText,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = h.sendDocument(ctx, invoice, doc, template, emails.TemplateReminder, content, "")
+
+	return err
+}
+
+// isPastDue reports whether the due date of an invoice is before today. Invoices only turn OVERDUE once the
+// worker has run, so the status alone can lag behind.
+func isPastDue(invoice *invoices.Invoice, now time.Time) bool {
+	return invoice.Status == invoiceEnums.InvoiceStatusOVERDUE || reminders.Day(invoice.DueDate).Before(reminders.Day(now))
+}
+
+// sendDocument emails rendered content with the invoice PDF attached. The customer's CC contacts are copied unless
+// the recipient is overridden.
+func (h *DeliveriesHandler) sendDocument(
+	ctx context.Context,
+	invoice *invoices.Invoice,
+	doc *documents.InvoiceDocument,
+	template documents.Template,
+	templateName string,
+	content *emails.Content,
+	recipientOverride string,
+) (*deliveries.Delivery, error) {
+	recipient := lo.Ternary(recipientOverride != "", recipientOverride, doc.Customer.Email)
+	if strings.TrimSpace(recipient) == "" {
+		return nil, ErrNoRecipient
+	}
+
+	var pdf bytes.Buffer
+
+	err := documents.RenderInvoicePDF(&pdf, doc, template)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &mailer.Message{
+		From:     mail.Address{Name: doc.Sender.Name, Address: h.from.Address},
+		To:       []mail.Address{{Name: doc.Customer.Name, Address: recipient}},
+		Subject:  content.Subject,
+		TextBody: content.TextBody,
+		HTMLBody: content.HTMLBody,
+		Attachments: []mailer.Attachment{
+			{Filename: InvoiceFileName(invoice), ContentType: "application/pdf", Data: pdf.Bytes()},
+		},
+	}
+
+	if msg.From.Name == "" {
+		msg.From.Name = h.from.Name
+	}
+
+	if doc.Sender.Email != "" {
+		msg.ReplyTo = &mail.Address{Name: doc.Sender.Name, Address: doc.Sender.Email}
+	}
+
+	if recipientOverride == "" {
+		msg.Cc, err = h.ccRecipients(ctx, invoice.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return h.deliver(ctx, invoice, templateName, msg)
+}
+
+// ccRecipients returns the contacts of the customer that are copied on its invoice emails.
+func (h *DeliveriesHandler) ccRecipients(ctx context.Context, customerID uuid.UUID) ([]mail.Address, error) {
+	customer, err := h.customersRepo.GetCustomerByIDWithDeleted(ctx, customerID)
+	if err != nil || customer == nil {
+		return nil, err
+	}
+
+	return lo.FilterMap(customer.Contacts.CC(), func(contact customers.Contact, _ int) (mail.Address, bool) {
+		return mail.Address{Name: contact.Name, Address: contact.Email}, !strings.EqualFold(contact.Email, customer.Email)
+	}), nil
+}
+
+func (h *DeliveriesHandler) deliver(
+	ctx context.Context,
+	invoice *invoices.Invoice,
+	template string,
+	msg *mailer.Message,
+) (*deliveries.Delivery, error) {
+	delivery, err := h.deliveriesRepo.CreateDelivery(ctx, &deliveries.Delivery{
+		ID:        uuid.New(),
+		InvoiceID: invoice.ID,
+		UserID:    invoice.UserID,
+		Template:  template,
+		Recipient: msg.To[0].Address,
+		Subject:   msg.Subject,
+		Status:    deliveryEnums.DeliveryStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sendErr := h.mailer.Send(ctx, msg)
+	if sendErr != nil {
+		delivery.Status = deliveryEnums.DeliveryStatusFailed
+		delivery.Error = lo.ToPtr(sendErr.Error())
+
+		// Record the failure even when the request context is what made the send fail.
+		err = h.deliveriesRepo.MarkFailed(context.WithoutCancel(ctx), delivery.ID, sendErr.Error())
+		if err != nil {
+			return nil, errors.Join(sendErr, err)
+		}
+
+		return delivery, fmt.Errorf("%w: %w", ErrDeliveryFailed, sendErr)
+	}
+
+	delivery.Status = deliveryEnums.DeliveryStatusSent
+	delivery.SentAt = lo.ToPtr(time.Now())
+
+	err = h.deliveriesRepo.MarkSent(ctx, delivery.ID, *delivery.SentAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (a *API) V1GetInvoiceDeliveries(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	invoice, err := a.invoicesHandler.invoicesRepo.GetInvoiceByID(r.Context(), invoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if invoice == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	list, err := a.deliveriesHandler.deliveriesRepo.ListDeliveriesByInvoiceID(r.Context(), invoice.ID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.DeliveriesResponse{
+		Data: lo.Map(list, func(delivery *deliveries.Delivery, _ int) server.Delivery {
+			return serializeDeliveryToAPIResponse(delivery)
+		}),
+	})
+}
+
+func serializeDeliveryToAPIResponse(delivery *deliveries.Delivery) server.Delivery {
+	return server.Delivery{
+		CreatedAt: delivery.CreatedAt,
+		Error:     delivery.Error,
+		Id:        delivery.ID,
+		InvoiceId: delivery.InvoiceID,
+		Recipient: openapi_types.Email(delivery.Recipient),
+		SentAt:    delivery.SentAt,
+		Status:    server.DeliveryStatusEnum(delivery.Status),
+		Subject:   delivery.Subject,
+	}
+}
@@ -0,0 +1,271 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/documents"
+	"invoice-backend/internal/repositories/branding"
+	"invoice-backend/internal/repositories/creditnotes"
+	"invoice-backend/internal/repositories/customers"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/invoicesitems"
+	"invoice-backend/internal/repositories/users"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+)
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+type DocumentsHandler struct {
+	brandingRepo  branding.Repository
+	customersRepo customers.Repository
+	usersRepo     users.Repository
+}
+
+func NewDocumentsHandler(
+	brandingRepo branding.Repository,
+	customersRepo customers.Repository,
+	usersRepo users.Repository,
+) *DocumentsHandler {
+	return &DocumentsHandler{
+		brandingRepo:  brandingRepo,
+		customersRepo: customersRepo,
+		usersRepo:     usersRepo,
+	}
+}
+
+// RenderInvoicePDF renders an invoice, loaded together with its items, with the branding of its sender.
+func (h *DocumentsHandler) RenderInvoicePDF(ctx context.Context, invoice *invoices.Invoice) ([]byte, error) {
+	doc, template, err := h.PrepareInvoice(ctx, invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	err = documents.RenderInvoicePDF(&buf, doc, template)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// PrepareInvoice returns the document of an invoice together with the branding template of its sender.
+func (h *DocumentsHandler) PrepareInvoice(
+	ctx context.Context,
+	invoice *invoices.Invoice,
+) (*documents.InvoiceDocument, documents.Template, error) {
+	settings, err := h.brandingRepo.GetSettings(ctx, invoice.UserID)
+	if err != nil {
+		return nil, documents.Template{}, err
+	}
+
+	template, err := settings.Template()
+	if err != nil {
+		return nil, documents.Template{}, err
+	}
+
+	doc, err := h.BuildInvoiceDocument(ctx, invoice, settings)
+	if err != nil {
+		return nil, documents.Template{}, err
+	}
+
+	return doc, template, nil
+}
+
+// BuildInvoiceDocument collects what is printed on an invoice.
+func (h *DocumentsHandler) BuildInvoiceDocument(
+	ctx context.Context,
+	invoice *invoices.Invoice,
+	settings *branding.BrandingSettings,
+) (*documents.InvoiceDocument, error) {
+	sender, recipient, err := h.parties(ctx, invoice.UserID, invoice.CustomerID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := lo.Map(invoice.Items, func(item *invoicesitems.InvoiceItem, _ int) documents.Line {
+		return documents.Line{
+			Description:    item.Description,
+			Quantity:       item.Quantity,
+			UnitPrice:      item.UnitPrice,
+			DiscountAmount: item.DiscountAmount,
+			Amount:         item.TotalPrice,
+			Taxes: lo.Map(item.Taxes, func(tax *invoicesitems.InvoiceItemTax, _ int) documents.LineTax {
+				return documents.LineTax{Name: tax.Name, Rate: tax.Rate, Amount: tax.Amount}
+			}),
+		}
+	})
+
+	return &documents.InvoiceDocument{
+		Number:         invoice.InvoiceNumber,
+		Status:         invoice.Status.String(),
+		IssueDate:      invoice.IssueDate,
+		DueDate:        invoice.DueDate,
+		Currency:       invoice.Currency,
+		Sender:         sender,
+		Customer:       recipient,
+		Lines:          lines,
+		Subtotal:       invoice.Subtotal,
+		DiscountAmount: invoice.DiscountAmount,
+		ShippingAmount: invoice.ShippingAmount,
+		TaxAmount:      invoice.TaxAmount,
+		TotalAmount:    invoice.TotalAmount,
+		AmountPaid:     invoice.AmountPaid,
+		AmountCredited: invoice.AmountCredited,
+		AmountDue:      invoice.AmountDue,
+	}, nil
+}
+
+// RenderCreditNotePDF renders a credit note, loaded together with its items, with the branding of its sender.
+// Lines show the credited net amounts, the discounts of the invoice already taken off.
+func (h *DocumentsHandler) RenderCreditNotePDF(
+	ctx context.Context,
+	note *creditnotes.CreditNote,
+	invoiceNumber string,
+) ([]byte, error) {
+	settings, err := h.brandingRepo.GetSettings(ctx, note.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := settings.Template()
+	if err != nil {
+		return nil, err
+	}
+
+	sender, recipient, err := h.parties(ctx, note.UserID, note.CustomerID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := lo.Map(note.Items, func(item *creditnotes.CreditNoteItem, _ int) documents.Line {
+		return documents.Line{
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Amount:      item.NetAmount,
+			Taxes: lo.Map(item.Taxes, func(tax creditnotes.Tax, _ int) documents.LineTax {
+				return documents.LineTax{Name: tax.Name, Rate: tax.Rate, Amount: tax.Amount}
+			}),
+		}
+	})
+
+	doc := &documents.InvoiceDocument{
+		Title:           "CREDIT NOTE",
+		Number:          note.CreditNoteNumber,
+		CreditedInvoice: invoiceNumber,
+		IssueDate:       note.IssueDate,
+		Currency:        note.Currency,
+		Sender:          sender,
+		Customer:        recipient,
+		Lines:           lines,
+		Subtotal:        note.Subtotal,
+		ShippingAmount:  note.ShippingAmount,
+		TaxAmount:       note.TaxAmount,
+		TotalAmount:     note.TotalAmount,
+		Notes:           note.Reason,
+	}
+
+	var buf bytes.Buffer
+
+	err = documents.RenderInvoicePDF(&buf, doc, template)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// parties returns the sender and recipient blocks of a document. Sender details missing from the branding settings
+// are taken from the user account.
+func (h *DocumentsHandler) parties(
+	ctx context.Context,
+	userID uuid.UUID,
+	customerID uuid.UUID,
+	settings *branding.BrandingSettings,
+) (documents.Party, documents.Party, error) {
+	sender := documents.Party{
+		Name:    settings.CompanyName,
+		Address: settings.Address,
+		Email:   settings.Email,
+		Phone:   settings.Phone,
+	}
+
+	if sender.Name == "" || sender.Email == "" {
+		user, err := h.usersRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			return documents.Party{}, documents.Party{}, err
+		}
+
+		if user != nil {
+			sender.Name = lo.Ternary(sender.Name == "", user.Name, sender.Name)
+			sender.Email = lo.Ternary(sender.Email == "", user.Email, sender.Email)
+		}
+	}
+
+	var recipient documents.Party
+
+	customer, err := h.customersRepo.GetCustomerByIDWithDeleted(ctx, customerID)
+	if err != nil {
+		return documents.Party{}, documents.Party{}, err
+	}
+
+	if customer != nil {
+		recipient = documents.Party{
+			Name:    customer.Name,
+			Address: customer.BillingAddress.String(),
+			Email:   customer.Email,
+			Phone:   customer.Phone,
+			TaxID:   customer.TaxID,
+		}
+	}
+
+	return sender, recipient, nil
+}
+
+// InvoiceFileName returns the file name suggested to clients downloading an invoice document.
+func InvoiceFileName(invoice *invoices.Invoice) string {
+	return unsafeFileNameChars.ReplaceAllString(invoice.InvoiceNumber, "_") + ".pdf"
+}
+
+// CreditNoteFileName returns the file name suggested to clients downloading a credit note document.
+func CreditNoteFileName(note *creditnotes.CreditNote) string {
+	return unsafeFileNameChars.ReplaceAllString(note.CreditNoteNumber, "_") + ".pdf"
+}
+
+func (a *API) V1GetInvoicePdf(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	invoice, err := a.invoicesHandler.GetInvoice(r.Context(), invoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if invoice == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	pdf, err := a.documentsHandler.RenderInvoicePDF(r.Context(), invoice)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, InvoiceFileName(invoice)))
+	w.Header().Set("Content-Length", fmt.Sprint(len(pdf)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(pdf)
+}
@@ -0,0 +1,668 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/activities"
+	activityEnums "invoice-backend/internal/repositories/activities/enums"
+	"invoice-backend/internal/repositories/estimates"
+	"invoice-backend/internal/repositories/estimates/enums"
+	"invoice-backend/internal/repositories/invoices"
+	invoiceEnums "invoice-backend/internal/repositories/invoices/enums"
+	"invoice-backend/internal/repositories/invoicesitems"
+	sequenceEnums "invoice-backend/internal/repositories/sequences/enums"
+	"invoice-backend/internal/repositories/unitofwork"
+	"invoice-backend/pkg/money"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+var ErrEstimateNotEditable = errors.New("estimate can no longer be edited")
+
+type EstimatesHandler struct {
+	estimatesRepo    estimates.Repository
+	unitOfWork       unitofwork.UnitOfWork
+	customersHandler *CustomersHandler
+	now              func() time.Time
+}
+
+func NewEstimatesHandler(
+	estimatesRepo estimates.Repository,
+	unitOfWork unitofwork.UnitOfWork,
+	customersHandler *CustomersHandler,
+) *EstimatesHandler {
+	return &EstimatesHandler{
+		estimatesRepo:    estimatesRepo,
+		unitOfWork:       unitOfWork,
+		customersHandler: customersHandler,
+		now:              time.Now,
+	}
+}
+
+// CreateEstimate allocates the estimate number and stores the estimate in one transaction.
+func (h *EstimatesHandler) CreateEstimate(ctx context.Context, estimate *estimates.Estimate) (*estimates.Estimate, error) {
+	var result *estimates.Estimate
+
+	err := h.unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		number, err := repos.Sequences.NextNumber(ctx, estimate.UserID, sequenceEnums.DocumentTypeEstimate, estimate.IssueDate)
+		if err != nil {
+			return fmt.Errorf("failed to allocate estimate number: %w", err)
+		}
+
+		estimate.EstimateNumber = number
+
+		result, err = repos.Estimates.CreateEstimate(ctx, estimate)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SendEstimate marks an estimate as sent and returns the token of the link the customer answers it with. Only the
+// hash of the token is stored, so sending the estimate again issues a new token and disables the previous link.
+func (h *EstimatesHandler) SendEstimate(ctx context.Context, estimateID uuid.UUID) (*estimates.Estimate, string, error) {
+	var (
+		result *estimates.Estimate
+		token  string
+	)
+
+	err := h.unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		estimate, err := repos.Estimates.LockEstimate(ctx, estimateID)
+		if err != nil {
+			return err
+		}
+
+		if estimate == nil {
+			return estimates.ErrEstimateNotFound
+		}
+
+		if estimate.Status != enums.EstimateStatusSENT {
+			err = estimates.ValidateTransition(estimate.Status, enums.EstimateStatusSENT)
+			if err != nil {
+				return err
+			}
+		}
+
+		if estimate.IsExpired(h.now()) {
+			return estimates.ErrEstimateExpired
+		}
+
+		var hash string
+
+		token, hash, err = auth.NewLinkToken()
+		if err != nil {
+			return err
+		}
+
+		estimate.Status = enums.EstimateStatusSENT
+		estimate.PublicTokenHash = &hash
+
+		err = repos.Estimates.UpdateEstimate(ctx, estimate)
+		if err != nil {
+			return err
+		}
+
+		result = estimate
+
+		return repos.Activities.CreateActivity(ctx, activities.NewCustomerActivity(
+			activityEnums.ActivityTypeEstimateSent,
+			estimate.UserID,
+			estimate.CustomerID,
+			fmt.Sprintf("Estimate %s sent", estimate.EstimateNumber),
+		))
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return result, token, nil
+}
+
+// RespondToEstimate records the answer a customer gave through the link of a sent estimate. An estimate past its
+// expiry date is marked expired and the answer fails with estimates.ErrEstimateExpired.
+func (h *EstimatesHandler) RespondToEstimate(
+	ctx context.Context,
+	token string,
+	status enums.EstimateStatus,
+) (*estimates.Estimate, error) {
+	var (
+		result  *estimates.Estimate
+		expired bool
+	)
+
+	systemCtx := auth.AsSystem(ctx)
+
+	err := h.unitOfWork.Do(systemCtx, func(repos *unitofwork.Repositories) error {
+		estimate, err := repos.Estimates.LockEstimateByTokenHash(systemCtx, auth.HashLinkToken(token))
+		if err != nil {
+			return err
+		}
+
+		if estimate == nil {
+			return estimates.ErrEstimateNotFound
+		}
+
+		ownerCtx := ownerContext(ctx, estimate.UserID)
+
+		err = estimate.Respond(status, h.now())
+		if errors.Is(err, estimates.ErrEstimateExpired) {
+			// The expiry is committed even though the answer is rejected.
+			expired = true
+			estimate.Status = enums.EstimateStatusEXPIRED
+
+			return repos.Estimates.UpdateEstimate(ownerCtx, estimate)
+		} else if err != nil {
+			return err
+		}
+
+		err = repos.Estimates.UpdateEstimate(ownerCtx, estimate)
+		if err != nil {
+			return err
+		}
+
+		result = estimate
+
+		activityType := activityEnums.ActivityTypeEstimateAccepted
+		if status == enums.EstimateStatusDECLINED {
+			activityType = activityEnums.ActivityTypeEstimateDeclined
+		}
+
+		return repos.Activities.CreateActivity(ownerCtx, activities.NewCustomerActivity(
+			activityType,
+			estimate.UserID,
+			estimate.CustomerID,
+			fmt.Sprintf("Estimate %s %s by the customer", estimate.EstimateNumber, strings.ToLower(status.String())),
+		))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if expired {
+		return nil, estimates.ErrEstimateExpired
+	}
+
+	return result, nil
+}
+
+// ConvertEstimate creates a draft invoice carrying the lines of an estimate and accepts the estimate, linking both
+// in one transaction. The estimate row stays locked meanwhile, so an estimate is never converted twice.
+func (h *EstimatesHandler) ConvertEstimate(
+	ctx context.Context,
+	estimateID uuid.UUID,
+	issueDate, dueDate time.Time,
+) (*invoices.Invoice, error) {
+	var result *invoices.Invoice
+
+	err := h.unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		estimate, err := repos.Estimates.LockEstimate(ctx, estimateID)
+		if err != nil {
+			return err
+		}
+
+		if estimate == nil {
+			return estimates.ErrEstimateNotFound
+		}
+
+		err = estimate.CheckConvertible(h.now())
+		if err != nil {
+			return err
+		}
+
+		err = h.customersHandler.RequireCustomer(ctx, estimate.CustomerID)
+		if err != nil {
+			return err
+		}
+
+		invoice := &invoices.DBInvoice{
+			ID:             uuid.New(),
+			UserID:         estimate.UserID,
+			CustomerID:     estimate.CustomerID,
+			IssueDate:      issueDate,
+			DueDate:        dueDate,
+			Status:         invoiceEnums.InvoiceStatusDRAFT,
+			Currency:       estimate.Currency,
+			DiscountType:   estimate.DiscountType,
+			DiscountValue:  estimate.DiscountValue,
+			ShippingAmount: estimate.ShippingAmount,
+			EstimateID:     lo.ToPtr(estimate.ID),
+			Items:          copyEstimateItems(estimate.Items),
+		}
+
+		result, err = createInvoice(ctx, repos, invoice)
+		if err != nil {
+			return err
+		}
+
+		estimate.Status = enums.EstimateStatusACCEPTED
+		estimate.InvoiceID = lo.ToPtr(result.ID)
+
+		err = repos.Estimates.UpdateEstimate(ctx, estimate)
+		if err != nil {
+			return err
+		}
+
+		return repos.Activities.CreateActivity(ctx, activities.NewInvoiceActivity(
+			activityEnums.ActivityTypeEstimateConverted,
+			result.UserID,
+			result.ID,
+			fmt.Sprintf("Invoice %s created from estimate %s", result.InvoiceNumber, estimate.EstimateNumber),
+		))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// copyEstimateItems copies the lines of an estimate for a new invoice. Names and rates of the taxes are kept as
+// they were quoted, whatever changed in the tax rate catalogue since.
+func copyEstimateItems(items estimates.Items) []*invoicesitems.InvoiceItem {
+	return lo.Map(items, func(item *invoicesitems.InvoiceItem, position int) *invoicesitems.InvoiceItem {
+		return &invoicesitems.InvoiceItem{
+			ID:            uuid.New(),
+			Position:      position,
+			ProductID:     item.ProductID,
+			Description:   item.Description,
+			Quantity:      item.Quantity,
+			UnitPrice:     item.UnitPrice,
+			DiscountType:  item.DiscountType,
+			DiscountValue: item.DiscountValue,
+			Taxes: lo.Map(item.Taxes, func(tax *invoicesitems.InvoiceItemTax, _ int) *invoicesitems.InvoiceItemTax {
+				return &invoicesitems.InvoiceItemTax{
+					TaxRateID: tax.TaxRateID,
+					Name:      tax.Name,
+					Rate:      tax.Rate,
+					Compound:  tax.Compound,
+				}
+			}),
+		}
+	})
+}
+
+func (a *API) V1GetEstimates(w http.ResponseWriter, r *http.Request, params server.V1GetEstimatesParams) {
+	var (
+		estimateFilter *estimates.EstimateDBFilter
+		page           = getDefaultPage()
+		pageSize       = getDefaultPageSize()
+	)
+
+	if params.Data != nil {
+		if filters := params.Data.Filters; filters != nil {
+			estimateFilter = &estimates.EstimateDBFilter{
+				CustomerID: lo.ToSlicePtr(lo.FromPtr(filters.CustomerId)),
+				Status: lo.Map(lo.FromPtr(filters.Status), func(status server.EstimateStatusEnum, _ int) *enums.EstimateStatus {
+					return lo.ToPtr(enums.EstimateStatus(status))
+				}),
+			}
+		}
+
+		page = lo.CoalesceOrEmpty(params.Data.Page, page)
+		pageSize = lo.CoalesceOrEmpty(params.Data.PageSize, pageSize)
+	}
+
+	result, err := a.estimatesHandler.estimatesRepo.ListEstimates(r.Context(), estimateFilter, preparePagination(pageSize, page))
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.EstimatesResponse{
+		Data: lo.Map(result, func(estimate *estimates.Estimate, _ int) server.Estimate {
+			return serializeEstimateToAPIResponse(estimate)
+		}),
+	})
+}
+
+func (a *API) V1CreateEstimate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	reqBody := new(server.V1CreateEstimateJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	estimate := &estimates.Estimate{
+		ID:     uuid.New(),
+		UserID: userID,
+		Status: enums.EstimateStatusDRAFT,
+	}
+
+	err = a.parseEstimate(r.Context(), reqBody.Data, estimate)
+	if err != nil {
+		renderEstimateError(err, w, r)
+
+		return
+	}
+
+	result, err := a.estimatesHandler.CreateEstimate(r.Context(), estimate)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, server.EstimateResponse{Data: serializeEstimateToAPIResponse(result)})
+}
+
+func (a *API) V1GetEstimate(w http.ResponseWriter, r *http.Request, estimateID openapi_types.UUID) {
+	estimate, ok := a.requireEstimate(w, r, estimateID)
+	if !ok {
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.EstimateResponse{Data: serializeEstimateToAPIResponse(estimate)})
+}
+
+// V1UpdateEstimate replaces the terms and lines of a draft estimate.
+func (a *API) V1UpdateEstimate(w http.ResponseWriter, r *http.Request, estimateID openapi_types.UUID) {
+	reqBody := new(server.V1UpdateEstimateJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	estimate, ok := a.requireEstimate(w, r, estimateID)
+	if !ok {
+		return
+	}
+
+	if !estimate.IsEditable() {
+		server.ConflictError(fmt.Errorf("%w: estimate is %s", ErrEstimateNotEditable, estimate.Status), nil, w, r)
+
+		return
+	}
+
+	err = a.parseEstimate(r.Context(), reqBody.Data, estimate)
+	if err != nil {
+		renderEstimateError(err, w, r)
+
+		return
+	}
+
+	err = a.estimatesHandler.estimatesRepo.UpdateEstimate(r.Context(), estimate)
+	if err != nil {
+		renderEstimateError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.EstimateResponse{Data: serializeEstimateToAPIResponse(estimate)})
+}
+
+func (a *API) V1DeleteEstimate(w http.ResponseWriter, r *http.Request, estimateID openapi_types.UUID) {
+	estimate, ok := a.requireEstimate(w, r, estimateID)
+	if !ok {
+		return
+	}
+
+	if estimate.InvoiceID != nil {
+		server.ConflictError(fmt.Errorf("%w and cannot be deleted", estimates.ErrEstimateConverted), nil, w, r)
+
+		return
+	}
+
+	err := a.estimatesHandler.estimatesRepo.DeleteEstimate(r.Context(), estimate.ID)
+	if err != nil {
+		renderEstimateError(err, w, r)
+
+		return
+	}
+
+	render.NoContent(w, r)
+}
+
+func (a *API) V1SendEstimate(w http.ResponseWriter, r *http.Request, estimateID openapi_types.UUID) {
+	estimate, token, err := a.estimatesHandler.SendEstimate(r.Context(), estimateID)
+	if err != nil {
+		renderEstimateError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.SentEstimateResponse{
+		Data: server.SentEstimate{
+			Estimate:    serializeEstimateToAPIResponse(estimate),
+			PublicToken: token,
+		},
+	})
+}
+
+// V1ConvertEstimate creates a draft invoice from an estimate. The invoice is issued today and due after the default
+// payment terms unless the request sets the dates.
+func (a *API) V1ConvertEstimate(w http.ResponseWriter, r *http.Request, estimateID openapi_types.UUID) {
+	reqBody := new(server.V1ConvertEstimateJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil && !errors.Is(err, io.EOF) {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	issueDate := time.Now()
+	if reqBody.Data != nil && reqBody.Data.IssueDate != nil {
+		issueDate = reqBody.Data.IssueDate.Time
+	}
+
+	dueDate := issueDate.AddDate(0, 0, defaultPaymentTermsDays)
+	if reqBody.Data != nil && reqBody.Data.DueDate != nil {
+		dueDate = reqBody.Data.DueDate.Time
+	}
+
+	invoice, err := a.estimatesHandler.ConvertEstimate(r.Context(), estimateID, issueDate, dueDate)
+	if err != nil {
+		renderEstimateError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, server.InvoiceResponse{Data: serializeInvoiceToAPIResponse(invoice)})
+}
+
+// V1GetPublicEstimate shows the estimate a customer link points to. The token is the only credential.
+func (a *API) V1GetPublicEstimate(w http.ResponseWriter, r *http.Request, token string) {
+	estimate, err := a.estimatesHandler.estimatesRepo.GetEstimateByTokenHash(auth.AsSystem(r.Context()), auth.HashLinkToken(token))
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if estimate == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.EstimateResponse{Data: serializeEstimateToAPIResponse(estimate)})
+}
+
+func (a *API) V1AcceptPublicEstimate(w http.ResponseWriter, r *http.Request, token string) {
+	a.respondToEstimate(w, r, token, enums.EstimateStatusACCEPTED)
+}
+
+func (a *API) V1DeclinePublicEstimate(w http.ResponseWriter, r *http.Request, token string) {
+	a.respondToEstimate(w, r, token, enums.EstimateStatusDECLINED)
+}
+
+func (a *API) respondToEstimate(w http.ResponseWriter, r *http.Request, token string, status enums.EstimateStatus) {
+	estimate, err := a.estimatesHandler.RespondToEstimate(r.Context(), token, status)
+	if err != nil {
+		renderEstimateError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.EstimateResponse{Data: serializeEstimateToAPIResponse(estimate)})
+}
+
+// requireEstimate loads an estimate, rendering the error response when it cannot be found.
+func (a *API) requireEstimate(w http.ResponseWriter, r *http.Request, estimateID openapi_types.UUID) (*estimates.Estimate, bool) {
+	estimate, err := a.estimatesHandler.estimatesRepo.GetEstimateByID(r.Context(), estimateID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return nil, false
+	}
+
+	if estimate == nil {
+		server.NotFoundError(w, r)
+
+		return nil, false
+	}
+
+	return estimate, true
+}
+
+// parseEstimate copies the terms and lines of a request onto an estimate and prices it.
+func (a *API) parseEstimate(ctx context.Context, data server.EstimateRequestBodyData, estimate *estimates.Estimate) error {
+	err := a.customersHandler.RequireCustomer(ctx, data.CustomerId)
+	if err != nil {
+		return err
+	}
+
+	estimate.Currency = money.DefaultCurrency
+	if data.Currency != nil {
+		estimate.Currency, err = money.ParseCurrency(lo.FromPtr(data.Currency))
+		if err != nil {
+			return fmt.Errorf("%w: %w", estimates.ErrInvalidEstimate, err)
+		}
+	}
+
+	estimate.DiscountType, estimate.DiscountValue, err = parseDiscount(data.Discount)
+	if err != nil {
+		return fmt.Errorf("%w: %w", estimates.ErrInvalidEstimate, err)
+	}
+
+	estimate.ShippingAmount = decimal.Zero
+	if data.ShippingAmount != nil {
+		estimate.ShippingAmount, err = money.ParseAmount(lo.FromPtr(data.ShippingAmount))
+		if err != nil {
+			return fmt.Errorf("%w: invalid shipping amount: %w", estimates.ErrInvalidEstimate, err)
+		}
+	}
+
+	items, err := a.parseItems(ctx, estimate.UserID, data.Items)
+	if err != nil {
+		return err
+	}
+
+	estimate.CustomerID = data.CustomerId
+	estimate.IssueDate = lo.FromPtrOr(dateToTime(data.IssueDate), time.Now())
+	estimate.ExpiryDate = data.ExpiryDate.Time
+	estimate.Notes = lo.FromPtr(data.Notes)
+	estimate.Items = items
+
+	err = estimate.Validate()
+	if err != nil {
+		return err
+	}
+
+	totals, err := invoices.CalculateTotals(
+		estimate.Items,
+		invoices.Discount{Type: estimate.DiscountType, Value: estimate.DiscountValue},
+		estimate.ShippingAmount,
+		estimate.Currency,
+	)
+	if err != nil {
+		return err
+	}
+
+	estimate.Subtotal = totals.Subtotal
+	estimate.DiscountAmount = totals.DiscountAmount
+	estimate.ShippingAmount = totals.ShippingAmount
+	estimate.TaxAmount = totals.TaxAmount
+	estimate.TotalAmount = totals.TotalAmount
+
+	return nil
+}
+
+func renderEstimateError(err error, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case errors.Is(err, estimates.ErrEstimateNotFound):
+		server.NotFoundError(w, r)
+	case errors.Is(err, estimates.ErrInvalidEstimate),
+		errors.Is(err, ErrInvalidItem),
+		errors.Is(err, ErrUnknownCustomer),
+		errors.Is(err, ErrUnknownTaxRate),
+		errors.Is(err, invoices.ErrInvalidDiscount),
+		errors.Is(err, invoices.ErrInvalidTaxRate),
+		errors.Is(err, money.ErrInvalidAmount):
+		server.BadRequestError(err, w, r)
+	case errors.Is(err, estimates.ErrInvalidStatusTransition),
+		errors.Is(err, estimates.ErrEstimateExpired),
+		errors.Is(err, estimates.ErrEstimateConverted),
+		errors.Is(err, invoices.ErrDuplicateInvoiceNumber):
+		server.ConflictError(err, nil, w, r)
+	default:
+		server.ProcessingError(err, w, r)
+	}
+}
+
+func serializeEstimateToAPIResponse(estimate *estimates.Estimate) server.Estimate {
+	items := lo.Map(estimate.Items, func(item *invoicesitems.InvoiceItem, _ int) server.Item {
+		serialized := serializeInvoiceItemsToAPIResponse(item, estimate.Currency)
+		serialized.InvoiceId = nil
+
+		return serialized
+	})
+
+	return server.Estimate{
+		Id:             estimate.ID,
+		EstimateNumber: estimate.EstimateNumber,
+		CustomerId:     estimate.CustomerID,
+		Status:         server.EstimateStatusEnum(estimate.Status),
+		Currency:       estimate.Currency.String(),
+		IssueDate:      openapi_types.Date{Time: estimate.IssueDate},
+		ExpiryDate:     openapi_types.Date{Time: estimate.ExpiryDate},
+		Items:          items,
+		Subtotal:       estimate.Currency.Format(estimate.Subtotal),
+		Discount:       serializeDiscount(estimate.DiscountType, estimate.DiscountValue),
+		DiscountAmount: estimate.Currency.Format(estimate.DiscountAmount),
+		ShippingAmount: estimate.Currency.Format(estimate.ShippingAmount),
+		TaxAmount:      estimate.Currency.Format(estimate.TaxAmount),
+		TotalAmount:    estimate.Currency.Format(estimate.TotalAmount),
+		Notes:          estimate.Notes,
+		RespondedAt:    estimate.RespondedAt,
+		InvoiceId:      estimate.InvoiceID,
+		CreatedAt:      estimate.CreatedAt,
+		UpdatedAt:      estimate.UpdatedAt,
+	}
+}
@@ -0,0 +1,267 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/repositories/activities"
+	activityEnums "invoice-backend/internal/repositories/activities/enums"
+	"invoice-backend/internal/repositories/deliveries"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/invoices/enums"
+	"invoice-backend/internal/repositories/payments"
+	paymentEnums "invoice-backend/internal/repositories/payments/enums"
+	"invoice-backend/internal/repositories/unitofwork"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+)
+
+var ErrInvoiceNotSendable = errors.New("invoice cannot be sent")
+
+// sendableStatuses can be emailed to the customer. Drafts are issued when sent, the others are reminders of an
+// open balance.
+var sendableStatuses = []enums.InvoiceStatus{
+	enums.InvoiceStatusDRAFT,
+	enums.InvoiceStatusPENDINGPAYMENT,
+	enums.InvoiceStatusOVERDUE,
+	enums.InvoiceStatusPARTIALLYPAID,
+}
+
+// V1SendInvoice emails the invoice to its customer and issues it when it is still a draft. The draft is only
+// issued once the email went out, so a failed delivery can simply be retried.
+func (a *API) V1SendInvoice(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	reqBody := new(server.V1SendInvoiceJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil && !errors.Is(err, io.EOF) {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	invoice, err := a.invoicesHandler.GetInvoice(r.Context(), invoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if invoice == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	if !lo.Contains(sendableStatuses, invoice.Status) {
+		server.ConflictError(fmt.Errorf("%w: invoice is %s", ErrInvoiceNotSendable, invoice.Status), nil, w, r)
+
+		return
+	}
+
+	options := SendOptions{}
+	if reqBody.Data != nil {
+		options.Recipient = string(lo.FromPtr(reqBody.Data.Recipient))
+		options.Message = lo.FromPtr(reqBody.Data.Message)
+	}
+
+	delivery, err := a.deliveriesHandler.SendInvoice(r.Context(), invoice, options)
+	if err != nil {
+		if errors.Is(err, ErrNoRecipient) {
+			server.BadRequestError(err, w, r)
+
+			return
+		}
+
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	err = issueSentInvoice(r.Context(), a.invoicesHandler.unitOfWork, invoice, delivery)
+	if err != nil {
+		renderInvoiceTransitionError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.InvoiceResponse{Data: serializeInvoiceToAPIResponse(invoice)})
+}
+
+func (a *API) V1VoidInvoice(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	a.handleInvoiceTransition(w, r, invoiceID, enums.InvoiceStatusVOID, activityEnums.ActivityTypeStatusChanged)
+}
+
+// V1MarkInvoicePaid records a payment covering the amount due. Invoices with nothing due are moved straight to PAID.
+func (a *API) V1MarkInvoicePaid(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	invoice, err := a.invoicesHandler.invoicesRepo.GetInvoiceByID(r.Context(), invoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if invoice == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	if !invoice.AmountDue.IsPositive() {
+		a.handleInvoiceTransition(w, r, invoiceID, enums.InvoiceStatusPAID, activityEnums.ActivityTypeStatusChanged)
+
+		return
+	}
+
+	_, err = a.paymentsHandler.RecordPayment(r.Context(), invoice.ID, &payments.Payment{
+		ID:     uuid.New(),
+		Type:   paymentEnums.PaymentTypePayment,
+		Amount: invoice.AmountDue,
+		Method: paymentEnums.PaymentMethodOther,
+		PaidAt: time.Now(),
+	})
+	if err != nil {
+		renderPaymentError(err, w, r)
+
+		return
+	}
+
+	paidInvoice, err := a.invoicesHandler.GetInvoice(r.Context(), invoice.ID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.InvoiceResponse{Data: serializeInvoiceToAPIResponse(paidInvoice)})
+}
+
+func (a *API) handleInvoiceTransition(
+	w http.ResponseWriter,
+	r *http.Request,
+	invoiceID openapi_types.UUID,
+	to enums.InvoiceStatus,
+	activityType activityEnums.ActivityType,
+) {
+	invoice, err := a.invoicesHandler.GetInvoice(r.Context(), invoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if invoice == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	err = a.invoicesHandler.TransitionInvoice(r.Context(), invoice, to, activityType)
+	if err != nil {
+		renderInvoiceTransitionError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.InvoiceResponse{Data: serializeInvoiceToAPIResponse(invoice)})
+}
+
+// TransitionInvoice moves the invoice to the given status through the invoice state machine and records the
+// change in the activity feed, in one transaction. The invoice is updated in place on success.
+func (h *InvoiceHandler) TransitionInvoice(
+	ctx context.Context,
+	invoice *invoices.Invoice,
+	to enums.InvoiceStatus,
+	activityType activityEnums.ActivityType,
+) error {
+	err := h.unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		return transitionInvoice(ctx, repos, invoice, to, activityType)
+	})
+	if err != nil {
+		return err
+	}
+
+	invoice.Status = to
+
+	return nil
+}
+
+// transitionInvoice is TransitionInvoice within the transaction of the given repositories. It leaves the invoice
+// untouched, callers update it once the transaction is committed.
+func transitionInvoice(
+	ctx context.Context,
+	repos *unitofwork.Repositories,
+	invoice *invoices.Invoice,
+	to enums.InvoiceStatus,
+	activityType activityEnums.ActivityType,
+) error {
+	from := invoice.Status
+
+	err := repos.Invoices.TransitionInvoiceStatus(ctx, invoice.ID, from, to)
+	if err != nil {
+		return err
+	}
+
+	return repos.Activities.CreateActivity(ctx, activities.NewInvoiceActivity(
+		activityType,
+		invoice.UserID,
+		invoice.ID,
+		fmt.Sprintf("Invoice %s status changed from %s to %s", invoice.InvoiceNumber, from, to),
+	))
+}
+
+// issueSentInvoice issues an invoice that was just emailed when it is still a draft, and records the delivery in
+// the activity feed, in one transaction.
+func issueSentInvoice(
+	ctx context.Context,
+	unitOfWork unitofwork.UnitOfWork,
+	invoice *invoices.Invoice,
+	delivery *deliveries.Delivery,
+) error {
+	issue := invoice.Status == enums.InvoiceStatusDRAFT
+
+	err := unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		if issue {
+			err := transitionInvoice(ctx, repos, invoice, enums.InvoiceStatusPENDINGPAYMENT, activityEnums.ActivityTypeStatusChanged)
+			if err != nil {
+				return err
+			}
+		}
+
+		return repos.Activities.CreateActivity(ctx, activities.NewInvoiceActivity(
+			activityEnums.ActivityTypeInvoiceSent,
+			invoice.UserID,
+			invoice.ID,
+			fmt.Sprintf("Invoice %s sent to %s", invoice.InvoiceNumber, delivery.Recipient),
+		))
+	})
+	if err != nil {
+		return err
+	}
+
+	if issue {
+		invoice.Status = enums.InvoiceStatusPENDINGPAYMENT
+	}
+
+	return nil
+}
+
+func renderInvoiceTransitionError(err error, w http.ResponseWriter, r *http.Request) {
+	if errors.Is(err, invoices.ErrInvalidStatusTransition) {
+		server.ConflictError(err, nil, w, r)
+
+		return
+	}
+
+	server.ProcessingError(err, w, r)
+}
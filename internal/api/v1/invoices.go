@@ -6,76 +6,146 @@ import (
 	"fmt"
 	"invoice-backend/internal/constants"
 	"net/http"
-	"strconv"
 	"strings"
+	"time"
 
 	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/repositories/activities"
+	activityEnums "invoice-backend/internal/repositories/activities/enums"
 	"invoice-backend/internal/repositories/invoices"
 	"invoice-backend/internal/repositories/invoices/enums"
 	"invoice-backend/internal/repositories/invoicesitems"
+	itemEnums "invoice-backend/internal/repositories/invoicesitems/enums"
+	sequenceEnums "invoice-backend/internal/repositories/sequences/enums"
+	"invoice-backend/internal/repositories/taxrates"
+	"invoice-backend/internal/repositories/unitofwork"
 	"invoice-backend/internal/shared"
+	"invoice-backend/pkg/money"
 
 	"github.com/go-chi/render"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrPageAndCursor        = errors.New("page and cursor cannot be combined")
+	ErrInvalidInvoiceFilter = errors.New("invalid invoice filter")
+	ErrInvalidItem          = errors.New("invalid item")
+	ErrMissingInvoiceField  = errors.New("missing invoice field")
 )
 
 type InvoiceHandler struct {
 	invoicesRepo      invoices.Repository
 	invoicesItemsRepo invoicesitems.Repository
+	unitOfWork        unitofwork.UnitOfWork
 }
 
-func NewInvoiceHandler(invoicesRepo invoices.Repository, invoiceItemsRepo invoicesitems.Repository) *InvoiceHandler {
+func NewInvoiceHandler(
+	invoicesRepo invoices.Repository,
+	invoiceItemsRepo invoicesitems.Repository,
+	unitOfWork unitofwork.UnitOfWork,
+) *InvoiceHandler {
 	return &InvoiceHandler{
 		invoicesRepo:      invoicesRepo,
 		invoicesItemsRepo: invoiceItemsRepo,
+		unitOfWork:        unitOfWork,
 	}
 }
 
 func (a *API) V1GetInvoices(w http.ResponseWriter, r *http.Request, reqBody server.V1GetInvoicesParams) {
 	var (
 		invoiceFilter *invoices.InvoiceDBFilter
-		page          = reqBody.Data.Page
-		pageSize      = reqBody.Data.PageSize
+		sortParam     = server.InvoiceSortCreatedAtDesc
+		page          = getDefaultPage()
+		pageSize      = getDefaultPageSize()
+		cursor        *shared.Cursor
 	)
 
-	params := reqBody.Data
+	if params := reqBody.Data; params != nil {
+		if params.Filters != nil {
+			filter, prepareErr := prepareInvoiceFilter(lo.FromPtr(params.Filters))
+			if prepareErr != nil {
+				server.BadRequestError(prepareErr, w, r)
+				return
+			}
 
-	if params.Filters != nil {
-		filter, prepareErr := prepareInvoiceFilter(lo.FromPtr(params.Filters))
-		if prepareErr != nil {
-			server.BadRequestError(prepareErr, w, r)
-			return
+			invoiceFilter = filter
 		}
 
-		invoiceFilter = filter
-	}
+		if params.Cursor != nil {
+			if params.Page != nil {
+				server.BadRequestError(ErrPageAndCursor, w, r)
+				return
+			}
 
-	if page == nil {
-		page = getDefaultPage()
+			decoded, decodeErr := shared.DecodeCursor(lo.FromPtr(params.Cursor))
+			if decodeErr != nil {
+				server.BadRequestError(decodeErr, w, r)
+				return
+			}
+
+			cursor = decoded
+		}
+
+		sortParam = lo.FromPtrOr(params.Sort, sortParam)
+		page = lo.CoalesceOrEmpty(params.Page, page)
+		pageSize = lo.CoalesceOrEmpty(params.PageSize, pageSize)
 	}
 
-	if pageSize == nil {
-		pageSize = getDefaultPageSize()
+	sort, err := shared.ParseSort(string(sortParam), invoices.SortColumns...)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+		return
 	}
 
 	paginationFilter := preparePagination(pageSize, page)
+	paginationFilter.Cursor = cursor
 
-	result, err := a.invoicesHandler.invoicesRepo.ListInvoices(r.Context(), invoiceFilter, paginationFilter)
-	if err != nil {
+	result, err := a.invoicesHandler.invoicesRepo.ListInvoices(r.Context(), invoiceFilter, sort, paginationFilter)
+	if errors.Is(err, shared.ErrCursorOrder) {
+		server.BadRequestError(err, w, r)
+
+		return
+	} else if err != nil {
 		server.ProcessingError(err, w, r)
 
 		return
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, lo.Map(result, func(invoice *invoices.Invoice, _ int) server.InvoiceResponseData {
-		return serializeInvoiceToAPIResponse(invoice)
-	}))
+	render.JSON(w, r, server.InvoicesResponse{
+		Data: lo.Map(result.Invoices, func(invoice *invoices.Invoice, _ int) server.InvoiceResponseData {
+			return serializeInvoiceToAPIResponse(invoice)
+		}),
+		Meta: serializeInvoicesPagination(result),
+	})
+}
+
+// serializeInvoicesPagination leaves out the counts of pages selected by cursor, which are not computed.
+func serializeInvoicesPagination(result *invoices.FindAllInvoicesResult) server.PaginationMeta {
+	meta := server.PaginationMeta{PageSize: int(result.PageSize)}
+
+	if result.Page > 0 {
+		meta.Page = lo.ToPtr(int(result.Page))
+		meta.PageCount = lo.ToPtr(int(result.PageCount))
+		meta.TotalCount = lo.ToPtr(int(result.TotalCount))
+	}
+
+	if result.NextCursor != nil {
+		meta.NextCursor = lo.ToPtr(result.NextCursor.Encode())
+	}
+
+	return meta
 }
 
 func (a *API) V1CreateInvoice(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
 	reqBody := new(server.V1CreateInvoiceJSONRequestBody)
 
 	err := render.DecodeJSON(r.Body, reqBody)
@@ -86,41 +156,101 @@ func (a *API) V1CreateInvoice(w http.ResponseWriter, r *http.Request) {
 	}
 
 	invoiceData := reqBody.Data
-	invoiceNum, err := a.invoicesHandler.GenerateNextInvoiceNumber(r.Context())
+
+	if invoiceData.CustomerId == uuid.Nil {
+		server.BadRequestError(fmt.Errorf("%w: customer_id", ErrMissingInvoiceField), w, r)
+
+		return
+	}
+
+	if invoiceData.DueDate.IsZero() {
+		server.BadRequestError(fmt.Errorf("%w: due_date", ErrMissingInvoiceField), w, r)
+
+		return
+	}
+
+	err = a.customersHandler.RequireCustomer(r.Context(), invoiceData.CustomerId)
+	if err != nil {
+		if errors.Is(err, ErrUnknownCustomer) {
+			server.BadRequestError(err, w, r)
+
+			return
+		}
+
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	currency := money.DefaultCurrency
+	if invoiceData.Currency != nil {
+		currency, err = money.ParseCurrency(lo.FromPtr(invoiceData.Currency))
+		if err != nil {
+			server.BadRequestError(err, w, r)
+
+			return
+		}
+	}
+
+	discountType, discountValue, err := parseDiscount(invoiceData.Discount)
 	if err != nil {
 		server.BadRequestError(err, w, r)
+
 		return
 	}
+
+	shippingAmount := decimal.Zero
+	if invoiceData.ShippingAmount != nil {
+		shippingAmount, err = money.ParseAmount(lo.FromPtr(invoiceData.ShippingAmount))
+		if err != nil {
+			server.BadRequestError(fmt.Errorf("invalid shipping amount: %w", err), w, r)
+
+			return
+		}
+	}
+
 	newInvoice := &invoices.DBInvoice{
-		ID:            uuid.New(),
-		UserID:        lo.FromPtr(invoiceData.UserId),
-		CustomerID:    lo.FromPtr(invoiceData.CustomerId),
-		InvoiceNumber: invoiceNum,
-		DueDate:       invoiceData.DueDate.Time,
-		Status:        enums.InvoiceStatusDRAFT,
+		ID:             uuid.New(),
+		UserID:         userID,
+		CustomerID:     invoiceData.CustomerId,
+		DueDate:        invoiceData.DueDate.Time,
+		IssueDate:      lo.FromPtrOr(invoiceData.IssueDate, openapi_types.Date{Time: time.Now()}).Time,
+		Status:         enums.InvoiceStatusDRAFT,
+		Currency:       currency,
+		DiscountType:   discountType,
+		DiscountValue:  discountValue,
+		ShippingAmount: shippingAmount,
 	}
 
-	var totalAmount float64
+	newInvoice.Items, err = a.parseItems(r.Context(), userID, invoiceData.Items)
+	if err != nil {
+		if errors.Is(err, ErrInvalidItem) || errors.Is(err, ErrUnknownTaxRate) {
+			server.BadRequestError(err, w, r)
 
-	newInvoice.Items = lo.Map(invoiceData.Items, func(item server.Item, _ int) *invoicesitems.InvoiceItem {
-		totalPrice := float64(float32(lo.FromPtr(item.Quantity)) * lo.FromPtr(item.UnitPrice))
+			return
+		}
 
-		totalAmount += totalPrice
+		server.ProcessingError(err, w, r)
 
-		return &invoicesitems.InvoiceItem{
-			ID:          uuid.New(),
-			Description: lo.FromPtr(item.Description),
-			InvoiceID:   newInvoice.ID,
-			Quantity:    lo.FromPtr(item.Quantity),
-			UnitPrice:   float64(lo.FromPtr(item.UnitPrice)),
-			TotalPrice:  totalPrice,
-		}
-	})
+		return
+	}
 
-	newInvoice.TotalAmount = totalAmount
+	// Prices the lines up front so that invalid discounts are reported before anything is stored.
+	_, err = invoices.CalculateTotals(newInvoice.Items, invoices.Discount{Type: discountType, Value: discountValue}, shippingAmount, currency)
+	if err != nil {
+		server.BadRequestError(err, w, r)
 
-	result, err := a.invoicesHandler.invoicesRepo.CreateInvoice(r.Context(), newInvoice)
+		return
+	}
+
+	result, err := a.invoicesHandler.CreateInvoice(r.Context(), newInvoice)
 	if err != nil {
+		if errors.Is(err, invoices.ErrDuplicateInvoiceNumber) {
+			server.ConflictError(err, nil, w, r)
+
+			return
+		}
+
 		server.ProcessingError(err, w, r)
 
 		return
@@ -128,68 +258,507 @@ func (a *API) V1CreateInvoice(w http.ResponseWriter, r *http.Request) {
 
 	response := serializeInvoiceToAPIResponse(result)
 	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, response)
+	render.JSON(w, r, server.InvoiceResponse{Data: response})
+}
+
+func (a *API) V1GetInvoice(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	invoice, err := a.invoicesHandler.GetInvoice(r.Context(), invoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if invoice == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.InvoiceResponse{Data: serializeInvoiceToAPIResponse(invoice)})
+}
+
+func (a *API) V1UpdateInvoice(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	reqBody := new(server.V1UpdateInvoiceJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	invoice, err := a.invoicesHandler.GetInvoice(r.Context(), invoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if invoice == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	updateData := reqBody.Data
+	targetStatus := invoice.Status
+
+	if updateData.Status != nil {
+		status, parseErr := enums.ParseInvoiceStatus(string(lo.FromPtr(updateData.Status)))
+		if parseErr != nil {
+			server.BadRequestError(fmt.Errorf("invalid invoice status: %s", parseErr.Error()), w, r)
+
+			return
+		}
+
+		targetStatus = status
+	}
+
+	if targetStatus != invoice.Status && invoices.IsPaymentStatus(targetStatus) {
+		server.ConflictError(fmt.Errorf("invoice cannot be set to %s directly, record a payment instead", targetStatus), nil, w, r)
+
+		return
+	}
+
+	if targetStatus != invoice.Status {
+		transitionErr := invoices.ValidateTransition(invoice.Status, targetStatus)
+		if transitionErr != nil {
+			server.ConflictError(transitionErr, nil, w, r)
+
+			return
+		}
+	}
+
+	if updateData.DueDate != nil && invoices.IsTerminalStatus(invoice.Status) {
+		server.ConflictError(fmt.Errorf("invoice in status %s can no longer be edited", invoice.Status), nil, w, r)
+
+		return
+	}
+
+	err = a.invoicesHandler.UpdateInvoice(r.Context(), invoice, dateToTime(updateData.DueDate), targetStatus)
+	if err != nil {
+		renderInvoiceTransitionError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.InvoiceResponse{Data: serializeInvoiceToAPIResponse(invoice)})
+}
+
+func (a *API) V1DeleteInvoice(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	invoice, err := a.invoicesHandler.invoicesRepo.GetInvoiceByID(r.Context(), invoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if invoice == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	paymentsCount, err := a.paymentsHandler.paymentsRepo.CountPayments(r.Context(), invoice.ID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if paymentsCount > 0 {
+		server.ConflictError(errors.New("invoice has recorded payments and cannot be deleted"), nil, w, r)
+
+		return
+	}
+
+	creditNotesCount, err := a.creditNotesHandler.creditNotesRepo.CountCreditNotes(r.Context(), invoice.ID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if creditNotesCount > 0 {
+		server.ConflictError(errors.New("invoice has issued credit notes and cannot be deleted"), nil, w, r)
+
+		return
+	}
+
+	err = a.invoicesHandler.DeleteInvoice(r.Context(), invoice)
+	if err != nil {
+		if errors.Is(err, invoices.ErrInvoiceNotFound) {
+			server.NotFoundError(w, r)
+
+			return
+		}
+
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.NoContent(w, r)
+}
+
+// UpdateInvoice changes the due date of the invoice when dueDate is set and moves it to the given status, recording
+// an activity for each change, in one transaction. The invoice is updated in place once the transaction is committed.
+func (h *InvoiceHandler) UpdateInvoice(
+	ctx context.Context,
+	invoice *invoices.Invoice,
+	dueDate *time.Time,
+	status enums.InvoiceStatus,
+) error {
+	err := h.unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		if dueDate != nil {
+			updated := *invoice
+			updated.DueDate = *dueDate
+
+			err := repos.Invoices.UpdateInvoice(ctx, &updated)
+			if err != nil {
+				return err
+			}
+
+			err = repos.Activities.CreateActivity(ctx, activities.NewInvoiceActivity(
+				activityEnums.ActivityTypeInvoiceUpdated,
+				invoice.UserID,
+				invoice.ID,
+				fmt.Sprintf("Invoice %s due date changed to %s", invoice.InvoiceNumber, dueDate.Format(time.DateOnly)),
+			))
+			if err != nil {
+				return err
+			}
+		}
+
+		if status == invoice.Status {
+			return nil
+		}
+
+		return transitionInvoice(ctx, repos, invoice, status, activityEnums.ActivityTypeStatusChanged)
+	})
+	if err != nil {
+		return err
+	}
+
+	if dueDate != nil {
+		invoice.DueDate = *dueDate
+	}
+
+	invoice.Status = status
+
+	return nil
+}
+
+// DeleteInvoice records the deletion activity and deletes the invoice in one transaction. The activity is written
+// first: deleting the invoice then clears its link to it, whereas an activity written afterwards would reference
+// a missing invoice and be rejected.
+func (h *InvoiceHandler) DeleteInvoice(ctx context.Context, invoice *invoices.Invoice) error {
+	return h.unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		err := repos.Activities.CreateActivity(ctx, activities.NewInvoiceActivity(
+			activityEnums.ActivityTypeInvoiceDeleted,
+			invoice.UserID,
+			invoice.ID,
+			fmt.Sprintf("Invoice %s deleted", invoice.InvoiceNumber),
+		))
+		if err != nil {
+			return err
+		}
+
+		return repos.Invoices.DeleteInvoice(ctx, invoice.ID)
+	})
+}
+
+// CreateInvoice allocates the invoice number, stores the invoice with its items and records the creation activity
+// in one transaction. The totals are recomputed from the items as stored, whatever the caller set.
+func (h *InvoiceHandler) CreateInvoice(ctx context.Context, invoice *invoices.DBInvoice) (*invoices.Invoice, error) {
+	var result *invoices.Invoice
+
+	err := h.unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		created, err := createInvoice(ctx, repos, invoice)
+		if err != nil {
+			return err
+		}
+
+		result = created
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// createInvoice is CreateInvoice within the transaction of the given repositories.
+func createInvoice(ctx context.Context, repos *unitofwork.Repositories, invoice *invoices.DBInvoice) (*invoices.Invoice, error) {
+	items := invoice.Items
+	invoice.Items = nil
+
+	invoiceNumber, err := repos.Sequences.NextNumber(ctx, invoice.UserID, sequenceEnums.DocumentTypeInvoice, invoice.IssueDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate invoice number: %w", err)
+	}
+
+	invoice.InvoiceNumber = invoiceNumber
+
+	created, err := repos.Invoices.CreateInvoice(ctx, invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		item.InvoiceID = created.ID
+	}
+
+	err = repos.InvoiceItems.CreateInvoiceItems(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	storedItems, err := repos.InvoiceItems.GetInvoiceItemsByInvoiceID(ctx, created.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	created.Items = lo.ToSlicePtr(storedItems)
+
+	totals, err := invoices.CalculateTotals(
+		created.Items,
+		invoices.Discount{Type: created.DiscountType, Value: created.DiscountValue},
+		created.ShippingAmount,
+		created.Currency,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repos.Invoices.UpdateTotals(ctx, created.ID, totals)
+	if err != nil {
+		return nil, err
+	}
+
+	applyTotals(created, totals)
+
+	err = repos.Activities.CreateActivity(ctx, activities.NewInvoiceActivity(
+		activityEnums.ActivityTypeInvoiceCreated,
+		created.UserID,
+		created.ID,
+		fmt.Sprintf("Invoice %s created", created.InvoiceNumber),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// GetInvoice fetches an invoice together with its line items, returning nil when it does not exist.
+func (h *InvoiceHandler) GetInvoice(ctx context.Context, invoiceID uuid.UUID) (*invoices.Invoice, error) {
+	invoice, err := h.invoicesRepo.GetInvoiceByID(ctx, invoiceID)
+	if err != nil || invoice == nil {
+		return nil, err
+	}
+
+	items, err := h.invoicesItemsRepo.GetInvoiceItemsByInvoiceID(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice.Items = lo.ToSlicePtr(items)
+
+	return invoice, nil
+}
+
+// parseItems builds the lines of a new document of the given user from the request items, copying the tax rates
+// they reference from the catalogue. Items referencing a product are prefilled from it, see prefillItem. Invalid
+// items, including those referencing an unknown or inactive product, fail with ErrInvalidItem and unknown tax rates
+// with ErrUnknownTaxRate. The lines are not priced yet, see invoices.CalculateTotals.
+func (a *API) parseItems(ctx context.Context, userID uuid.UUID, items []server.Item) ([]*invoicesitems.InvoiceItem, error) {
+	productIDs := lo.FilterMap(items, func(item server.Item, _ int) (uuid.UUID, bool) {
+		return lo.FromPtr(item.ProductId), item.ProductId != nil
+	})
+
+	productsByID, err := a.productsHandler.ResolveProducts(ctx, userID, productIDs)
+	if err != nil {
+		if errors.Is(err, ErrUnknownProduct) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidItem, err)
+		}
+
+		return nil, err
+	}
+
+	for _, productID := range productIDs {
+		if !productsByID[productID].Active {
+			return nil, fmt.Errorf("%w: product %s is inactive", ErrInvalidItem, productID)
+		}
+	}
+
+	items = lo.Map(items, func(item server.Item, _ int) server.Item {
+		if item.ProductId == nil {
+			return item
+		}
+
+		return prefillItem(item, productsByID[*item.ProductId])
+	})
+
+	taxRateIDs := lo.FlatMap(items, func(item server.Item, _ int) []uuid.UUID {
+		return lo.FromPtr(item.TaxRateIds)
+	})
+
+	taxRatesByID, err := a.taxRatesHandler.ResolveTaxRates(ctx, userID, taxRateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := make([]*invoicesitems.InvoiceItem, 0, len(items))
+
+	for position, item := range items {
+		unitPrice, err := money.ParseAmount(lo.FromPtr(item.UnitPrice))
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid unit price: %w", ErrInvalidItem, err)
+		}
+
+		discountType, discountValue, err := parseDiscount(item.Discount)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidItem, err)
+		}
+
+		parsed = append(parsed, &invoicesitems.InvoiceItem{
+			ID:            uuid.New(),
+			Description:   lo.FromPtr(item.Description),
+			Position:      position,
+			ProductID:     item.ProductId,
+			Quantity:      lo.FromPtr(item.Quantity),
+			UnitPrice:     unitPrice,
+			DiscountType:  discountType,
+			DiscountValue: discountValue,
+			Taxes:         itemTaxes(lo.FromPtr(item.TaxRateIds), taxRatesByID),
+		})
+	}
+
+	return parsed, nil
+}
+
+// itemTaxes copies the given tax rates, resolved beforehand, onto a line.
+func itemTaxes(taxRateIDs []uuid.UUID, taxRatesByID map[uuid.UUID]*taxrates.TaxRate) []*invoicesitems.InvoiceItemTax {
+	return lo.Map(taxRateIDs, func(taxRateID uuid.UUID, _ int) *invoicesitems.InvoiceItemTax {
+		taxRate := taxRatesByID[taxRateID]
+
+		return &invoicesitems.InvoiceItemTax{
+			TaxRateID: lo.ToPtr(taxRate.ID),
+			Name:      taxRate.Name,
+			Rate:      taxRate.Rate,
+			Compound:  taxRate.Compound,
+		}
+	})
 }
 
 func serializeInvoiceToAPIResponse(invoice *invoices.Invoice) server.InvoiceResponseData {
 	items := lo.Map(invoice.Items, func(items *invoicesitems.InvoiceItem, _ int) server.Item {
-		return serializeInvoiceItemsToAPIResponse(items)
+		return serializeInvoiceItemsToAPIResponse(items, invoice.Currency)
 	})
 
 	return server.InvoiceResponseData{
-		DueDate:     openapi_types.Date{Time: invoice.DueDate},
-		Id:          invoice.ID,
-		Items:       items,
-		Sender:      "nil",
-		Status:      server.InvoiceStatusEnum(invoice.Status),
-		TotalAmount: lo.ToPtr(float32(invoice.TotalAmount)),
+		Currency:           lo.ToPtr(invoice.Currency.String()),
+		Customer:           invoice.CustomerID.String(),
+		Discount:           serializeDiscount(invoice.DiscountType, invoice.DiscountValue),
+		DiscountAmount:     lo.ToPtr(invoice.Currency.Format(invoice.DiscountAmount)),
+		DueDate:            openapi_types.Date{Time: invoice.DueDate},
+		Id:                 invoice.ID,
+		InvoiceNumber:      lo.ToPtr(invoice.InvoiceNumber),
+		IssueDate:          &openapi_types.Date{Time: invoice.IssueDate},
+		Items:              items,
+		Sender:             invoice.UserID.String(),
+		ShippingAmount:     lo.ToPtr(invoice.Currency.Format(invoice.ShippingAmount)),
+		Status:             server.InvoiceStatusEnum(invoice.Status),
+		Subtotal:           lo.ToPtr(invoice.Currency.Format(invoice.Subtotal)),
+		TaxAmount:          lo.ToPtr(invoice.Currency.Format(invoice.TaxAmount)),
+		TotalAmount:        lo.ToPtr(invoice.Currency.Format(invoice.TotalAmount)),
+		AmountPaid:         lo.ToPtr(invoice.Currency.Format(invoice.AmountPaid)),
+		AmountCredited:     lo.ToPtr(invoice.Currency.Format(invoice.AmountCredited)),
+		AmountDue:          lo.ToPtr(invoice.Currency.Format(invoice.AmountDue)),
+		RecurringInvoiceId: invoice.RecurringID,
+		EstimateId:         invoice.EstimateID,
 	}
 }
 
-func serializeInvoiceItemsToAPIResponse(item *invoicesitems.InvoiceItem) server.Item {
+func serializeInvoiceItemsToAPIResponse(item *invoicesitems.InvoiceItem, currency money.Currency) server.Item {
+	taxes := lo.Map(item.Taxes, func(tax *invoicesitems.InvoiceItemTax, _ int) server.ItemTax {
+		return server.ItemTax{
+			Amount:    currency.Format(tax.Amount),
+			Compound:  tax.Compound,
+			Name:      tax.Name,
+			Rate:      formatRate(tax.Rate),
+			TaxRateId: tax.TaxRateID,
+		}
+	})
+
 	return server.Item{
-		Description: &item.Description,
-		Id:          &item.ID,
-		InvoiceId:   &item.InvoiceID,
-		Quantity:    &item.Quantity,
-		TotalPrice:  lo.ToPtr(float32(item.TotalPrice)),
-		UnitPrice:   lo.ToPtr(float32(item.UnitPrice)),
+		Description:    &item.Description,
+		Discount:       serializeDiscount(item.DiscountType, item.DiscountValue),
+		DiscountAmount: lo.ToPtr(currency.Format(item.DiscountAmount)),
+		Id:             &item.ID,
+		InvoiceId:      &item.InvoiceID,
+		ProductId:      item.ProductID,
+		Quantity:       &item.Quantity,
+		TaxAmount:      lo.ToPtr(currency.Format(item.TaxAmount)),
+		Taxes:          &taxes,
+		TotalPrice:     lo.ToPtr(currency.Format(item.TotalPrice)),
+		UnitPrice:      lo.ToPtr(currency.Format(item.UnitPrice)),
 	}
 }
 
-func prepareInvoiceFilter(filter server.InvoiceFilters) (*invoices.InvoiceDBFilter, error) {
-	validationErr := validateInvoicesFilter(filter)
-	if validationErr != nil {
-		return nil, validationErr
+func serializeDiscount(discountType *itemEnums.DiscountType, value decimal.Decimal) *server.Discount {
+	if discountType == nil {
+		return nil
 	}
 
-	ids := make([]*uuid.UUID, 0)
-	userIds := make([]*uuid.UUID, 0)
-	customerIds := make([]*uuid.UUID, 0)
-	invoiceNumbers := make([]*string, 0)
-	invoiceStatus := make([]*enums.InvoiceStatus, 0)
+	return &server.Discount{
+		Type:  server.DiscountTypeEnum(*discountType),
+		Value: value.String(),
+	}
+}
 
-	if filter.Id != nil {
-		for _, id := range lo.FromPtr(filter.Id) {
-			invId := uuid.MustParse(id)
-			ids = append(ids, &invId)
-		}
+func parseDiscount(discount *server.Discount) (*itemEnums.DiscountType, decimal.Decimal, error) {
+	if discount == nil {
+		return nil, decimal.Zero, nil
 	}
 
-	if filter.UserId != nil {
-		for _, userID := range lo.FromPtr(filter.UserId) {
-			usId := uuid.MustParse(userID)
-			userIds = append(userIds, &usId)
-		}
+	discountType, err := itemEnums.ParseDiscountType(string(discount.Type))
+	if err != nil {
+		return nil, decimal.Zero, fmt.Errorf("invalid discount type: %w", err)
 	}
 
-	if filter.CustomerId != nil {
-		for _, customerID := range lo.FromPtr(filter.CustomerId) {
-			cusId := uuid.MustParse(customerID)
-			customerIds = append(customerIds, &cusId)
-		}
+	value, err := money.ParseAmount(discount.Value)
+	if err != nil {
+		return nil, decimal.Zero, fmt.Errorf("invalid discount value: %w", err)
+	}
+
+	return &discountType, value, nil
+}
+
+// applyTotals copies a computed breakdown onto an invoice.
+func applyTotals(invoice *invoices.Invoice, totals invoices.Totals) {
+	invoice.Subtotal = totals.Subtotal
+	invoice.DiscountAmount = totals.DiscountAmount
+	invoice.ShippingAmount = totals.ShippingAmount
+	invoice.TaxAmount = totals.TaxAmount
+	invoice.TotalAmount = totals.TotalAmount
+	invoice.AmountDue = totals.TotalAmount.Sub(invoice.AmountPaid).Sub(invoice.AmountCredited)
+}
+
+func prepareInvoiceFilter(filter server.InvoiceFilters) (*invoices.InvoiceDBFilter, error) {
+	toUUIDPtrs := func(ids *[]openapi_types.UUID) []*uuid.UUID {
+		return lo.Map(lo.FromPtr(ids), func(id openapi_types.UUID, _ int) *uuid.UUID { return lo.ToPtr(id) })
 	}
 
+	invoiceNumbers := make([]*string, 0)
+	invoiceStatus := make([]*enums.InvoiceStatus, 0)
+
 	if filter.InvoiceNumber != nil {
 		for _, invoiceNumber := range lo.FromPtr(filter.InvoiceNumber) {
 			invoiceNumbers = append(invoiceNumbers, &invoiceNumber)
@@ -207,23 +776,83 @@ func prepareInvoiceFilter(filter server.InvoiceFilters) (*invoices.InvoiceDBFilt
 		}
 	}
 
-	return &invoices.InvoiceDBFilter{
-		CustomerID:    customerIds,
-		UserID:        userIds,
-		ID:            ids,
+	dbFilter := &invoices.InvoiceDBFilter{
+		CustomerID:    toUUIDPtrs(filter.CustomerId),
+		UserID:        toUUIDPtrs(filter.UserId),
+		ID:            toUUIDPtrs(filter.Id),
 		InvoiceNumber: invoiceNumbers,
 		Status:        invoiceStatus,
-	}, nil
+		RecurringID:   toUUIDPtrs(filter.RecurringInvoiceId),
+		CreatedAfter:  formatDate(filter.CreatedAfter),
+		CreatedBefore: formatDate(filter.CreatedBefore),
+		IssueDateFrom: dateToTime(filter.IssueDateFrom),
+		IssueDateTo:   dateToTime(filter.IssueDateTo),
+		DueDateFrom:   dateToTime(filter.DueDateFrom),
+		DueDateTo:     dateToTime(filter.DueDateTo),
+		Overdue:       lo.FromPtr(filter.Overdue),
+		Search:        strings.TrimSpace(lo.FromPtr(filter.Search)),
+	}
+
+	for _, bound := range []struct {
+		value  *string
+		target **decimal.Decimal
+	}{
+		{filter.MinTotal, &dbFilter.MinTotal},
+		{filter.MaxTotal, &dbFilter.MaxTotal},
+	} {
+		if bound.value == nil {
+			continue
+		}
+
+		amount, err := money.ParseAmount(lo.FromPtr(bound.value))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInvoiceFilter, err)
+		}
+
+		*bound.target = &amount
+	}
+
+	validationErr := validateInvoicesFilter(dbFilter)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	return dbFilter, nil
 }
 
-func validateInvoicesFilter(filter server.InvoiceFilters) error {
-	if filter.Id == nil && filter.CustomerId == nil && filter.UserId == nil && filter.InvoiceNumber == nil && filter.Status == nil {
-		return errors.New("at least one filter must be provided")
+// validateInvoicesFilter rejects ranges that cannot match anything, which are most likely swapped bounds.
+func validateInvoicesFilter(filter *invoices.InvoiceDBFilter) error {
+	if filter.MinTotal != nil && filter.MaxTotal != nil && filter.MinTotal.GreaterThan(*filter.MaxTotal) {
+		return fmt.Errorf("%w: min_total is greater than max_total", ErrInvalidInvoiceFilter)
+	}
+
+	if filter.IssueDateFrom != nil && filter.IssueDateTo != nil && filter.IssueDateFrom.After(*filter.IssueDateTo) {
+		return fmt.Errorf("%w: issue_date_from is after issue_date_to", ErrInvalidInvoiceFilter)
+	}
+
+	if filter.DueDateFrom != nil && filter.DueDateTo != nil && filter.DueDateFrom.After(*filter.DueDateTo) {
+		return fmt.Errorf("%w: due_date_from is after due_date_to", ErrInvalidInvoiceFilter)
 	}
 
 	return nil
 }
 
+func dateToTime(date *openapi_types.Date) *time.Time {
+	if date == nil {
+		return nil
+	}
+
+	return lo.ToPtr(date.Time)
+}
+
+func formatDate(date *openapi_types.Date) *string {
+	if date == nil {
+		return nil
+	}
+
+	return lo.ToPtr(date.Format(openapi_types.DateFormat))
+}
+
 func preparePagination(limit, page *int) shared.Pagination {
 	return shared.Pagination{
 		Limit: limit,
@@ -238,26 +867,3 @@ func getDefaultPageSize() *int {
 func getDefaultPage() *int {
 	return lo.ToPtr(constants.DefaultPageNumber)
 }
-
-func (h *InvoiceHandler) GenerateNextInvoiceNumber(ctx context.Context) (string, error) {
-	lastInvoice, err := h.invoicesRepo.FetchLastInvoice(ctx)
-	if err != nil {
-		return "", fmt.Errorf("failed to fetch last invoice: %w", err)
-	}
-
-	// Default to "INV0000001" if no invoices exist
-	if lastInvoice == nil || lastInvoice.InvoiceNumber == "" {
-		return "INV0000001", nil
-	}
-
-	// Extract the numeric part of the last invoice number
-	lastNumber := strings.TrimPrefix(lastInvoice.InvoiceNumber, "INV")
-	parsedNumber, parseErr := strconv.Atoi(lastNumber)
-	if parseErr != nil {
-		return "", fmt.Errorf("invalid invoice number format: %w", parseErr)
-	}
-
-	// Increment the numeric part and format it with leading zeros
-	nextNumber := fmt.Sprintf("INV%07d", parsedNumber+1)
-	return nextNumber, nil
-}
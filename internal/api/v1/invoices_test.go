@@ -0,0 +1,359 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/activities"
+	activityEnums "invoice-backend/internal/repositories/activities/enums"
+	"invoice-backend/internal/repositories/customers"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/invoices/enums"
+	"invoice-backend/internal/repositories/invoicesitems"
+	"invoice-backend/internal/repositories/sequences"
+	sequenceEnums "invoice-backend/internal/repositories/sequences/enums"
+	"invoice-backend/internal/repositories/unitofwork"
+	openAPIUtils "invoice-backend/pkg/openapi"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUnitOfWork struct {
+	repos        *unitofwork.Repositories
+	transactions int
+}
+
+func (u *fakeUnitOfWork) Do(_ context.Context, fn func(repos *unitofwork.Repositories) error) error {
+	u.transactions++
+
+	return fn(u.repos)
+}
+
+type fakeActivities struct {
+	activities.Repository
+
+	created []*activities.Activity
+}
+
+func (r *fakeActivities) CreateActivity(_ context.Context, activity *activities.Activity) error {
+	r.created = append(r.created, activity)
+
+	return nil
+}
+
+// fakeInvoices deletes invoices the way the database does: activities referencing a deleted invoice are kept and
+// their link is cleared, like activities.fk_invoice, whereas linking an activity to a deleted invoice fails.
+type fakeInvoices struct {
+	invoices.Repository
+
+	activities    *fakeActivities
+	deleted       []uuid.UUID
+	numbers       []string
+	updated       []*invoices.Invoice
+	transitions   []enums.InvoiceStatus
+	err           error
+	transitionErr error
+}
+
+// CreateInvoice rejects invoice numbers that were already issued, like invoices_user_id_invoice_number_key.
+func (r *fakeInvoices) CreateInvoice(_ context.Context, invoice *invoices.DBInvoice) (*invoices.Invoice, error) {
+	if lo.Contains(r.numbers, invoice.InvoiceNumber) {
+		return nil, fmt.Errorf("%w: %s", invoices.ErrDuplicateInvoiceNumber, invoice.InvoiceNumber)
+	}
+
+	r.numbers = append(r.numbers, invoice.InvoiceNumber)
+
+	return invoices.FromDBInvoice(invoice), nil
+}
+
+func (r *fakeInvoices) UpdateTotals(_ context.Context, _ uuid.UUID, _ invoices.Totals) error {
+	return nil
+}
+
+func (r *fakeInvoices) UpdateInvoice(_ context.Context, invoice *invoices.Invoice) error {
+	r.updated = append(r.updated, invoice)
+
+	return nil
+}
+
+func (r *fakeInvoices) TransitionInvoiceStatus(_ context.Context, _ uuid.UUID, _, to enums.InvoiceStatus) error {
+	if r.transitionErr != nil {
+		return r.transitionErr
+	}
+
+	r.transitions = append(r.transitions, to)
+
+	return nil
+}
+
+func (r *fakeInvoices) DeleteInvoice(_ context.Context, id uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.deleted = append(r.deleted, id)
+
+	for _, activity := range r.activities.created {
+		if lo.FromPtr(activity.InvoiceID) == id {
+			activity.InvoiceID = nil
+		}
+	}
+
+	return nil
+}
+
+func TestDeleteInvoiceKeepsTheDeletionActivity(t *testing.T) {
+	activitiesRepo := &fakeActivities{}
+	invoicesRepo := &fakeInvoices{activities: activitiesRepo}
+	uow := &fakeUnitOfWork{repos: &unitofwork.Repositories{Activities: activitiesRepo, Invoices: invoicesRepo}}
+	invoice := &invoices.Invoice{ID: uuid.New(), UserID: uuid.New(), InvoiceNumber: "INV-0000042"}
+
+	err := NewInvoiceHandler(invoicesRepo, nil, uow).DeleteInvoice(context.Background(), invoice)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, uow.transactions, "the activity and the deletion share a transaction")
+	assert.Equal(t, []uuid.UUID{invoice.ID}, invoicesRepo.deleted)
+	require.Len(t, activitiesRepo.created, 1)
+	assert.Equal(t, activityEnums.ActivityTypeInvoiceDeleted, activitiesRepo.created[0].Type)
+	assert.Equal(t, invoice.UserID, activitiesRepo.created[0].UserID)
+	assert.Equal(t, "Invoice INV-0000042 deleted", activitiesRepo.created[0].Description)
+	assert.Nil(t, activitiesRepo.created[0].InvoiceID, "written before the deletion, which cleared the link")
+}
+
+func TestDeleteInvoiceFailsWithTheDeletion(t *testing.T) {
+	activitiesRepo := &fakeActivities{}
+	invoicesRepo := &fakeInvoices{activities: activitiesRepo, err: invoices.ErrInvoiceNotFound}
+	uow := &fakeUnitOfWork{repos: &unitofwork.Repositories{Activities: activitiesRepo, Invoices: invoicesRepo}}
+
+	err := NewInvoiceHandler(invoicesRepo, nil, uow).DeleteInvoice(context.Background(), &invoices.Invoice{ID: uuid.New()})
+
+	assert.ErrorIs(t, err, invoices.ErrInvoiceNotFound, "the transaction is rolled back")
+}
+
+func TestUpdateInvoiceChangesTheDueDateAndStatusTogether(t *testing.T) {
+	activitiesRepo := &fakeActivities{}
+	invoicesRepo := &fakeInvoices{activities: activitiesRepo}
+	uow := &fakeUnitOfWork{repos: &unitofwork.Repositories{Activities: activitiesRepo, Invoices: invoicesRepo}}
+	invoice := &invoices.Invoice{ID: uuid.New(), InvoiceNumber: "INV-0000042", Status: enums.InvoiceStatusDRAFT}
+	dueDate := time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC)
+
+	err := NewInvoiceHandler(invoicesRepo, nil, uow).UpdateInvoice(context.Background(), invoice, &dueDate, enums.InvoiceStatusPENDINGPAYMENT)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, uow.transactions, "the changes and their activities share a transaction")
+	require.Len(t, invoicesRepo.updated, 1)
+	assert.Equal(t, dueDate, invoicesRepo.updated[0].DueDate)
+	assert.Equal(t, []enums.InvoiceStatus{enums.InvoiceStatusPENDINGPAYMENT}, invoicesRepo.transitions)
+	assert.Equal(t, dueDate, invoice.DueDate)
+	assert.Equal(t, enums.InvoiceStatusPENDINGPAYMENT, invoice.Status)
+	require.Len(t, activitiesRepo.created, 2)
+	assert.Equal(t, "Invoice INV-0000042 due date changed to 2026-11-30", activitiesRepo.created[0].Description)
+	assert.Equal(t, activityEnums.ActivityTypeStatusChanged, activitiesRepo.created[1].Type)
+}
+
+func TestUpdateInvoiceFailsWithTheTransition(t *testing.T) {
+	activitiesRepo := &fakeActivities{}
+	invoicesRepo := &fakeInvoices{activities: activitiesRepo, transitionErr: invoices.ErrInvalidStatusTransition}
+	uow := &fakeUnitOfWork{repos: &unitofwork.Repositories{Activities: activitiesRepo, Invoices: invoicesRepo}}
+	previousDueDate := time.Date(2026, 11, 15, 0, 0, 0, 0, time.UTC)
+	invoice := &invoices.Invoice{ID: uuid.New(), Status: enums.InvoiceStatusDRAFT, DueDate: previousDueDate}
+	dueDate := time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC)
+
+	err := NewInvoiceHandler(invoicesRepo, nil, uow).UpdateInvoice(context.Background(), invoice, &dueDate, enums.InvoiceStatusPAID)
+
+	assert.ErrorIs(t, err, invoices.ErrInvalidStatusTransition, "the due date change is rolled back")
+	assert.Equal(t, enums.InvoiceStatusDRAFT, invoice.Status)
+	assert.Equal(t, previousDueDate, invoice.DueDate)
+}
+
+type fakeCustomers struct {
+	customers.Repository
+
+	customer *customers.Customer
+}
+
+func (r *fakeCustomers) GetCustomerByID(_ context.Context, customerID uuid.UUID) (*customers.Customer, error) {
+	if r.customer == nil || r.customer.ID != customerID {
+		return nil, nil
+	}
+
+	return r.customer, nil
+}
+
+type fakeInvoiceItems struct {
+	invoicesitems.Repository
+
+	items []*invoicesitems.InvoiceItem
+}
+
+func (r *fakeInvoiceItems) CreateInvoiceItems(_ context.Context, items []*invoicesitems.InvoiceItem) error {
+	r.items = append(r.items, items...)
+
+	return nil
+}
+
+func (r *fakeInvoiceItems) GetInvoiceItemsByInvoiceID(_ context.Context, _ uuid.UUID) ([]invoicesitems.InvoiceItem, error) {
+	return lo.FromSlicePtr(r.items), nil
+}
+
+// fakeSequences numbers invoices with the given settings, keeping a counter per period.
+type fakeSequences struct {
+	sequences.Repository
+
+	settings sequences.NumberingSettings
+	values   map[string]int64
+}
+
+func newFakeSequences() *fakeSequences {
+	return &fakeSequences{
+		settings: sequences.NumberingSettings{Prefix: "INV", Template: "{PREFIX}-{SEQ:7}", ResetPolicy: sequenceEnums.ResetPolicyNever},
+		values:   map[string]int64{},
+	}
+}
+
+func (r *fakeSequences) NextNumber(_ context.Context, _ uuid.UUID, _ sequenceEnums.DocumentType, at time.Time) (string, error) {
+	period := sequences.Period(r.settings.ResetPolicy, at)
+	r.values[period]++
+
+	return sequences.FormatNumber(r.settings.Template, r.settings.Prefix, at, r.values[period]), nil
+}
+
+// newCreateInvoiceAPI returns an API creating invoices for the given customer, numbered by sequencesRepo.
+func newCreateInvoiceAPI(customer *customers.Customer, sequencesRepo *fakeSequences) *API {
+	invoicesRepo := &fakeInvoices{activities: &fakeActivities{}}
+	invoiceItemsRepo := &fakeInvoiceItems{}
+	uow := &fakeUnitOfWork{repos: &unitofwork.Repositories{
+		Activities:   invoicesRepo.activities,
+		Invoices:     invoicesRepo,
+		InvoiceItems: invoiceItemsRepo,
+		Sequences:    sequencesRepo,
+	}}
+	taxRatesHandler := NewTaxRatesHandler(nil)
+
+	return &API{
+		customersHandler: NewCustomersHandler(&fakeCustomers{customer: customer}),
+		invoicesHandler:  NewInvoiceHandler(invoicesRepo, invoiceItemsRepo, uow),
+		productsHandler:  NewProductsHandler(nil, taxRatesHandler),
+		taxRatesHandler:  taxRatesHandler,
+	}
+}
+
+// validated serves handler behind the request validator of the API specification.
+func validated(t *testing.T, handler http.HandlerFunc) http.Handler {
+	t.Helper()
+
+	swagger, err := server.GetSwagger()
+	require.NoError(t, err)
+
+	validation := openAPIUtils.NewValidationMiddleware(
+		openAPIUtils.WithDoc(swagger),
+		openAPIUtils.WithErrorRenderer(server.ErrorRenderer),
+		openAPIUtils.WithOpenAPIOptions(&openapi3filter.Options{
+			MultiError:         true,
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		}),
+	)
+
+	return validation.Handler()(handler)
+}
+
+func postInvoice(handler http.Handler, userID uuid.UUID, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/v1/invoices", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(auth.WithPrincipal(req.Context(), &auth.Principal{UserID: userID}))
+
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+
+	return res
+}
+
+func TestCreateInvoiceAcceptsAValidBody(t *testing.T) {
+	customer := &customers.Customer{ID: uuid.New(), UserID: uuid.New()}
+	handler := validated(t, newCreateInvoiceAPI(customer, newFakeSequences()).V1CreateInvoice)
+
+	res := postInvoice(handler, customer.UserID, `{"data": {
+		"customer_id": "`+customer.ID.String()+`",
+		"due_date": "2026-11-17",
+		"items": [{"description": "Hosting", "quantity": 2, "unit_price": "19.99"}]
+	}}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+
+	var response server.InvoiceResponse
+	require.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+
+	assert.Equal(t, "INV-0000001", lo.FromPtr(response.Data.InvoiceNumber))
+	assert.Equal(t, "2026-11-17", response.Data.DueDate.String())
+	assert.Equal(t, customer.ID.String(), response.Data.Customer)
+}
+
+func TestCreateInvoiceRejectsMissingFields(t *testing.T) {
+	customer := &customers.Customer{ID: uuid.New(), UserID: uuid.New()}
+	api := newCreateInvoiceAPI(customer, newFakeSequences())
+	handler := validated(t, api.V1CreateInvoice)
+
+	tests := []struct {
+		name             string
+		body             string
+		checkedByHandler bool
+	}{
+		{
+			name:             "customer",
+			body:             `{"data": {"due_date": "2026-11-17", "items": [{"quantity": 1, "unit_price": "10"}]}}`,
+			checkedByHandler: true,
+		},
+		{
+			name:             "due date",
+			body:             `{"data": {"customer_id": "` + customer.ID.String() + `", "items": [{"quantity": 1, "unit_price": "10"}]}}`,
+			checkedByHandler: true,
+		},
+		{
+			name: "items",
+			body: `{"data": {"customer_id": "` + customer.ID.String() + `", "due_date": "2026-11-17"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, http.StatusBadRequest, postInvoice(handler, customer.UserID, tt.body).Code)
+
+			if tt.checkedByHandler {
+				res := postInvoice(http.HandlerFunc(api.V1CreateInvoice), customer.UserID, tt.body)
+				assert.Equal(t, http.StatusBadRequest, res.Code, "without the request validator")
+			}
+		})
+	}
+}
+
+func TestCreateInvoiceConflictsAfterTheNumberingRestarts(t *testing.T) {
+	customer := &customers.Customer{ID: uuid.New(), UserID: uuid.New()}
+	sequencesRepo := newFakeSequences()
+	sequencesRepo.settings.Template = "{PREFIX}-{YYYY}-{SEQ:4}"
+	handler := validated(t, newCreateInvoiceAPI(customer, sequencesRepo).V1CreateInvoice)
+	body := `{"data": {
+		"customer_id": "` + customer.ID.String() + `",
+		"issue_date": "2026-10-18",
+		"due_date": "2026-11-17",
+		"items": [{"description": "Hosting", "quantity": 1, "unit_price": "10"}]
+	}}`
+
+	require.Equal(t, http.StatusCreated, postInvoice(handler, customer.UserID, body).Code)
+
+	sequencesRepo.settings.ResetPolicy = sequenceEnums.ResetPolicyYearly
+
+	res := postInvoice(handler, customer.UserID, body)
+	assert.Equal(t, http.StatusConflict, res.Code, "INV-2026-0001 was already issued before the yearly reset")
+	assert.Contains(t, res.Body.String(), "INV-2026-0001")
+}
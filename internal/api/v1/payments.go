@@ -0,0 +1,269 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/repositories/activities"
+	activityEnums "invoice-backend/internal/repositories/activities/enums"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/payments"
+	paymentEnums "invoice-backend/internal/repositories/payments/enums"
+	"invoice-backend/internal/repositories/unitofwork"
+	"invoice-backend/pkg/money"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrInvoiceNotPayable       = errors.New("invoice does not accept payments")
+	ErrPaymentExceedsAmountDue = errors.New("payment exceeds the amount due")
+	ErrRefundExceedsAmountPaid = errors.New("refund exceeds the amount paid")
+)
+
+type PaymentsHandler struct {
+	paymentsRepo payments.Repository
+	unitOfWork   unitofwork.UnitOfWork
+}
+
+func NewPaymentsHandler(paymentsRepo payments.Repository, unitOfWork unitofwork.UnitOfWork) *PaymentsHandler {
+	return &PaymentsHandler{
+		paymentsRepo: paymentsRepo,
+		unitOfWork:   unitOfWork,
+	}
+}
+
+// RecordPayment adds a payment or refund to an invoice, then recomputes its balance and status from the ledger and
+// records the matching activities. The invoice row stays locked for the whole transaction, so concurrent payments
+// cannot both fit in the same amount due. It returns the updated invoice.
+func (h *PaymentsHandler) RecordPayment(ctx context.Context, invoiceID uuid.UUID, payment *payments.Payment) (*invoices.Invoice, error) {
+	var result *invoices.Invoice
+
+	err := h.unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		invoice, err := repos.Invoices.LockInvoice(ctx, invoiceID)
+		if err != nil {
+			return err
+		}
+
+		if invoice == nil {
+			return invoices.ErrInvoiceNotFound
+		}
+
+		err = validatePayment(invoice, payment)
+		if err != nil {
+			return err
+		}
+
+		payment.InvoiceID = invoice.ID
+		payment.UserID = invoice.UserID
+		payment.Currency = invoice.Currency
+
+		_, err = repos.Payments.CreatePayment(ctx, payment)
+		if err != nil {
+			return err
+		}
+
+		amountPaid, err := repos.Payments.SumPayments(ctx, invoice.ID)
+		if err != nil {
+			return err
+		}
+
+		from := invoice.Status
+		invoice.AmountPaid = amountPaid
+		invoice.AmountDue = invoice.TotalAmount.Sub(amountPaid).Sub(invoice.AmountCredited)
+		invoice.Status = invoices.StatusAfterPayment(from, invoice.TotalAmount, amountPaid.Add(invoice.AmountCredited))
+
+		err = repos.Invoices.UpdateBalance(ctx, invoice.ID, invoice.Status, invoice.AmountPaid, invoice.AmountCredited, invoice.AmountDue)
+		if err != nil {
+			return err
+		}
+
+		err = repos.Activities.CreateActivity(ctx, newPaymentActivity(invoice, payment))
+		if err != nil {
+			return err
+		}
+
+		if invoice.Status != from {
+			err = repos.Activities.CreateActivity(ctx, activities.NewInvoiceActivity(
+				activityEnums.ActivityTypeStatusChanged,
+				invoice.UserID,
+				invoice.ID,
+				fmt.Sprintf("Invoice %s status changed from %s to %s", invoice.InvoiceNumber, from, invoice.Status),
+			))
+			if err != nil {
+				return err
+			}
+		}
+
+		result = invoice
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (a *API) V1GetInvoicePayments(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	invoice, err := a.invoicesHandler.invoicesRepo.GetInvoiceByID(r.Context(), invoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if invoice == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	list, err := a.paymentsHandler.paymentsRepo.ListPaymentsByInvoiceID(r.Context(), invoice.ID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.PaymentsResponse{
+		Data: lo.Map(list, func(payment *payments.Payment, _ int) server.Payment {
+			return serializePaymentToAPIResponse(payment)
+		}),
+	})
+}
+
+func (a *API) V1CreateInvoicePayment(w http.ResponseWriter, r *http.Request, invoiceID openapi_types.UUID) {
+	reqBody := new(server.V1CreateInvoicePaymentJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	paymentData := reqBody.Data
+
+	paymentType := paymentEnums.PaymentTypePayment
+	if paymentData.Type != nil {
+		paymentType, err = paymentEnums.ParsePaymentType(string(lo.FromPtr(paymentData.Type)))
+		if err != nil {
+			server.BadRequestError(err, w, r)
+
+			return
+		}
+	}
+
+	method, err := paymentEnums.ParsePaymentMethod(string(paymentData.Method))
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	amount, err := money.ParseAmount(paymentData.Amount)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	payment := &payments.Payment{
+		ID:        uuid.New(),
+		Type:      paymentType,
+		Amount:    amount,
+		Method:    method,
+		Reference: paymentData.Reference,
+		PaidAt:    lo.FromPtrOr(paymentData.PaidAt, time.Now()),
+	}
+
+	_, err = a.paymentsHandler.RecordPayment(r.Context(), invoiceID, payment)
+	if err != nil {
+		renderPaymentError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, server.PaymentResponse{Data: serializePaymentToAPIResponse(payment)})
+}
+
+func validatePayment(invoice *invoices.Invoice, payment *payments.Payment) error {
+	if !invoices.IsPayableStatus(invoice.Status) {
+		return fmt.Errorf("%w: invoice is %s", ErrInvoiceNotPayable, invoice.Status)
+	}
+
+	if !payment.Amount.IsPositive() {
+		return fmt.Errorf("%w: amount must be positive", money.ErrInvalidAmount)
+	}
+
+	if !payment.Amount.Equal(invoice.Currency.Round(payment.Amount)) {
+		return fmt.Errorf("%w: %s has more decimal places than %s allows", money.ErrInvalidAmount, payment.Amount, invoice.Currency)
+	}
+
+	if payment.Type == paymentEnums.PaymentTypeRefund && payment.Amount.GreaterThan(invoice.AmountPaid) {
+		return fmt.Errorf("%w: %s", ErrRefundExceedsAmountPaid, invoice.Currency.Format(invoice.AmountPaid))
+	}
+
+	if payment.Type == paymentEnums.PaymentTypePayment && payment.Amount.GreaterThan(invoice.AmountDue) {
+		return fmt.Errorf("%w: %s", ErrPaymentExceedsAmountDue, invoice.Currency.Format(invoice.AmountDue))
+	}
+
+	return nil
+}
+
+func newPaymentActivity(invoice *invoices.Invoice, payment *payments.Payment) *activities.Activity {
+	activityType := activityEnums.ActivityTypePaymentRecorded
+	description := "Payment of %s %s received for invoice %s"
+
+	if payment.Type == paymentEnums.PaymentTypeRefund {
+		activityType = activityEnums.ActivityTypePaymentRefunded
+		description = "Refund of %s %s issued for invoice %s"
+	}
+
+	return activities.NewInvoiceActivity(
+		activityType,
+		invoice.UserID,
+		invoice.ID,
+		fmt.Sprintf(description, invoice.Currency.Format(payment.Amount), invoice.Currency, invoice.InvoiceNumber),
+	)
+}
+
+func renderPaymentError(err error, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case errors.Is(err, invoices.ErrInvoiceNotFound):
+		server.NotFoundError(w, r)
+	case errors.Is(err, money.ErrInvalidAmount):
+		server.BadRequestError(err, w, r)
+	case errors.Is(err, ErrInvoiceNotPayable),
+		errors.Is(err, ErrPaymentExceedsAmountDue),
+		errors.Is(err, ErrRefundExceedsAmountPaid):
+		server.ConflictError(err, nil, w, r)
+	default:
+		server.ProcessingError(err, w, r)
+	}
+}
+
+func serializePaymentToAPIResponse(payment *payments.Payment) server.Payment {
+	return server.Payment{
+		Amount:    payment.Currency.Format(payment.Amount),
+		CreatedAt: payment.CreatedAt,
+		Currency:  payment.Currency.String(),
+		Id:        payment.ID,
+		InvoiceId: payment.InvoiceID,
+		Method:    server.PaymentMethodEnum(payment.Method),
+		PaidAt:    payment.PaidAt,
+		Reference: payment.Reference,
+		Type:      server.PaymentTypeEnum(payment.Type),
+	}
+}
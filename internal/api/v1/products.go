@@ -0,0 +1,405 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/repositories/products"
+	"invoice-backend/pkg/money"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrUnknownProduct           = errors.New("unknown product")
+	ErrInvalidProductSalesRange = errors.New("invalid product sales range")
+)
+
+type ProductsHandler struct {
+	productsRepo    products.Repository
+	taxRatesHandler *TaxRatesHandler
+}
+
+func NewProductsHandler(productsRepo products.Repository, taxRatesHandler *TaxRatesHandler) *ProductsHandler {
+	return &ProductsHandler{
+		productsRepo:    productsRepo,
+		taxRatesHandler: taxRatesHandler,
+	}
+}
+
+// ResolveProducts loads the given products of a user, keyed by ID. It fails with ErrUnknownProduct when any of
+// them does not exist or belongs to someone else.
+func (h *ProductsHandler) ResolveProducts(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) (map[uuid.UUID]*products.Product, error) {
+	ids = lo.Uniq(ids)
+	if len(ids) == 0 {
+		return map[uuid.UUID]*products.Product{}, nil
+	}
+
+	list, err := h.productsRepo.ListProducts(ctx, &products.ProductDBFilter{
+		ID:     lo.ToSlicePtr(ids),
+		UserID: []*uuid.UUID{&userID},
+	}, preparePagination(lo.ToPtr(len(ids)), nil))
+	if err != nil {
+		return nil, err
+	}
+
+	byID := lo.KeyBy(list, func(product *products.Product) uuid.UUID {
+		return product.ID
+	})
+
+	for _, id := range ids {
+		if _, ok := byID[id]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownProduct, id)
+		}
+	}
+
+	return byID, nil
+}
+
+// ValidateProduct checks the fields of a product and that its default tax rate belongs to its owner.
+func (h *ProductsHandler) ValidateProduct(ctx context.Context, product *products.Product) error {
+	err := products.Validate(product)
+	if err != nil {
+		return err
+	}
+
+	if product.TaxRateID == nil {
+		return nil
+	}
+
+	_, err = h.taxRatesHandler.ResolveTaxRates(ctx, product.UserID, []uuid.UUID{*product.TaxRateID})
+
+	return err
+}
+
+func (a *API) V1GetProducts(w http.ResponseWriter, r *http.Request, params server.V1GetProductsParams) {
+	var (
+		productFilter *products.ProductDBFilter
+		page          = getDefaultPage()
+		pageSize      = getDefaultPageSize()
+	)
+
+	if params.Data != nil {
+		if params.Data.Filters != nil {
+			productFilter = &products.ProductDBFilter{
+				UserID: lo.ToSlicePtr(lo.FromPtr(params.Data.Filters.UserId)),
+				Active: params.Data.Filters.Active,
+				Search: strings.TrimSpace(lo.FromPtr(params.Data.Filters.Search)),
+			}
+		}
+
+		if params.Data.Page != nil {
+			page = params.Data.Page
+		}
+
+		if params.Data.PageSize != nil {
+			pageSize = params.Data.PageSize
+		}
+	}
+
+	result, err := a.productsHandler.productsRepo.ListProducts(r.Context(), productFilter, preparePagination(pageSize, page))
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.ProductsResponse{
+		Data: lo.Map(result, func(product *products.Product, _ int) server.Product {
+			return serializeProductToAPIResponse(product)
+		}),
+	})
+}
+
+func (a *API) V1CreateProduct(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	reqBody := new(server.V1CreateProductJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	productData := reqBody.Data
+
+	unitPrice, err := money.ParseAmount(productData.UnitPrice)
+	if err != nil {
+		server.BadRequestError(fmt.Errorf("invalid unit price: %w", err), w, r)
+
+		return
+	}
+
+	newProduct := &products.Product{
+		ID:          uuid.New(),
+		UserID:      userID,
+		SKU:         productData.Sku,
+		Name:        productData.Name,
+		Description: lo.FromPtr(productData.Description),
+		UnitPrice:   unitPrice,
+		Unit:        lo.FromPtr(productData.Unit),
+		TaxRateID:   productData.TaxRateId,
+		Active:      lo.FromPtrOr(productData.Active, true),
+	}
+
+	err = a.productsHandler.ValidateProduct(r.Context(), newProduct)
+	if err != nil {
+		renderProductError(err, w, r)
+
+		return
+	}
+
+	result, err := a.productsHandler.productsRepo.CreateProduct(r.Context(), newProduct)
+	if err != nil {
+		renderProductError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, server.ProductResponse{Data: serializeProductToAPIResponse(result)})
+}
+
+func (a *API) V1GetProduct(w http.ResponseWriter, r *http.Request, productID openapi_types.UUID) {
+	product, ok := a.requireProduct(w, r, productID)
+	if !ok {
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.ProductResponse{Data: serializeProductToAPIResponse(product)})
+}
+
+func (a *API) V1UpdateProduct(w http.ResponseWriter, r *http.Request, productID openapi_types.UUID) {
+	reqBody := new(server.V1UpdateProductJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	product, ok := a.requireProduct(w, r, productID)
+	if !ok {
+		return
+	}
+
+	updateData := reqBody.Data
+
+	if updateData.Sku != nil {
+		product.SKU = lo.EmptyableToPtr(lo.FromPtr(updateData.Sku))
+	}
+
+	if updateData.Name != nil {
+		product.Name = lo.FromPtr(updateData.Name)
+	}
+
+	if updateData.Description != nil {
+		product.Description = lo.FromPtr(updateData.Description)
+	}
+
+	if updateData.UnitPrice != nil {
+		unitPrice, parseErr := money.ParseAmount(lo.FromPtr(updateData.UnitPrice))
+		if parseErr != nil {
+			server.BadRequestError(fmt.Errorf("invalid unit price: %w", parseErr), w, r)
+
+			return
+		}
+
+		product.UnitPrice = unitPrice
+	}
+
+	if updateData.Unit != nil {
+		product.Unit = lo.FromPtr(updateData.Unit)
+	}
+
+	if updateData.TaxRateId != nil {
+		taxRateID, parseErr := parseOptionalUUID(lo.FromPtr(updateData.TaxRateId))
+		if parseErr != nil {
+			server.BadRequestError(fmt.Errorf("invalid tax rate ID: %w", parseErr), w, r)
+
+			return
+		}
+
+		product.TaxRateID = taxRateID
+	}
+
+	if updateData.Active != nil {
+		product.Active = lo.FromPtr(updateData.Active)
+	}
+
+	err = a.productsHandler.ValidateProduct(r.Context(), product)
+	if err != nil {
+		renderProductError(err, w, r)
+
+		return
+	}
+
+	err = a.productsHandler.productsRepo.UpdateProduct(r.Context(), product)
+	if err != nil {
+		renderProductError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.ProductResponse{Data: serializeProductToAPIResponse(product)})
+}
+
+func (a *API) V1DeleteProduct(w http.ResponseWriter, r *http.Request, productID openapi_types.UUID) {
+	err := a.productsHandler.productsRepo.DeleteProduct(r.Context(), productID)
+	if err != nil {
+		renderProductError(err, w, r)
+
+		return
+	}
+
+	render.NoContent(w, r)
+}
+
+func (a *API) V1GetProductSales(
+	w http.ResponseWriter,
+	r *http.Request,
+	productID openapi_types.UUID,
+	params server.V1GetProductSalesParams,
+) {
+	var filters server.ProductSalesFilters
+	if params.Data != nil && params.Data.Filters != nil {
+		filters = *params.Data.Filters
+	}
+
+	from, to := dateToTime(filters.IssueDateFrom), dateToTime(filters.IssueDateTo)
+	if from != nil && to != nil && from.After(*to) {
+		server.BadRequestError(fmt.Errorf("%w: issue_date_from is after issue_date_to", ErrInvalidProductSalesRange), w, r)
+
+		return
+	}
+
+	_, ok := a.requireProduct(w, r, productID)
+	if !ok {
+		return
+	}
+
+	sales, err := a.productsHandler.productsRepo.SummarizeSales(r.Context(), productID, from, to)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.ProductSalesResponse{
+		Data: lo.Map(sales, func(sale *products.Sales, _ int) server.ProductSales {
+			return server.ProductSales{
+				Currency:     sale.Currency.String(),
+				InvoiceCount: sale.InvoiceCount,
+				NetAmount:    sale.Currency.Format(sale.NetAmount),
+				Quantity:     sale.Quantity,
+			}
+		}),
+	})
+}
+
+// requireProduct loads a product of the authenticated user, rendering a 404 when there is none.
+func (a *API) requireProduct(w http.ResponseWriter, r *http.Request, productID openapi_types.UUID) (*products.Product, bool) {
+	product, err := a.productsHandler.productsRepo.GetProductByID(r.Context(), productID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return nil, false
+	}
+
+	if product == nil {
+		server.NotFoundError(w, r)
+
+		return nil, false
+	}
+
+	return product, true
+}
+
+// prefillItem fills the description, unit price and tax rates an item leaves out from the product it references.
+func prefillItem(item server.Item, product *products.Product) server.Item {
+	if item.Description == nil {
+		item.Description = lo.ToPtr(lo.CoalesceOrEmpty(product.Description, product.Name))
+	}
+
+	if item.UnitPrice == nil {
+		item.UnitPrice = lo.ToPtr(product.UnitPrice.String())
+	}
+
+	if item.TaxRateIds == nil && product.TaxRateID != nil {
+		item.TaxRateIds = &[]uuid.UUID{*product.TaxRateID}
+	}
+
+	return item
+}
+
+// parseOptionalUUID parses an ID of a request, an empty string clearing it.
+func parseOptionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func renderProductError(err error, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case errors.Is(err, products.ErrProductNotFound):
+		server.NotFoundError(w, r)
+	case errors.Is(err, products.ErrInvalidName),
+		errors.Is(err, products.ErrInvalidSKU),
+		errors.Is(err, products.ErrInvalidUnit),
+		errors.Is(err, products.ErrInvalidUnitPrice),
+		errors.Is(err, ErrUnknownTaxRate):
+		server.BadRequestError(err, w, r)
+	case errors.Is(err, products.ErrDuplicateSKU):
+		server.ConflictError(err, nil, w, r)
+	default:
+		server.ProcessingError(err, w, r)
+	}
+}
+
+func serializeProductToAPIResponse(product *products.Product) server.Product {
+	return server.Product{
+		Active:      product.Active,
+		CreatedAt:   product.CreatedAt,
+		Description: product.Description,
+		Id:          product.ID,
+		Name:        product.Name,
+		Sku:         product.SKU,
+		TaxRateId:   product.TaxRateID,
+		Unit:        product.Unit,
+		UnitPrice:   formatUnitPrice(product.UnitPrice),
+	}
+}
+
+// formatUnitPrice renders a catalogue price, which has no currency yet, with at least two decimal places.
+func formatUnitPrice(price decimal.Decimal) string {
+	if price.Equal(price.Round(2)) {
+		return price.StringFixed(2)
+	}
+
+	return price.String()
+}
@@ -0,0 +1,582 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/invoices"
+	invoiceEnums "invoice-backend/internal/repositories/invoices/enums"
+	"invoice-backend/internal/repositories/invoicesitems"
+	"invoice-backend/internal/repositories/recurringinvoices"
+	"invoice-backend/internal/repositories/recurringinvoices/enums"
+	"invoice-backend/internal/repositories/unitofwork"
+	"invoice-backend/pkg/money"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultPaymentTermsDays = 30
+	defaultPreviewCount     = 5
+)
+
+var ErrInvalidRecurringStatus = errors.New("invalid recurring invoice status")
+
+type RecurringInvoicesHandler struct {
+	recurringRepo     recurringinvoices.Repository
+	invoicesRepo      invoices.Repository
+	unitOfWork        unitofwork.UnitOfWork
+	customersHandler  *CustomersHandler
+	taxRatesHandler   *TaxRatesHandler
+	deliveriesHandler *DeliveriesHandler
+	now               func() time.Time
+}
+
+func NewRecurringInvoicesHandler(
+	recurringRepo recurringinvoices.Repository,
+	invoicesRepo invoices.Repository,
+	unitOfWork unitofwork.UnitOfWork,
+	customersHandler *CustomersHandler,
+	taxRatesHandler *TaxRatesHandler,
+	deliveriesHandler *DeliveriesHandler,
+) *RecurringInvoicesHandler {
+	return &RecurringInvoicesHandler{
+		recurringRepo:     recurringRepo,
+		invoicesRepo:      invoicesRepo,
+		unitOfWork:        unitOfWork,
+		customersHandler:  customersHandler,
+		taxRatesHandler:   taxRatesHandler,
+		deliveriesHandler: deliveriesHandler,
+		now:               time.Now,
+	}
+}
+
+// GenerateNext generates the next invoice of the recurring invoice due the longest, on or before today, and
+// advances its schedule in the same transaction. It returns the recurring invoice it handled, nil when none is
+// due. A template whose customer or tax rates no longer exist is paused instead, the invoice is then nil.
+func (h *RecurringInvoicesHandler) GenerateNext(
+	ctx context.Context,
+	today time.Time,
+) (*invoices.Invoice, *recurringinvoices.RecurringInvoice, error) {
+	var (
+		generated *invoices.Invoice
+		recurring *recurringinvoices.RecurringInvoice
+	)
+
+	err := h.unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		due, err := repos.RecurringInvoices.LockDueRecurringInvoices(ctx, today, 1)
+		if err != nil || len(due) == 0 {
+			return err
+		}
+
+		recurring = due[0]
+		ownerCtx := ownerContext(ctx, recurring.UserID)
+
+		invoice, err := h.prepareInvoice(ownerCtx, recurring, *recurring.NextRunOn)
+		if errors.Is(err, ErrUnknownCustomer) || errors.Is(err, ErrUnknownTaxRate) {
+			recurring.Status = enums.RecurringStatusPaused
+
+			return repos.RecurringInvoices.UpdateRecurringInvoice(ownerCtx, recurring)
+		} else if err != nil {
+			return err
+		}
+
+		generated, err = createInvoice(ownerCtx, repos, invoice)
+		if err != nil {
+			return err
+		}
+
+		recurring.Advance(*recurring.NextRunOn)
+
+		return repos.RecurringInvoices.UpdateRecurringInvoice(ownerCtx, recurring)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return generated, recurring, nil
+}
+
+// SendGenerated emails a generated invoice to its customer and issues it.
+func (h *RecurringInvoicesHandler) SendGenerated(ctx context.Context, invoice *invoices.Invoice) error {
+	ownerCtx := ownerContext(ctx, invoice.UserID)
+
+	delivery, err := h.deliveriesHandler.SendInvoice(ownerCtx, invoice, SendOptions{})
+	if err != nil {
+		return err
+	}
+
+	return issueSentInvoice(ownerCtx, h.unitOfWork, invoice, delivery)
+}
+
+// prepareInvoice builds the draft invoice a recurring invoice generates on the given day, with the taxes of its
+// items resolved from the catalogue. It fails with ErrUnknownCustomer or ErrUnknownTaxRate when the template
+// references something that does not exist anymore.
+func (h *RecurringInvoicesHandler) prepareInvoice(
+	ctx context.Context,
+	recurring *recurringinvoices.RecurringInvoice,
+	issueDate time.Time,
+) (*invoices.DBInvoice, error) {
+	err := h.customersHandler.RequireCustomer(ctx, recurring.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	taxRateIDs := lo.FlatMap(recurring.Items, func(item recurringinvoices.Item, _ int) []uuid.UUID {
+		return item.TaxRateIDs
+	})
+
+	taxRatesByID, err := h.taxRatesHandler.ResolveTaxRates(ctx, recurring.UserID, taxRateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := &invoices.DBInvoice{
+		ID:             uuid.New(),
+		UserID:         recurring.UserID,
+		CustomerID:     recurring.CustomerID,
+		IssueDate:      issueDate,
+		DueDate:        issueDate.AddDate(0, 0, recurring.PaymentTermsDays),
+		Status:         invoiceEnums.InvoiceStatusDRAFT,
+		Currency:       recurring.Currency,
+		DiscountType:   recurring.DiscountType,
+		DiscountValue:  recurring.DiscountValue,
+		ShippingAmount: recurring.ShippingAmount,
+		RecurringID:    lo.ToPtr(recurring.ID),
+	}
+
+	invoice.Items = lo.Map(recurring.Items, func(item recurringinvoices.Item, position int) *invoicesitems.InvoiceItem {
+		return &invoicesitems.InvoiceItem{
+			ID:            uuid.New(),
+			Description:   item.Description,
+			InvoiceID:     invoice.ID,
+			Position:      position,
+			Quantity:      item.Quantity,
+			UnitPrice:     item.UnitPrice,
+			DiscountType:  item.DiscountType,
+			DiscountValue: item.DiscountValue,
+			Taxes:         itemTaxes(item.TaxRateIDs, taxRatesByID),
+		}
+	})
+
+	return invoice, nil
+}
+
+// validate checks the schedule and prices the invoice the template would generate, so that invalid templates are
+// reported when they are saved rather than when they run.
+func (h *RecurringInvoicesHandler) validate(ctx context.Context, recurring *recurringinvoices.RecurringInvoice) error {
+	err := recurring.Validate()
+	if err != nil {
+		return err
+	}
+
+	invoice, err := h.prepareInvoice(ctx, recurring, recurring.StartDate)
+	if err != nil {
+		return err
+	}
+
+	_, err = invoices.CalculateTotals(
+		invoice.Items,
+		invoices.Discount{Type: invoice.DiscountType, Value: invoice.DiscountValue},
+		invoice.ShippingAmount,
+		invoice.Currency,
+	)
+
+	return err
+}
+
+// schedule computes the next run from today, completing the recurring invoice when its schedule has ended.
+// Paused recurring invoices stay paused.
+func (h *RecurringInvoicesHandler) schedule(recurring *recurringinvoices.RecurringInvoice) {
+	recurring.NextRunOn = recurring.NextRunFrom(h.now())
+
+	switch {
+	case recurring.NextRunOn == nil:
+		recurring.Status = enums.RecurringStatusCompleted
+	case recurring.Status != enums.RecurringStatusPaused:
+		recurring.Status = enums.RecurringStatusActive
+	}
+}
+
+func (a *API) V1GetRecurringInvoices(w http.ResponseWriter, r *http.Request, params server.V1GetRecurringInvoicesParams) {
+	var (
+		recurringFilter *recurringinvoices.RecurringInvoiceDBFilter
+		page            = getDefaultPage()
+		pageSize        = getDefaultPageSize()
+	)
+
+	if params.Data != nil {
+		if filters := params.Data.Filters; filters != nil {
+			recurringFilter = &recurringinvoices.RecurringInvoiceDBFilter{
+				CustomerID: lo.ToSlicePtr(lo.FromPtr(filters.CustomerId)),
+				Status: lo.Map(lo.FromPtr(filters.Status), func(status server.RecurringStatusEnum, _ int) *enums.RecurringStatus {
+					return lo.ToPtr(enums.RecurringStatus(status))
+				}),
+			}
+		}
+
+		page = lo.CoalesceOrEmpty(params.Data.Page, page)
+		pageSize = lo.CoalesceOrEmpty(params.Data.PageSize, pageSize)
+	}
+
+	result, err := a.recurringInvoicesHandler.recurringRepo.ListRecurringInvoices(r.Context(), recurringFilter, preparePagination(pageSize, page))
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.RecurringInvoicesResponse{
+		Data: lo.Map(result, func(recurring *recurringinvoices.RecurringInvoice, _ int) server.RecurringInvoice {
+			return serializeRecurringInvoiceToAPIResponse(recurring)
+		}),
+	})
+}
+
+func (a *API) V1CreateRecurringInvoice(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	reqBody := new(server.V1CreateRecurringInvoiceJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	recurring := &recurringinvoices.RecurringInvoice{
+		ID:     uuid.New(),
+		UserID: userID,
+		Status: enums.RecurringStatusActive,
+	}
+
+	err = parseRecurringInvoice(reqBody.Data, recurring)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	err = a.recurringInvoicesHandler.validate(r.Context(), recurring)
+	if err != nil {
+		renderRecurringInvoiceError(err, w, r)
+
+		return
+	}
+
+	a.recurringInvoicesHandler.schedule(recurring)
+
+	result, err := a.recurringInvoicesHandler.recurringRepo.CreateRecurringInvoice(r.Context(), recurring)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, server.RecurringInvoiceResponse{Data: serializeRecurringInvoiceToAPIResponse(result)})
+}
+
+func (a *API) V1GetRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceID openapi_types.UUID) {
+	recurring, ok := a.requireRecurringInvoice(w, r, recurringInvoiceID)
+	if !ok {
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.RecurringInvoiceResponse{Data: serializeRecurringInvoiceToAPIResponse(recurring)})
+}
+
+// V1UpdateRecurringInvoice replaces the template and the schedule. The invoices generated so far keep counting
+// towards the schedule's count.
+func (a *API) V1UpdateRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceID openapi_types.UUID) {
+	reqBody := new(server.V1UpdateRecurringInvoiceJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	recurring, ok := a.requireRecurringInvoice(w, r, recurringInvoiceID)
+	if !ok {
+		return
+	}
+
+	err = parseRecurringInvoice(reqBody.Data, recurring)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	err = a.recurringInvoicesHandler.validate(r.Context(), recurring)
+	if err != nil {
+		renderRecurringInvoiceError(err, w, r)
+
+		return
+	}
+
+	a.recurringInvoicesHandler.schedule(recurring)
+
+	a.saveRecurringInvoice(w, r, recurring)
+}
+
+func (a *API) V1DeleteRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceID openapi_types.UUID) {
+	err := a.recurringInvoicesHandler.recurringRepo.DeleteRecurringInvoice(r.Context(), recurringInvoiceID)
+	if err != nil {
+		renderRecurringInvoiceError(err, w, r)
+
+		return
+	}
+
+	render.NoContent(w, r)
+}
+
+func (a *API) V1PauseRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceID openapi_types.UUID) {
+	recurring, ok := a.requireRecurringInvoice(w, r, recurringInvoiceID)
+	if !ok {
+		return
+	}
+
+	if recurring.Status != enums.RecurringStatusActive {
+		server.ConflictError(fmt.Errorf("%w: recurring invoice is %s", ErrInvalidRecurringStatus, recurring.Status), nil, w, r)
+
+		return
+	}
+
+	recurring.Status = enums.RecurringStatusPaused
+
+	a.saveRecurringInvoice(w, r, recurring)
+}
+
+// V1ResumeRecurringInvoice reactivates a paused recurring invoice from today on, the runs missed in the meantime
+// are not generated.
+func (a *API) V1ResumeRecurringInvoice(w http.ResponseWriter, r *http.Request, recurringInvoiceID openapi_types.UUID) {
+	recurring, ok := a.requireRecurringInvoice(w, r, recurringInvoiceID)
+	if !ok {
+		return
+	}
+
+	if recurring.Status != enums.RecurringStatusPaused {
+		server.ConflictError(fmt.Errorf("%w: recurring invoice is %s", ErrInvalidRecurringStatus, recurring.Status), nil, w, r)
+
+		return
+	}
+
+	recurring.Status = enums.RecurringStatusActive
+	a.recurringInvoicesHandler.schedule(recurring)
+
+	a.saveRecurringInvoice(w, r, recurring)
+}
+
+func (a *API) V1PreviewRecurringInvoice(
+	w http.ResponseWriter,
+	r *http.Request,
+	recurringInvoiceID openapi_types.UUID,
+	params server.V1PreviewRecurringInvoiceParams,
+) {
+	recurring, ok := a.requireRecurringInvoice(w, r, recurringInvoiceID)
+	if !ok {
+		return
+	}
+
+	// A paused recurring invoice previews the runs it would have once resumed today.
+	if recurring.Status == enums.RecurringStatusPaused {
+		recurring.NextRunOn = recurring.NextRunFrom(a.recurringInvoicesHandler.now())
+	}
+
+	runs := recurring.Upcoming(lo.FromPtrOr(params.Count, defaultPreviewCount))
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.RecurringInvoicePreviewResponse{
+		Data: lo.Map(runs, func(run time.Time, _ int) server.RecurringRun {
+			return server.RecurringRun{
+				IssueDate: openapi_types.Date{Time: run},
+				DueDate:   openapi_types.Date{Time: run.AddDate(0, 0, recurring.PaymentTermsDays)},
+			}
+		}),
+	})
+}
+
+// requireRecurringInvoice loads a recurring invoice, rendering the error response when it cannot be found.
+func (a *API) requireRecurringInvoice(
+	w http.ResponseWriter,
+	r *http.Request,
+	recurringInvoiceID openapi_types.UUID,
+) (*recurringinvoices.RecurringInvoice, bool) {
+	recurring, err := a.recurringInvoicesHandler.recurringRepo.GetRecurringInvoiceByID(r.Context(), recurringInvoiceID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return nil, false
+	}
+
+	if recurring == nil {
+		server.NotFoundError(w, r)
+
+		return nil, false
+	}
+
+	return recurring, true
+}
+
+func (a *API) saveRecurringInvoice(w http.ResponseWriter, r *http.Request, recurring *recurringinvoices.RecurringInvoice) {
+	err := a.recurringInvoicesHandler.recurringRepo.UpdateRecurringInvoice(r.Context(), recurring)
+	if err != nil {
+		renderRecurringInvoiceError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.RecurringInvoiceResponse{Data: serializeRecurringInvoiceToAPIResponse(recurring)})
+}
+
+// parseRecurringInvoice copies the template and the schedule of a request onto a recurring invoice.
+func parseRecurringInvoice(data server.RecurringInvoiceRequestBodyData, recurring *recurringinvoices.RecurringInvoice) error {
+	var err error
+
+	recurring.Currency = money.DefaultCurrency
+	if data.Currency != nil {
+		recurring.Currency, err = money.ParseCurrency(lo.FromPtr(data.Currency))
+		if err != nil {
+			return err
+		}
+	}
+
+	recurring.DiscountType, recurring.DiscountValue, err = parseDiscount(data.Discount)
+	if err != nil {
+		return err
+	}
+
+	recurring.ShippingAmount = decimal.Zero
+	if data.ShippingAmount != nil {
+		recurring.ShippingAmount, err = money.ParseAmount(lo.FromPtr(data.ShippingAmount))
+		if err != nil {
+			return fmt.Errorf("invalid shipping amount: %w", err)
+		}
+	}
+
+	recurring.Items = make(recurringinvoices.Items, 0, len(data.Items))
+
+	for _, item := range data.Items {
+		unitPrice, parseErr := money.ParseAmount(item.UnitPrice)
+		if parseErr != nil {
+			return fmt.Errorf("invalid unit price: %w", parseErr)
+		}
+
+		discountType, discountValue, parseErr := parseDiscount(item.Discount)
+		if parseErr != nil {
+			return parseErr
+		}
+
+		recurring.Items = append(recurring.Items, recurringinvoices.Item{
+			Description:   item.Description,
+			Quantity:      item.Quantity,
+			UnitPrice:     unitPrice,
+			DiscountType:  discountType,
+			DiscountValue: discountValue,
+			TaxRateIDs:    lo.Uniq(lo.FromPtr(item.TaxRateIds)),
+		})
+	}
+
+	frequency, err := enums.ParseFrequency(string(data.Schedule.Frequency))
+	if err != nil {
+		return fmt.Errorf("invalid frequency: %w", err)
+	}
+
+	recurring.CustomerID = data.CustomerId
+	recurring.Frequency = frequency
+	recurring.Interval = lo.FromPtrOr(data.Schedule.Interval, 1)
+	recurring.DayOfMonth = data.Schedule.DayOfMonth
+	recurring.StartDate = data.Schedule.StartDate.Time
+	recurring.EndDate = dateToTime(data.Schedule.EndDate)
+	recurring.MaxCount = data.Schedule.Count
+	recurring.PaymentTermsDays = lo.FromPtrOr(data.PaymentTermsDays, defaultPaymentTermsDays)
+	recurring.AutoSend = lo.FromPtr(data.AutoSend)
+
+	return nil
+}
+
+func serializeRecurringInvoiceToAPIResponse(recurring *recurringinvoices.RecurringInvoice) server.RecurringInvoice {
+	items := lo.Map(recurring.Items, func(item recurringinvoices.Item, _ int) server.RecurringItem {
+		return server.RecurringItem{
+			Description: item.Description,
+			Discount:    serializeDiscount(item.DiscountType, item.DiscountValue),
+			Quantity:    item.Quantity,
+			TaxRateIds:  lo.ToPtr(append([]uuid.UUID{}, item.TaxRateIDs...)),
+			UnitPrice:   recurring.Currency.Format(item.UnitPrice),
+		}
+	})
+
+	var nextRunOn *openapi_types.Date
+	if recurring.NextRunOn != nil {
+		nextRunOn = &openapi_types.Date{Time: *recurring.NextRunOn}
+	}
+
+	var endDate *openapi_types.Date
+	if recurring.EndDate != nil {
+		endDate = &openapi_types.Date{Time: *recurring.EndDate}
+	}
+
+	return server.RecurringInvoice{
+		Id:         recurring.ID,
+		CustomerId: recurring.CustomerID,
+		Items:      items,
+		Currency:   recurring.Currency.String(),
+		Discount:   serializeDiscount(recurring.DiscountType, recurring.DiscountValue),
+		Schedule: server.RecurringSchedule{
+			Frequency:  server.RecurringFrequencyEnum(recurring.Frequency),
+			Interval:   lo.ToPtr(recurring.Interval),
+			DayOfMonth: recurring.DayOfMonth,
+			StartDate:  openapi_types.Date{Time: recurring.StartDate},
+			EndDate:    endDate,
+			Count:      recurring.MaxCount,
+		},
+		ShippingAmount:   recurring.Currency.Format(recurring.ShippingAmount),
+		PaymentTermsDays: recurring.PaymentTermsDays,
+		AutoSend:         recurring.AutoSend,
+		Status:           server.RecurringStatusEnum(recurring.Status),
+		GeneratedCount:   recurring.GeneratedCount,
+		NextRunOn:        nextRunOn,
+		CreatedAt:        recurring.CreatedAt,
+		UpdatedAt:        recurring.UpdatedAt,
+	}
+}
+
+func renderRecurringInvoiceError(err error, w http.ResponseWriter, r *http.Request) {
+	switch {
+	case errors.Is(err, recurringinvoices.ErrRecurringInvoiceNotFound):
+		server.NotFoundError(w, r)
+	case errors.Is(err, recurringinvoices.ErrInvalidSchedule),
+		errors.Is(err, ErrUnknownCustomer),
+		errors.Is(err, ErrUnknownTaxRate),
+		errors.Is(err, invoices.ErrInvalidDiscount),
+		errors.Is(err, invoices.ErrInvalidTaxRate):
+		server.BadRequestError(err, w, r)
+	default:
+		server.ProcessingError(err, w, r)
+	}
+}
+
+// ownerContext acts on behalf of the owner of a resource, so that the background jobs only see and reference the
+// data of that user.
+func ownerContext(ctx context.Context, userID uuid.UUID) context.Context {
+	return auth.WithPrincipal(ctx, &auth.Principal{UserID: userID})
+}
@@ -0,0 +1,307 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/documents"
+	"invoice-backend/internal/repositories/branding"
+	"invoice-backend/internal/repositories/reminders"
+	"invoice-backend/internal/repositories/sequences"
+	"invoice-backend/internal/repositories/sequences/enums"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type SettingsHandler struct {
+	brandingRepo  branding.Repository
+	remindersRepo reminders.Repository
+	sequencesRepo sequences.Repository
+}
+
+func NewSettingsHandler(
+	brandingRepo branding.Repository,
+	remindersRepo reminders.Repository,
+	sequencesRepo sequences.Repository,
+) *SettingsHandler {
+	return &SettingsHandler{
+		brandingRepo:  brandingRepo,
+		remindersRepo: remindersRepo,
+		sequencesRepo: sequencesRepo,
+	}
+}
+
+// NextNumberPreview renders the number the next document of the given type would receive today.
+func (h *SettingsHandler) NextNumberPreview(ctx context.Context, settings *sequences.NumberingSettings) (string, error) {
+	now := time.Now()
+
+	currentValue, err := h.sequencesRepo.CurrentValue(
+		ctx,
+		settings.UserID,
+		settings.DocumentType,
+		sequences.Period(settings.ResetPolicy, now),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return sequences.FormatNumber(settings.Template, settings.Prefix, now, currentValue+1), nil
+}
+
+func (a *API) V1GetInvoiceNumberingSettings(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	a.renderNumberingSettings(w, r, userID)
+}
+
+func (a *API) V1UpdateInvoiceNumberingSettings(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	reqBody := new(server.V1UpdateInvoiceNumberingSettingsJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	settingsData := reqBody.Data
+
+	resetPolicy, err := enums.ParseResetPolicy(string(settingsData.ResetPolicy))
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	err = sequences.ValidatePrefix(settingsData.Prefix)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	err = sequences.ValidateTemplate(settingsData.Template, resetPolicy)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	_, err = a.settingsHandler.sequencesRepo.SaveSettings(r.Context(), &sequences.NumberingSettings{
+		UserID:       userID,
+		DocumentType: enums.DocumentTypeInvoice,
+		Prefix:       settingsData.Prefix,
+		Template:     settingsData.Template,
+		ResetPolicy:  resetPolicy,
+	})
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	a.renderNumberingSettings(w, r, userID)
+}
+
+func (a *API) renderNumberingSettings(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	settings, err := a.settingsHandler.sequencesRepo.GetSettings(r.Context(), userID, enums.DocumentTypeInvoice)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	nextNumber, err := a.settingsHandler.NextNumberPreview(r.Context(), settings)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.NumberingSettingsResponse{
+		Data: server.NumberingSettingsResponseData{
+			NextNumber:  nextNumber,
+			Prefix:      settings.Prefix,
+			ResetPolicy: server.ResetPolicyEnum(settings.ResetPolicy),
+			Template:    settings.Template,
+		},
+	})
+}
+
+func (a *API) V1GetBrandingSettings(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	a.renderBrandingSettings(w, r, userID)
+}
+
+func (a *API) V1UpdateBrandingSettings(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	reqBody := new(server.V1UpdateBrandingSettingsJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	settingsData := reqBody.Data
+	settings := &branding.BrandingSettings{
+		UserID:       userID,
+		CompanyName:  settingsData.CompanyName,
+		Address:      settingsData.Address,
+		Email:        settingsData.Email,
+		Phone:        settingsData.Phone,
+		Logo:         lo.FromPtr(settingsData.Logo),
+		PrimaryColor: settingsData.PrimaryColor,
+		AccentColor:  settingsData.AccentColor,
+		FooterText:   settingsData.FooterText,
+	}
+
+	if len(settings.Logo) == 0 {
+		settings.Logo = nil
+	} else if _, err = documents.LogoImageType(settings.Logo); err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	// Store colours normalised so that they compare and render consistently.
+	template, err := settings.Template()
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	settings.PrimaryColor = template.PrimaryColor.Hex()
+	settings.AccentColor = template.AccentColor.Hex()
+
+	_, err = a.settingsHandler.brandingRepo.SaveSettings(r.Context(), settings)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	a.renderBrandingSettings(w, r, userID)
+}
+
+func (a *API) renderBrandingSettings(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	settings, err := a.settingsHandler.brandingRepo.GetSettings(r.Context(), userID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.BrandingSettingsResponse{
+		Data: server.BrandingSettings{
+			AccentColor:  settings.AccentColor,
+			Address:      settings.Address,
+			CompanyName:  settings.CompanyName,
+			Email:        settings.Email,
+			FooterText:   settings.FooterText,
+			Logo:         lo.Ternary(len(settings.Logo) > 0, &settings.Logo, nil),
+			Phone:        settings.Phone,
+			PrimaryColor: settings.PrimaryColor,
+		},
+	})
+}
+
+func (a *API) V1GetReminderSettings(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	rules, err := a.settingsHandler.remindersRepo.GetRules(r.Context(), userID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	renderReminderSettings(w, r, rules)
+}
+
+func (a *API) V1UpdateReminderSettings(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	reqBody := new(server.V1UpdateReminderSettingsJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	rules := lo.Map(reqBody.Data.Rules, func(rule server.ReminderRule, _ int) *reminders.Rule {
+		return &reminders.Rule{
+			OffsetDays: rule.OffsetDays,
+			RepeatDays: rule.RepeatEveryDays,
+		}
+	})
+
+	err = reminders.Validate(rules)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	_, err = a.settingsHandler.remindersRepo.ReplaceRules(r.Context(), userID, rules)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	rules, err = a.settingsHandler.remindersRepo.GetRules(r.Context(), userID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	renderReminderSettings(w, r, rules)
+}
+
+func renderReminderSettings(w http.ResponseWriter, r *http.Request, rules []*reminders.Rule) {
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.ReminderSettingsResponse{
+		Data: server.ReminderSettings{
+			Rules: lo.Map(rules, func(rule *reminders.Rule, _ int) server.ReminderRule {
+				return server.ReminderRule{
+					OffsetDays:      rule.OffsetDays,
+					RepeatEveryDays: rule.RepeatDays,
+				}
+			}),
+		},
+	})
+}
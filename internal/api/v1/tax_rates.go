@@ -0,0 +1,269 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"invoice-backend/internal/api/server"
+	"invoice-backend/internal/repositories/taxrates"
+	"invoice-backend/pkg/money"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+var ErrUnknownTaxRate = errors.New("unknown tax rate")
+
+type TaxRatesHandler struct {
+	taxRatesRepo taxrates.Repository
+}
+
+func NewTaxRatesHandler(taxRatesRepo taxrates.Repository) *TaxRatesHandler {
+	return &TaxRatesHandler{
+		taxRatesRepo: taxRatesRepo,
+	}
+}
+
+// ResolveTaxRates loads the given tax rates of a user, keyed by ID. It fails with ErrUnknownTaxRate when any of
+// them does not exist or belongs to someone else.
+func (h *TaxRatesHandler) ResolveTaxRates(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) (map[uuid.UUID]*taxrates.TaxRate, error) {
+	ids = lo.Uniq(ids)
+	if len(ids) == 0 {
+		return map[uuid.UUID]*taxrates.TaxRate{}, nil
+	}
+
+	list, err := h.taxRatesRepo.ListTaxRates(ctx, &taxrates.TaxRateDBFilter{
+		ID:     lo.ToSlicePtr(ids),
+		UserID: []*uuid.UUID{&userID},
+	}, preparePagination(lo.ToPtr(len(ids)), nil))
+	if err != nil {
+		return nil, err
+	}
+
+	byID := lo.KeyBy(list, func(taxRate *taxrates.TaxRate) uuid.UUID {
+		return taxRate.ID
+	})
+
+	for _, id := range ids {
+		if _, ok := byID[id]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownTaxRate, id)
+		}
+	}
+
+	return byID, nil
+}
+
+func (a *API) V1GetTaxRates(w http.ResponseWriter, r *http.Request, params server.V1GetTaxRatesParams) {
+	var (
+		taxRateFilter *taxrates.TaxRateDBFilter
+		page          = getDefaultPage()
+		pageSize      = getDefaultPageSize()
+	)
+
+	if params.Data != nil {
+		if params.Data.Filters != nil {
+			taxRateFilter = &taxrates.TaxRateDBFilter{
+				UserID: lo.ToSlicePtr(lo.FromPtr(params.Data.Filters.UserId)),
+			}
+		}
+
+		if params.Data.Page != nil {
+			page = params.Data.Page
+		}
+
+		if params.Data.PageSize != nil {
+			pageSize = params.Data.PageSize
+		}
+	}
+
+	result, err := a.taxRatesHandler.taxRatesRepo.ListTaxRates(r.Context(), taxRateFilter, preparePagination(pageSize, page))
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.TaxRatesResponse{
+		Data: lo.Map(result, func(taxRate *taxrates.TaxRate, _ int) server.TaxRate {
+			return serializeTaxRateToAPIResponse(taxRate)
+		}),
+	})
+}
+
+func (a *API) V1CreateTaxRate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(w, r)
+	if !ok {
+		return
+	}
+
+	reqBody := new(server.V1CreateTaxRateJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	taxRateData := reqBody.Data
+
+	rate, err := money.ParseAmount(taxRateData.Rate)
+	if err != nil {
+		server.BadRequestError(fmt.Errorf("invalid rate: %w", err), w, r)
+
+		return
+	}
+
+	newTaxRate := &taxrates.TaxRate{
+		ID:       uuid.New(),
+		UserID:   userID,
+		Name:     taxRateData.Name,
+		Rate:     rate,
+		Compound: lo.FromPtr(taxRateData.Compound),
+	}
+
+	err = taxrates.Validate(newTaxRate)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	result, err := a.taxRatesHandler.taxRatesRepo.CreateTaxRate(r.Context(), newTaxRate)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, server.TaxRateResponse{Data: serializeTaxRateToAPIResponse(result)})
+}
+
+func (a *API) V1GetTaxRate(w http.ResponseWriter, r *http.Request, taxRateID openapi_types.UUID) {
+	taxRate, err := a.taxRatesHandler.taxRatesRepo.GetTaxRateByID(r.Context(), taxRateID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if taxRate == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.TaxRateResponse{Data: serializeTaxRateToAPIResponse(taxRate)})
+}
+
+func (a *API) V1UpdateTaxRate(w http.ResponseWriter, r *http.Request, taxRateID openapi_types.UUID) {
+	reqBody := new(server.V1UpdateTaxRateJSONRequestBody)
+
+	err := render.DecodeJSON(r.Body, reqBody)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	taxRate, err := a.taxRatesHandler.taxRatesRepo.GetTaxRateByID(r.Context(), taxRateID)
+	if err != nil {
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	if taxRate == nil {
+		server.NotFoundError(w, r)
+
+		return
+	}
+
+	updateData := reqBody.Data
+
+	if updateData.Name != nil {
+		taxRate.Name = lo.FromPtr(updateData.Name)
+	}
+
+	if updateData.Rate != nil {
+		rate, parseErr := money.ParseAmount(lo.FromPtr(updateData.Rate))
+		if parseErr != nil {
+			server.BadRequestError(fmt.Errorf("invalid rate: %w", parseErr), w, r)
+
+			return
+		}
+
+		taxRate.Rate = rate
+	}
+
+	if updateData.Compound != nil {
+		taxRate.Compound = lo.FromPtr(updateData.Compound)
+	}
+
+	err = taxrates.Validate(taxRate)
+	if err != nil {
+		server.BadRequestError(err, w, r)
+
+		return
+	}
+
+	err = a.taxRatesHandler.taxRatesRepo.UpdateTaxRate(r.Context(), taxRate)
+	if err != nil {
+		if errors.Is(err, taxrates.ErrTaxRateNotFound) {
+			server.NotFoundError(w, r)
+
+			return
+		}
+
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, server.TaxRateResponse{Data: serializeTaxRateToAPIResponse(taxRate)})
+}
+
+func (a *API) V1DeleteTaxRate(w http.ResponseWriter, r *http.Request, taxRateID openapi_types.UUID) {
+	err := a.taxRatesHandler.taxRatesRepo.DeleteTaxRate(r.Context(), taxRateID)
+	if err != nil {
+		if errors.Is(err, taxrates.ErrTaxRateNotFound) {
+			server.NotFoundError(w, r)
+
+			return
+		}
+
+		server.ProcessingError(err, w, r)
+
+		return
+	}
+
+	render.NoContent(w, r)
+}
+
+func serializeTaxRateToAPIResponse(taxRate *taxrates.TaxRate) server.TaxRate {
+	return server.TaxRate{
+		Compound:  taxRate.Compound,
+		CreatedAt: taxRate.CreatedAt,
+		Id:        taxRate.ID,
+		Name:      taxRate.Name,
+		Rate:      formatRate(taxRate.Rate),
+	}
+}
+
+// formatRate renders a percentage with at least two decimal places, e.g. 20.00 or 9.975.
+func formatRate(rate decimal.Decimal) string {
+	if rate.Equal(rate.Round(2)) {
+		return rate.StringFixed(2)
+	}
+
+	return rate.String()
+}
@@ -22,6 +22,32 @@ type Config struct {
 	DatabasePassword        string `env:"DATABASE_PASSWORD" env-required:"true"`
 	DatabasePort            string `env:"DATABASE_PORT" env-default:"5432"`
 	DatabaseUsername        string `env:"DATABASE_USERNAME" env-required:"true"`
+
+	// Auth, access tokens are signed with JWT_SECRET (HS256)
+	JWTSecret       string `env:"JWT_SECRET" env-required:"true"`
+	JWTIssuer       string `env:"JWT_ISSUER" env-default:"invoice-backend"`
+	AccessTokenTTL  int64  `env:"ACCESS_TOKEN_TTL" env-default:"900"`      // seconds
+	RefreshTokenTTL int64  `env:"REFRESH_TOKEN_TTL" env-default:"2592000"` // seconds
+
+	// Mail, MAIL_TRANSPORT is one of smtp, file or memory. The SMTP defaults match a local MailHog.
+	MailTransport   string `env:"MAIL_TRANSPORT" env-default:"smtp"`
+	MailFromAddress string `env:"MAIL_FROM_ADDRESS" env-default:"invoices@localhost"`
+	MailFromName    string `env:"MAIL_FROM_NAME" env-default:"Invoices"`
+	MailFileDir     string `env:"MAIL_FILE_DIR" env-default:"tmp/mail"`
+	SMTPHost        string `env:"SMTP_HOST" env-default:"localhost"`
+	SMTPPort        string `env:"SMTP_PORT" env-default:"1025"`
+	SMTPUsername    string `env:"SMTP_USERNAME"`
+	SMTPPassword    string `env:"SMTP_PASSWORD"`
+	SMTPTimeout     int64  `env:"SMTP_TIMEOUT" env-default:"30"`
+
+	// Worker
+	OverdueJobInterval        int64 `env:"OVERDUE_JOB_INTERVAL" env-default:"3600"` // seconds
+	OverdueJobBatchSize       int   `env:"OVERDUE_JOB_BATCH_SIZE" env-default:"100"`
+	ReminderJobInterval       int64 `env:"REMINDER_JOB_INTERVAL" env-default:"3600"` // seconds
+	ReminderJobBatchSize      int   `env:"REMINDER_JOB_BATCH_SIZE" env-default:"100"`
+	RecurringJobInterval      int64 `env:"RECURRING_JOB_INTERVAL" env-default:"3600"` // seconds
+	RecurringJobBatchSize     int   `env:"RECURRING_JOB_BATCH_SIZE" env-default:"100"`
+	EstimateExpiryJobInterval int64 `env:"ESTIMATE_EXPIRY_JOB_INTERVAL" env-default:"3600"` // seconds
 }
 
 func LoadConfig() (*Config, error) {
@@ -38,3 +64,31 @@ func LoadConfig() (*Config, error) {
 func (c *Config) HTTPServerTimeout() time.Duration {
 	return time.Duration(c.ServerTimeout) * time.Second
 }
+
+func (c *Config) AccessTokenLifetime() time.Duration {
+	return time.Duration(c.AccessTokenTTL) * time.Second
+}
+
+func (c *Config) RefreshTokenLifetime() time.Duration {
+	return time.Duration(c.RefreshTokenTTL) * time.Second
+}
+
+func (c *Config) SMTPServerTimeout() time.Duration {
+	return time.Duration(c.SMTPTimeout) * time.Second
+}
+
+func (c *Config) OverdueJobPeriod() time.Duration {
+	return time.Duration(c.OverdueJobInterval) * time.Second
+}
+
+func (c *Config) ReminderJobPeriod() time.Duration {
+	return time.Duration(c.ReminderJobInterval) * time.Second
+}
+
+func (c *Config) RecurringJobPeriod() time.Duration {
+	return time.Duration(c.RecurringJobInterval) * time.Second
+}
+
+func (c *Config) EstimateExpiryJobPeriod() time.Duration {
+	return time.Duration(c.EstimateExpiryJobInterval) * time.Second
+}
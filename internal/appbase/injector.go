@@ -3,17 +3,36 @@ package appbase
 import (
 	"gorm.io/gorm"
 	"invoice-backend/internal/api"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/jobs"
+	"invoice-backend/internal/repositories/branding"
+	"invoice-backend/internal/repositories/creditnotes"
+	"invoice-backend/internal/repositories/deliveries"
+	"invoice-backend/internal/repositories/estimates"
 	"invoice-backend/internal/repositories/invoices"
 	"invoice-backend/internal/repositories/invoicesitems"
+	"invoice-backend/internal/repositories/payments"
+	"invoice-backend/internal/repositories/products"
+	"invoice-backend/internal/repositories/recurringinvoices"
+	"invoice-backend/internal/repositories/refreshtokens"
+	"invoice-backend/internal/repositories/reminders"
+	"invoice-backend/internal/repositories/sequences"
+	"invoice-backend/internal/repositories/taxrates"
+	"invoice-backend/internal/repositories/unitofwork"
+	"invoice-backend/internal/repositories/users"
 	"invoice-backend/pkg/postgres"
+	"net/mail"
 	"os"
 
 	"invoice-backend/internal/api/server"
 	v1 "invoice-backend/internal/api/v1"
 	"invoice-backend/internal/repositories/activities"
+	"invoice-backend/internal/repositories/apikeys"
 	"invoice-backend/internal/repositories/customers"
+	"invoice-backend/pkg/mailer"
 	openAPIUtils "invoice-backend/pkg/openapi"
 
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog"
 	"github.com/samber/do"
@@ -49,21 +68,50 @@ func NewInjector(serviceName string, cfg *Config) *do.Injector {
 	})
 
 	do.ProvideNamed(injector, InjectorOpenAPIValidationMiddleware, func(i *do.Injector) (*openAPIUtils.ValidationMiddleware, error) {
+		// Credentials are verified by the authentication middleware, the validator only checks the request shape.
+		options := &openapi3filter.Options{
+			MultiError:         true,
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		}
+
 		switch cfg.Env {
 		case "test":
 			return openAPIUtils.NewValidationMiddleware(
 				openAPIUtils.WithDoc(lo.Must(server.GetSwagger())),
 				openAPIUtils.WithErrorRenderer(server.ErrorRenderer),
+				openAPIUtils.WithOpenAPIOptions(options),
 			), nil
 		default:
 			return openAPIUtils.NewValidationMiddleware(
 				openAPIUtils.WithDoc(lo.Must(server.GetSwagger())),
 				openAPIUtils.WithKinOpenAPIDefaults(),
 				openAPIUtils.WithErrorRenderer(server.ErrorRenderer),
+				openAPIUtils.WithOpenAPIOptions(options),
 			), nil
 		}
 	})
 
+	do.Provide(injector, func(i *do.Injector) (*auth.TokenManager, error) {
+		return auth.NewTokenManager(
+			cfg.JWTSecret,
+			cfg.JWTIssuer,
+			cfg.AccessTokenLifetime(),
+			cfg.RefreshTokenLifetime(),
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (mailer.Mailer, error) {
+		return mailer.New(&mailer.Config{
+			Transport:    cfg.MailTransport,
+			SMTPHost:     cfg.SMTPHost,
+			SMTPPort:     cfg.SMTPPort,
+			SMTPUsername: cfg.SMTPUsername,
+			SMTPPassword: cfg.SMTPPassword,
+			SMTPTimeout:  cfg.SMTPServerTimeout(),
+			FileDir:      cfg.MailFileDir,
+		})
+	})
+
 	// ===========================
 	//	API services & Routes
 	// ===========================
@@ -73,25 +121,139 @@ func NewInjector(serviceName string, cfg *Config) *do.Injector {
 		), nil
 	})
 
+	do.Provide(injector, func(i *do.Injector) (*v1.APIKeysHandler, error) {
+		return v1.NewAPIKeysHandler(
+			do.MustInvoke[*apikeys.SQLRepository](i),
+			do.MustInvoke[*users.SQLRepository](i),
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*v1.AuthHandler, error) {
+		return v1.NewAuthHandler(
+			do.MustInvoke[*refreshtokens.SQLRepository](i),
+			do.MustInvoke[*auth.TokenManager](i),
+			do.MustInvoke[*users.SQLRepository](i),
+		), nil
+	})
+
 	do.Provide(injector, func(i *do.Injector) (*v1.CustomersHandler, error) {
 		return v1.NewCustomersHandler(
 			do.MustInvoke[*customers.SQLRepository](i),
 		), nil
 	})
 
+	do.Provide(injector, func(i *do.Injector) (*v1.CreditNotesHandler, error) {
+		return v1.NewCreditNotesHandler(
+			do.MustInvoke[*creditnotes.SQLRepository](i),
+			do.MustInvoke[*unitofwork.SQLUnitOfWork](i),
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*v1.DeliveriesHandler, error) {
+		return v1.NewDeliveriesHandler(
+			do.MustInvoke[*deliveries.SQLRepository](i),
+			do.MustInvoke[*customers.SQLRepository](i),
+			do.MustInvoke[*v1.DocumentsHandler](i),
+			do.MustInvoke[mailer.Mailer](i),
+			mail.Address{Name: cfg.MailFromName, Address: cfg.MailFromAddress},
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*v1.DocumentsHandler, error) {
+		return v1.NewDocumentsHandler(
+			do.MustInvoke[*branding.SQLRepository](i),
+			do.MustInvoke[*customers.SQLRepository](i),
+			do.MustInvoke[*users.SQLRepository](i),
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*v1.EstimatesHandler, error) {
+		return v1.NewEstimatesHandler(
+			do.MustInvoke[*estimates.SQLRepository](i),
+			do.MustInvoke[*unitofwork.SQLUnitOfWork](i),
+			do.MustInvoke[*v1.CustomersHandler](i),
+		), nil
+	})
+
 	do.Provide(injector, func(i *do.Injector) (*v1.InvoiceHandler, error) {
 		return v1.NewInvoiceHandler(
 			do.MustInvoke[*invoices.SQLRepository](i),
 			do.MustInvoke[*invoicesitems.SQLRepository](i),
+			do.MustInvoke[*unitofwork.SQLUnitOfWork](i),
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*v1.PaymentsHandler, error) {
+		return v1.NewPaymentsHandler(
+			do.MustInvoke[*payments.SQLRepository](i),
+			do.MustInvoke[*unitofwork.SQLUnitOfWork](i),
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*v1.ProductsHandler, error) {
+		return v1.NewProductsHandler(
+			do.MustInvoke[*products.SQLRepository](i),
+			do.MustInvoke[*v1.TaxRatesHandler](i),
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*v1.RecurringInvoicesHandler, error) {
+		return v1.NewRecurringInvoicesHandler(
+			do.MustInvoke[*recurringinvoices.SQLRepository](i),
+			do.MustInvoke[*invoices.SQLRepository](i),
+			do.MustInvoke[*unitofwork.SQLUnitOfWork](i),
+			do.MustInvoke[*v1.CustomersHandler](i),
+			do.MustInvoke[*v1.TaxRatesHandler](i),
+			do.MustInvoke[*v1.DeliveriesHandler](i),
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*v1.SettingsHandler, error) {
+		return v1.NewSettingsHandler(
+			do.MustInvoke[*branding.SQLRepository](i),
+			do.MustInvoke[*reminders.SQLRepository](i),
+			do.MustInvoke[*sequences.SQLRepository](i),
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*v1.TaxRatesHandler, error) {
+		return v1.NewTaxRatesHandler(
+			do.MustInvoke[*taxrates.SQLRepository](i),
 		), nil
 	})
 
 	do.Provide(injector, func(i *do.Injector) (*v1.API, error) {
 		activitiesHandler := do.MustInvoke[*v1.ActivitiesHandler](i)
+		apiKeysHandler := do.MustInvoke[*v1.APIKeysHandler](i)
+		authHandler := do.MustInvoke[*v1.AuthHandler](i)
+		creditNotesHandler := do.MustInvoke[*v1.CreditNotesHandler](i)
 		customersHandler := do.MustInvoke[*v1.CustomersHandler](i)
+		deliveriesHandler := do.MustInvoke[*v1.DeliveriesHandler](i)
+		documentsHandler := do.MustInvoke[*v1.DocumentsHandler](i)
+		estimatesHandler := do.MustInvoke[*v1.EstimatesHandler](i)
 		invoiceHandler := do.MustInvoke[*v1.InvoiceHandler](i)
+		paymentsHandler := do.MustInvoke[*v1.PaymentsHandler](i)
+		productsHandler := do.MustInvoke[*v1.ProductsHandler](i)
+		recurringInvoicesHandler := do.MustInvoke[*v1.RecurringInvoicesHandler](i)
+		settingsHandler := do.MustInvoke[*v1.SettingsHandler](i)
+		taxRatesHandler := do.MustInvoke[*v1.TaxRatesHandler](i)
 
-		return v1.NewAPI(activitiesHandler, customersHandler, invoiceHandler), nil
+		return v1.NewAPI(
+			activitiesHandler,
+			apiKeysHandler,
+			authHandler,
+			creditNotesHandler,
+			customersHandler,
+			deliveriesHandler,
+			documentsHandler,
+			estimatesHandler,
+			invoiceHandler,
+			paymentsHandler,
+			productsHandler,
+			recurringInvoicesHandler,
+			settingsHandler,
+			taxRatesHandler,
+		), nil
 	})
 
 	do.Provide(injector, func(i *do.Injector) (*api.Routes, error) {
@@ -100,6 +262,44 @@ func NewInjector(serviceName string, cfg *Config) *do.Injector {
 		return api.NewRoutes(v1API), nil
 	})
 
+	// ===========================
+	//	Background jobs
+	// ===========================
+	do.Provide(injector, func(i *do.Injector) (*jobs.OverdueJob, error) {
+		return jobs.NewOverdueJob(
+			do.MustInvoke[*unitofwork.SQLUnitOfWork](i),
+			do.MustInvoke[*zerolog.Logger](i),
+			cfg.OverdueJobBatchSize,
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*jobs.ReminderJob, error) {
+		return jobs.NewReminderJob(
+			do.MustInvoke[*reminders.SQLRepository](i),
+			do.MustInvoke[*invoices.SQLRepository](i),
+			do.MustInvoke[*invoicesitems.SQLRepository](i),
+			do.MustInvoke[*activities.SQLRepository](i),
+			do.MustInvoke[*v1.DeliveriesHandler](i),
+			do.MustInvoke[*zerolog.Logger](i),
+			cfg.ReminderJobBatchSize,
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*jobs.RecurringInvoiceJob, error) {
+		return jobs.NewRecurringInvoiceJob(
+			do.MustInvoke[*v1.RecurringInvoicesHandler](i),
+			do.MustInvoke[*zerolog.Logger](i),
+			cfg.RecurringJobBatchSize,
+		), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*jobs.EstimateExpiryJob, error) {
+		return jobs.NewEstimateExpiryJob(
+			do.MustInvoke[*estimates.SQLRepository](i),
+			do.MustInvoke[*zerolog.Logger](i),
+		), nil
+	})
+
 	// ===========================
 	//	Database Config & Repo
 	// ===========================
@@ -109,11 +309,36 @@ func NewInjector(serviceName string, cfg *Config) *do.Injector {
 
 	})
 
+	do.Provide(injector, func(i *do.Injector) (*apikeys.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return apikeys.NewSQLRepository(gormDB), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*branding.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return branding.NewSQLRepository(gormDB), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*creditnotes.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return creditnotes.NewSQLRepository(gormDB), nil
+	})
+
 	do.Provide(injector, func(i *do.Injector) (*customers.SQLRepository, error) {
 		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
 		return customers.NewSQLRepository(gormDB), nil
 	})
 
+	do.Provide(injector, func(i *do.Injector) (*deliveries.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return deliveries.NewSQLRepository(gormDB), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*estimates.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return estimates.NewSQLRepository(gormDB), nil
+	})
+
 	do.Provide(injector, func(i *do.Injector) (*invoices.SQLRepository, error) {
 		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
 		return invoices.NewSQLRepository(gormDB), nil
@@ -124,6 +349,51 @@ func NewInjector(serviceName string, cfg *Config) *do.Injector {
 		return invoicesitems.NewSQLRepository(gormDB), nil
 	})
 
+	do.Provide(injector, func(i *do.Injector) (*payments.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return payments.NewSQLRepository(gormDB), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*products.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return products.NewSQLRepository(gormDB), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*recurringinvoices.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return recurringinvoices.NewSQLRepository(gormDB), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*refreshtokens.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return refreshtokens.NewSQLRepository(gormDB), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*reminders.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return reminders.NewSQLRepository(gormDB), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*sequences.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return sequences.NewSQLRepository(gormDB), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*taxrates.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return taxrates.NewSQLRepository(gormDB), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*unitofwork.SQLUnitOfWork, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return unitofwork.NewSQLUnitOfWork(gormDB), nil
+	})
+
+	do.Provide(injector, func(i *do.Injector) (*users.SQLRepository, error) {
+		gormDB := do.MustInvokeNamed[*gorm.DB](i, InjectorDatabase)
+		return users.NewSQLRepository(gormDB), nil
+	})
+
 	do.ProvideNamed(injector, InjectorDatabase, func(i *do.Injector) (*gorm.DB, error) {
 		return postgres.InitDB(
 			serviceName, &postgres.Config{
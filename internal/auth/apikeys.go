@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const (
+	apiKeyPrefix       = "inv"
+	apiKeyIDBytes      = 4
+	apiKeySecretBytes  = 32
+	apiKeySeparator    = "_"
+	scopeSeparator     = ":"
+	scopeAllPermission = "*"
+)
+
+var (
+	ErrInvalidAPIKey = errors.New("invalid, expired or revoked API key")
+	ErrInvalidScope  = errors.New("invalid API key scope")
+)
+
+// NewAPIKey returns a random API key such as inv_3f9a0c1d_<secret>, the prefix identifying it (inv_3f9a0c1d) and the
+// hash to store. Like refresh tokens, only the prefix and the hash are persisted.
+func NewAPIKey() (key string, prefix string, hash string, err error) {
+	id := make([]byte, apiKeyIDBytes)
+	secret := make([]byte, apiKeySecretBytes)
+
+	_, err = rand.Read(id)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	_, err = rand.Read(secret)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	prefix = apiKeyPrefix + apiKeySeparator + hex.EncodeToString(id)
+	key = prefix + apiKeySeparator + base64.RawURLEncoding.EncodeToString(secret)
+
+	return key, prefix, HashAPIKey(key), nil
+}
+
+// APIKeyPrefix extracts the identifying prefix of an API key, or false when the key is not shaped like one.
+func APIKeyPrefix(key string) (string, bool) {
+	parts := strings.SplitN(key, apiKeySeparator, 3)
+	if len(parts) != 3 || parts[0] != apiKeyPrefix || len(parts[1]) != hex.EncodedLen(apiKeyIDBytes) || parts[2] == "" {
+		return "", false
+	}
+
+	return parts[0] + apiKeySeparator + parts[1], true
+}
+
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyAPIKey compares a presented key with a stored hash in constant time.
+func VerifyAPIKey(key, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashAPIKey(key)), []byte(hash)) == 1
+}
+
+// ValidateScope accepts the known permissions and resource wildcards such as customers:*.
+func ValidateScope(scope string) error {
+	resource, action, ok := strings.Cut(scope, scopeSeparator)
+	if ok && action == scopeAllPermission {
+		for _, permission := range allPermissions {
+			if permission.resource() == resource {
+				return nil
+			}
+		}
+	}
+
+	for _, permission := range allPermissions {
+		if string(permission) == scope {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %q", ErrInvalidScope, scope)
+}
+
+// DelegateScope fails with ErrForbidden unless the principal may hand the scope on to a new API key. Principals
+// authenticated with an API key may only delegate permissions their own scopes grant, so that a key can never mint
+// a more powerful one; interactive sessions are not restricted by scopes.
+func (p *Principal) DelegateScope(scope string) error {
+	if p.Scopes == nil {
+		return nil
+	}
+
+	for _, permission := range allPermissions {
+		if !ScopeGrants(scope, permission) {
+			continue
+		}
+
+		if !lo.SomeBy(p.Scopes, func(own string) bool { return ScopeGrants(own, permission) }) {
+			return fmt.Errorf("%w: API key is not scoped for %s", ErrForbidden, permission)
+		}
+	}
+
+	return nil
+}
+
+// ScopeGrants reports whether an API key scope covers the permission.
+func ScopeGrants(scope string, permission Permission) bool {
+	return scope == string(permission) || scope == permission.resource()+scopeSeparator+scopeAllPermission
+}
+
+func (p Permission) resource() string {
+	resource, _, _ := strings.Cut(string(p), scopeSeparator)
+
+	return resource
+}
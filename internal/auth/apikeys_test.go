@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	key, prefix, hash, err := NewAPIKey()
+	require.NoError(t, err)
+
+	other, otherPrefix, _, err := NewAPIKey()
+	require.NoError(t, err)
+
+	assert.NotEqual(t, key, other)
+	assert.NotEqual(t, prefix, otherPrefix)
+	assert.Regexp(t, `^inv_[0-9a-f]{8}$`, prefix)
+
+	extracted, ok := APIKeyPrefix(key)
+	assert.True(t, ok)
+	assert.Equal(t, prefix, extracted)
+
+	assert.True(t, VerifyAPIKey(key, hash))
+	assert.False(t, VerifyAPIKey(other, hash))
+}
+
+func TestAPIKeyPrefix(t *testing.T) {
+	tests := map[string]bool{
+		"inv_3f9a0c1d_c2VjcmV0":     true,
+		"inv_3f9a0c1d_with_under":   true,
+		"inv_3f9a0c1d_":             false,
+		"inv_3f9a0c_c2VjcmV0":       false,
+		"sk_3f9a0c1d_c2VjcmV0":      false,
+		"eyJhbGciOiJIUzI1NiJ9.e30.": false,
+	}
+
+	for key, valid := range tests {
+		t.Run(key, func(t *testing.T) {
+			_, ok := APIKeyPrefix(key)
+			assert.Equal(t, valid, ok)
+		})
+	}
+}
+
+func TestValidateScope(t *testing.T) {
+	for _, scope := range []string{"invoices:read", "invoices:write", "customers:*", "tax_rates:delete"} {
+		assert.NoError(t, ValidateScope(scope), scope)
+	}
+
+	for _, scope := range []string{"invoices", "invoices:admin", "unknown:*", "*", ""} {
+		assert.ErrorIs(t, ValidateScope(scope), ErrInvalidScope, scope)
+	}
+}
+
+func TestScopeGrants(t *testing.T) {
+	assert.True(t, ScopeGrants("invoices:read", PermissionInvoicesRead))
+	assert.False(t, ScopeGrants("invoices:read", PermissionInvoicesWrite))
+	assert.True(t, ScopeGrants("customers:*", PermissionCustomersDelete))
+	assert.False(t, ScopeGrants("customers:*", PermissionInvoicesRead))
+}
+
+func TestDelegateScope(t *testing.T) {
+	session := &Principal{UserID: uuid.New(), Role: RoleOwner}
+	assert.NoError(t, session.DelegateScope("invoices:*"), "sessions are not restricted by scopes")
+
+	apiKey := &Principal{UserID: uuid.New(), Role: RoleOwner, Scopes: []string{"api_keys:write", "customers:*", "invoices:read"}}
+
+	assert.NoError(t, apiKey.DelegateScope("customers:*"))
+	assert.NoError(t, apiKey.DelegateScope("customers:read"))
+	assert.NoError(t, apiKey.DelegateScope("invoices:read"))
+	assert.ErrorIs(t, apiKey.DelegateScope("invoices:write"), ErrForbidden)
+	assert.ErrorIs(t, apiKey.DelegateScope("invoices:*"), ErrForbidden, "only part of the resource is granted")
+	assert.ErrorIs(t, apiKey.DelegateScope("tax_rates:read"), ErrForbidden)
+}
@@ -0,0 +1,24 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePassword(t *testing.T) {
+	assert.NoError(t, ValidatePassword("correct horse"))
+	assert.ErrorIs(t, ValidatePassword("short"), ErrInvalidPassword)
+	assert.ErrorIs(t, ValidatePassword(strings.Repeat("a", MaxPasswordLength+1)), ErrInvalidPassword)
+}
+
+func TestVerifyPassword(t *testing.T) {
+	hash, err := HashPassword("correct horse")
+	require.NoError(t, err)
+
+	assert.NoError(t, VerifyPassword(hash, "correct horse"))
+	assert.ErrorIs(t, VerifyPassword(hash, "battery staple"), ErrInvalidCredentials)
+	assert.ErrorIs(t, VerifyPassword("", "correct horse"), ErrInvalidCredentials)
+}
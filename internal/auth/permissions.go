@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+var ErrForbidden = errors.New("not allowed to perform this operation")
+
+// Permission grants an action on a kind of resource, written as resource:action.
+type Permission string
+
+const (
+	PermissionActivitiesRead  Permission = "activities:read"
+	PermissionAPIKeysRead     Permission = "api_keys:read"
+	PermissionAPIKeysWrite    Permission = "api_keys:write"
+	PermissionCustomersRead   Permission = "customers:read"
+	PermissionCustomersWrite  Permission = "customers:write"
+	PermissionCustomersDelete Permission = "customers:delete"
+	PermissionInvoicesRead    Permission = "invoices:read"
+	PermissionInvoicesWrite   Permission = "invoices:write"
+	PermissionInvoicesDelete  Permission = "invoices:delete"
+	PermissionPaymentsRead    Permission = "payments:read"
+	PermissionPaymentsWrite   Permission = "payments:write"
+	PermissionProductsRead    Permission = "products:read"
+	PermissionProductsWrite   Permission = "products:write"
+	PermissionProductsDelete  Permission = "products:delete"
+	PermissionSettingsRead    Permission = "settings:read"
+	PermissionSettingsWrite   Permission = "settings:write"
+	PermissionTaxRatesRead    Permission = "tax_rates:read"
+	PermissionTaxRatesWrite   Permission = "tax_rates:write"
+	PermissionTaxRatesDelete  Permission = "tax_rates:delete"
+)
+
+var (
+	readPermissions = []Permission{
+		PermissionActivitiesRead,
+		PermissionCustomersRead,
+		PermissionInvoicesRead,
+		PermissionPaymentsRead,
+		PermissionProductsRead,
+		PermissionSettingsRead,
+		PermissionTaxRatesRead,
+	}
+
+	bookkeepingPermissions = []Permission{
+		PermissionCustomersWrite,
+		PermissionInvoicesWrite,
+		PermissionPaymentsWrite,
+		PermissionProductsWrite,
+		PermissionTaxRatesWrite,
+	}
+
+	accountPermissions = []Permission{
+		PermissionAPIKeysRead,
+		PermissionAPIKeysWrite,
+		PermissionSettingsWrite,
+	}
+
+	deletePermissions = []Permission{
+		PermissionCustomersDelete,
+		PermissionInvoicesDelete,
+		PermissionProductsDelete,
+		PermissionTaxRatesDelete,
+	}
+
+	allPermissions = lo.Flatten([][]Permission{readPermissions, bookkeepingPermissions, accountPermissions, deletePermissions})
+)
+
+// rolePermissions is the policy: viewers only read, accountants also keep the books but cannot delete, and owners
+// and admins may do everything, deletions included. Registered users are owners of their account, see Role.
+var rolePermissions = map[Role]map[Permission]bool{
+	RoleViewer:     permissionSet(readPermissions),
+	RoleAccountant: permissionSet(readPermissions, bookkeepingPermissions),
+	RoleOwner:      permissionSet(readPermissions, bookkeepingPermissions, accountPermissions, deletePermissions),
+	RoleAdmin:      permissionSet(readPermissions, bookkeepingPermissions, accountPermissions, deletePermissions),
+}
+
+// Can reports whether the role grants the permission. Unknown roles grant nothing.
+func (x Role) Can(permission Permission) bool {
+	return rolePermissions[x][permission]
+}
+
+// Authorize fails with ErrForbidden unless the principal's role grants the permission. Principals authenticated
+// with an API key also need a scope of the key covering it.
+func (p *Principal) Authorize(permission Permission) error {
+	if !p.Role.Can(permission) {
+		return fmt.Errorf("%w: role %q lacks %s", ErrForbidden, p.Role, permission)
+	}
+
+	if p.Scopes != nil && !lo.SomeBy(p.Scopes, func(scope string) bool { return ScopeGrants(scope, permission) }) {
+		return fmt.Errorf("%w: API key is not scoped for %s", ErrForbidden, permission)
+	}
+
+	return nil
+}
+
+func permissionSet(groups ...[]Permission) map[Permission]bool {
+	set := make(map[Permission]bool)
+
+	for _, group := range groups {
+		for _, permission := range group {
+			set[permission] = true
+		}
+	}
+
+	return set
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleCan(t *testing.T) {
+	testCases := []struct {
+		name       string
+		role       Role
+		permission Permission
+		allowed    bool
+	}{
+		{name: "viewer reads invoices", role: RoleViewer, permission: PermissionInvoicesRead, allowed: true},
+		{name: "viewer cannot create invoices", role: RoleViewer, permission: PermissionInvoicesWrite, allowed: false},
+		{name: "viewer cannot record payments", role: RoleViewer, permission: PermissionPaymentsWrite, allowed: false},
+		{name: "accountant creates invoices", role: RoleAccountant, permission: PermissionInvoicesWrite, allowed: true},
+		{name: "accountant records payments", role: RoleAccountant, permission: PermissionPaymentsWrite, allowed: true},
+		{name: "accountant cannot change settings", role: RoleAccountant, permission: PermissionSettingsWrite, allowed: false},
+		{name: "owner changes settings", role: RoleOwner, permission: PermissionSettingsWrite, allowed: true},
+		{name: "owner deletes invoices", role: RoleOwner, permission: PermissionInvoicesDelete, allowed: true},
+		{name: "accountant cannot delete invoices", role: RoleAccountant, permission: PermissionInvoicesDelete, allowed: false},
+		{name: "admin deletes invoices", role: RoleAdmin, permission: PermissionInvoicesDelete, allowed: true},
+		{name: "admin deletes tax rates", role: RoleAdmin, permission: PermissionTaxRatesDelete, allowed: true},
+		{name: "accountant manages products", role: RoleAccountant, permission: PermissionProductsWrite, allowed: true},
+		{name: "accountant cannot delete products", role: RoleAccountant, permission: PermissionProductsDelete, allowed: false},
+		{name: "unknown role is granted nothing", role: Role("user"), permission: PermissionInvoicesRead, allowed: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.allowed, tc.role.Can(tc.permission))
+
+			err := (&Principal{UserID: uuid.New(), Role: tc.role}).Authorize(tc.permission)
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbidden)
+			}
+		})
+	}
+}
+
+func TestAuthorizeAPIKeyScopes(t *testing.T) {
+	principal := &Principal{UserID: uuid.New(), Role: RoleAccountant, Scopes: []string{"invoices:*", "customers:read"}}
+
+	assert.NoError(t, principal.Authorize(PermissionInvoicesWrite))
+	assert.NoError(t, principal.Authorize(PermissionCustomersRead))
+	assert.ErrorIs(t, principal.Authorize(PermissionCustomersWrite), ErrForbidden, "not in the scopes")
+	assert.ErrorIs(t, principal.Authorize(PermissionInvoicesDelete), ErrForbidden, "scoped but not granted to the role")
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type principalContextKey struct{}
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	UserID uuid.UUID
+	Email  string
+	Role   Role
+	// Scopes restrict callers using an API key; they are nil for interactive sessions.
+	Scopes []string
+}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated caller, or false for anonymous requests.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+
+	return principal, ok && principal != nil
+}
+
+type systemContextKey struct{}
+
+// AsSystem marks ctx as acting on behalf of the service itself rather than a user. Background jobs use it to reach
+// the data of every tenant; requests must never carry it.
+func AsSystem(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemContextKey{}, true)
+}
+
+func IsSystem(ctx context.Context) bool {
+	system, _ := ctx.Value(systemContextKey{}).(bool)
+
+	return system
+}
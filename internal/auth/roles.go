@@ -0,0 +1,10 @@
+package auth
+
+// Role is what a user may do on their account, see rolePermissions. Users registering themselves are owners
+// (users.DefaultRole). No endpoint changes roles: the narrower accountant and viewer roles, and the admin role of
+// operator accounts, are assigned by an operator updating the role column of the users table.
+//
+// Role ENUM(owner, accountant, viewer, admin)
+//
+//go:generate go run github.com/abice/go-enum@v0.5.5
+type Role string
@@ -0,0 +1,51 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// RoleOwner is a Role of type owner.
+	RoleOwner Role = "owner"
+	// RoleAccountant is a Role of type accountant.
+	RoleAccountant Role = "accountant"
+	// RoleViewer is a Role of type viewer.
+	RoleViewer Role = "viewer"
+	// RoleAdmin is a Role of type admin.
+	RoleAdmin Role = "admin"
+)
+
+var ErrInvalidRole = errors.New("not a valid Role")
+
+// String implements the Stringer interface.
+func (x Role) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x Role) IsValid() bool {
+	_, err := ParseRole(string(x))
+	return err == nil
+}
+
+var _RoleValue = map[string]Role{
+	"owner":      RoleOwner,
+	"accountant": RoleAccountant,
+	"viewer":     RoleViewer,
+	"admin":      RoleAdmin,
+}
+
+// ParseRole attempts to convert a string to a Role.
+func ParseRole(name string) (Role, error) {
+	if x, ok := _RoleValue[name]; ok {
+		return x, nil
+	}
+	return Role(""), fmt.Errorf("%s is %w", name, ErrInvalidRole)
+}
@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const opaqueTokenBytes = 32
+
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+// AccessClaims are the claims of the signed access tokens. The subject holds the user ID.
+type AccessClaims struct {
+	jwt.RegisteredClaims
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// TokenManager issues and verifies HS256 signed access tokens and generates opaque refresh tokens.
+type TokenManager struct {
+	secret          []byte
+	issuer          string
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+}
+
+func NewTokenManager(secret, issuer string, accessTokenTTL, refreshTokenTTL time.Duration) *TokenManager {
+	return &TokenManager{
+		secret:          []byte(secret),
+		issuer:          issuer,
+		accessTokenTTL:  accessTokenTTL,
+		refreshTokenTTL: refreshTokenTTL,
+	}
+}
+
+func (m *TokenManager) AccessTokenTTL() time.Duration {
+	return m.accessTokenTTL
+}
+
+func (m *TokenManager) RefreshTokenTTL() time.Duration {
+	return m.refreshTokenTTL
+}
+
+// IssueAccessToken signs an access token for the principal, valid for the access token TTL from now.
+func (m *TokenManager) IssueAccessToken(principal *Principal, now time.Time) (string, error) {
+	claims := AccessClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    m.issuer,
+			Subject:   principal.UserID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.accessTokenTTL)),
+		},
+		Email: principal.Email,
+		Role:  principal.Role.String(),
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+}
+
+// ParseAccessToken verifies the signature, issuer and lifetime of an access token and returns its principal.
+func (m *TokenManager) ParseAccessToken(token string) (*Principal, error) {
+	claims := new(AccessClaims)
+
+	_, err := jwt.ParseWithClaims(
+		token,
+		claims,
+		func(*jwt.Token) (interface{}, error) { return m.secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid subject", ErrInvalidToken)
+	}
+
+	role, err := ParseRole(claims.Role)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	return &Principal{
+		UserID: userID,
+		Email:  claims.Email,
+		Role:   role,
+	}, nil
+}
+
+// NewRefreshToken returns a random refresh token together with the hash to store. Only the hash is persisted, so
+// a leaked database does not leak usable tokens.
+func NewRefreshToken() (token string, hash string, err error) {
+	token, err = randomToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	return token, HashRefreshToken(token), nil
+}
+
+func HashRefreshToken(token string) string {
+	return hashToken(token)
+}
+
+// NewLinkToken returns a random token for the links customers open without an account, such as the accept and
+// decline links of estimates, together with the hash to store.
+func NewLinkToken() (token string, hash string, err error) {
+	token, err = randomToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	return token, HashLinkToken(token), nil
+}
+
+func HashLinkToken(token string) string {
+	return hashToken(token)
+}
+
+func randomToken() (string, error) {
+	random := make([]byte, opaqueTokenBytes)
+
+	_, err := rand.Read(random)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokenRoundTrip(t *testing.T) {
+	manager := NewTokenManager("secret", "invoice-backend", 15*time.Minute, 24*time.Hour)
+	principal := &Principal{UserID: uuid.New(), Email: "jane@example.com", Role: RoleOwner}
+
+	token, err := manager.IssueAccessToken(principal, time.Now())
+	require.NoError(t, err)
+
+	parsed, err := manager.ParseAccessToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, principal, parsed)
+}
+
+func TestParseAccessTokenRejectsInvalidTokens(t *testing.T) {
+	manager := NewTokenManager("secret", "invoice-backend", 15*time.Minute, 24*time.Hour)
+	principal := &Principal{UserID: uuid.New(), Role: RoleOwner}
+
+	expired, err := manager.IssueAccessToken(principal, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	otherSecret, err := NewTokenManager("other", "invoice-backend", time.Minute, time.Hour).IssueAccessToken(principal, time.Now())
+	require.NoError(t, err)
+
+	otherIssuer, err := NewTokenManager("secret", "someone-else", time.Minute, time.Hour).IssueAccessToken(principal, time.Now())
+	require.NoError(t, err)
+
+	unknownRole, err := manager.IssueAccessToken(&Principal{UserID: uuid.New(), Role: Role("superuser")}, time.Now())
+	require.NoError(t, err)
+
+	tests := map[string]string{
+		"expired":      expired,
+		"other secret": otherSecret,
+		"other issuer": otherIssuer,
+		"unknown role": unknownRole,
+		"malformed":    "not-a-token",
+	}
+
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := manager.ParseAccessToken(token)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestNewRefreshToken(t *testing.T) {
+	token, hash, err := NewRefreshToken()
+	require.NoError(t, err)
+
+	other, _, err := NewRefreshToken()
+	require.NoError(t, err)
+
+	assert.NotEqual(t, token, other)
+	assert.Equal(t, HashRefreshToken(token), hash)
+	assert.Len(t, hash, 64)
+}
+
+func TestNewLinkToken(t *testing.T) {
+	token, hash, err := NewLinkToken()
+	require.NoError(t, err)
+
+	assert.Equal(t, HashLinkToken(token), hash)
+	assert.NotEqual(t, token, hash)
+	assert.Len(t, hash, 64)
+}
@@ -0,0 +1,99 @@
+package documents
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"invoice-backend/pkg/money"
+)
+
+// Party is the sender or recipient block of a document.
+type Party struct {
+	Name    string
+	Address string
+	Email   string
+	Phone   string
+	TaxID   string
+}
+
+type LineTax struct {
+	Name   string
+	Rate   decimal.Decimal // Percentage, e.g. 20 for 20%
+	Amount decimal.Decimal
+}
+
+type Line struct {
+	Description    string
+	Quantity       int
+	UnitPrice      decimal.Decimal
+	DiscountAmount decimal.Decimal
+	Amount         decimal.Decimal // Quantity * UnitPrice before discount and tax
+	Taxes          []LineTax
+}
+
+// InvoiceDocument holds everything printed on an invoice or a credit note. It is independent of the storage models
+// so that the renderers only deal with presentation.
+type InvoiceDocument struct {
+	Title           string // Printed in the header, INVOICE when empty
+	Number          string
+	CreditedInvoice string // Number of the invoice a credit note corrects, empty on invoices
+	Status          string
+	IssueDate       time.Time
+	DueDate         time.Time
+	Currency        money.Currency
+	Sender          Party
+	Customer        Party
+	Lines           []Line
+	Subtotal        decimal.Decimal
+	DiscountAmount  decimal.Decimal
+	ShippingAmount  decimal.Decimal
+	TaxAmount       decimal.Decimal
+	TotalAmount     decimal.Decimal
+	AmountPaid      decimal.Decimal
+	AmountCredited  decimal.Decimal
+	AmountDue       decimal.Decimal
+	Notes           string
+}
+
+// IsCreditNote tells whether the document is a credit note rather than an invoice.
+func (d *InvoiceDocument) IsCreditNote() bool {
+	return d.CreditedInvoice != ""
+}
+
+func (d *InvoiceDocument) title() string {
+	if d.Title == "" {
+		return "INVOICE"
+	}
+
+	return d.Title
+}
+
+// TaxSummary is the total charged for one tax across all lines.
+type TaxSummary struct {
+	Name   string
+	Rate   decimal.Decimal
+	Amount decimal.Decimal
+}
+
+// TaxBreakdown groups the line taxes by name and rate, in the order they first appear on the invoice.
+func (d *InvoiceDocument) TaxBreakdown() []TaxSummary {
+	summaries := make([]TaxSummary, 0)
+	index := map[string]int{}
+
+	for _, line := range d.Lines {
+		for _, tax := range line.Taxes {
+			key := tax.Name + "|" + tax.Rate.String()
+
+			i, ok := index[key]
+			if !ok {
+				index[key] = len(summaries)
+				summaries = append(summaries, TaxSummary{Name: tax.Name, Rate: tax.Rate, Amount: decimal.Zero})
+				i = len(summaries) - 1
+			}
+
+			summaries[i].Amount = summaries[i].Amount.Add(tax.Amount)
+		}
+	}
+
+	return summaries
+}
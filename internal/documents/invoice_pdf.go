@@ -0,0 +1,396 @@
+package documents
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	pageMargin   = 15.0
+	footerHeight = 20.0
+	lineHeight   = 5.0
+	logoHeight   = 16.0
+	logoName     = "logo"
+	dateLayout   = "02 Jan 2006"
+)
+
+var (
+	mutedColor = Color{R: 107, G: 114, B: 128}
+	textColor  = Color{R: 17, G: 24, B: 39}
+	white      = Color{R: 255, G: 255, B: 255}
+	ruleColor  = Color{R: 229, G: 231, B: 235}
+)
+
+type column struct {
+	title string
+	width float64
+	align string
+}
+
+// Column widths add up to the 180mm printable width of an A4 page.
+var itemColumns = []column{
+	{title: "Description", width: 72, align: "L"},
+	{title: "Qty", width: 14, align: "R"},
+	{title: "Unit price", width: 26, align: "R"},
+	{title: "Discount", width: 22, align: "R"},
+	{title: "Tax", width: 18, align: "R"},
+	{title: "Amount", width: 28, align: "R"},
+}
+
+type invoicePDF struct {
+	pdf *fpdf.Fpdf
+	doc *InvoiceDocument
+	tpl Template
+	tr  func(string) string
+}
+
+// RenderInvoicePDF writes doc as an A4 PDF to w. Long invoices flow onto further pages, each repeating the
+// branding header, the item table headings and the footer with page numbers.
+func RenderInvoicePDF(w io.Writer, doc *InvoiceDocument, tpl Template) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, footerHeight+pageMargin)
+	pdf.AliasNbPages("{nb}")
+	pdf.SetTitle(fmt.Sprintf("%s %s", titleCase(doc.title()), doc.Number), true)
+	pdf.SetCreator("invoice-backend", true)
+
+	r := &invoicePDF{
+		pdf: pdf,
+		doc: doc,
+		tpl: tpl,
+		tr:  pdf.UnicodeTranslatorFromDescriptor(""),
+	}
+
+	if len(tpl.Logo) > 0 {
+		imageType, err := LogoImageType(tpl.Logo)
+		if err != nil {
+			return err
+		}
+
+		pdf.RegisterImageOptionsReader(logoName, fpdf.ImageOptions{ImageType: imageType}, bytes.NewReader(tpl.Logo))
+	}
+
+	pdf.SetHeaderFunc(r.header)
+	pdf.SetFooterFunc(r.footer)
+
+	pdf.AddPage()
+	r.parties()
+	r.items()
+	r.totals()
+	r.notes()
+
+	return pdf.Output(w)
+}
+
+func (r *invoicePDF) header() {
+	pdf := r.pdf
+	top := pdf.GetY()
+
+	if len(r.tpl.Logo) > 0 {
+		pdf.ImageOptions(logoName, pageMargin, top, 0, logoHeight, false, fpdf.ImageOptions{}, 0, "")
+	} else {
+		r.setText(r.tpl.PrimaryColor)
+		pdf.SetFont("Helvetica", "B", 14)
+		pdf.CellFormat(100, logoHeight, r.tr(r.doc.Sender.Name), "", 0, "LM", false, 0, "")
+	}
+
+	pdf.SetXY(pageMargin+100, top)
+	r.setText(r.tpl.PrimaryColor)
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(80, 9, r.tr(r.doc.title()), "", 2, "R", false, 0, "")
+
+	r.setText(mutedColor)
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(80, 6, r.tr(r.doc.Number), "", 2, "R", false, 0, "")
+
+	pdf.SetY(top + logoHeight + 6)
+}
+
+func (r *invoicePDF) footer() {
+	pdf := r.pdf
+
+	pdf.SetY(-(pageMargin + 8))
+	r.setDraw(ruleColor)
+	pdf.Line(pageMargin, pdf.GetY(), pageMargin+180, pdf.GetY())
+
+	r.setText(mutedColor)
+	pdf.SetFont("Helvetica", "", 8)
+	pdf.CellFormat(150, 8, r.tr(r.tpl.FooterText), "", 0, "L", false, 0, "")
+	pdf.CellFormat(30, 8, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "R", false, 0, "")
+}
+
+func (r *invoicePDF) parties() {
+	pdf := r.pdf
+	top := pdf.GetY()
+
+	r.party("From", r.doc.Sender, pageMargin, top)
+	senderBottom := pdf.GetY()
+
+	r.party("Bill to", r.doc.Customer, pageMargin+65, top)
+	customerBottom := pdf.GetY()
+
+	details := [][2]string{
+		{"Issue date", r.doc.IssueDate.Format(dateLayout)},
+		{"Due date", r.doc.DueDate.Format(dateLayout)},
+		{"Status", strings.ReplaceAll(r.doc.Status, "_", " ")},
+		{"Amount due", r.amount(r.doc.AmountDue) + " " + r.doc.Currency.String()},
+	}
+
+	if r.doc.IsCreditNote() {
+		details = [][2]string{
+			{"Issue date", r.doc.IssueDate.Format(dateLayout)},
+			{"Invoice", r.doc.CreditedInvoice},
+			{"Credited", r.amount(r.doc.TotalAmount) + " " + r.doc.Currency.String()},
+		}
+	}
+
+	pdf.SetXY(pageMargin+130, top)
+
+	for _, detail := range details {
+		pdf.SetX(pageMargin + 130)
+		r.setText(mutedColor)
+		pdf.SetFont("Helvetica", "", 9)
+		pdf.CellFormat(22, lineHeight, detail[0], "", 0, "L", false, 0, "")
+		r.setText(textColor)
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.CellFormat(28, lineHeight, r.tr(detail[1]), "", 1, "R", false, 0, "")
+	}
+
+	pdf.SetY(max(senderBottom, customerBottom, pdf.GetY()) + 8)
+}
+
+func (r *invoicePDF) party(title string, party Party, x, y float64) {
+	pdf := r.pdf
+
+	pdf.SetXY(x, y)
+	r.setText(mutedColor)
+	pdf.SetFont("Helvetica", "B", 8)
+	pdf.CellFormat(60, lineHeight, strings.ToUpper(title), "", 2, "L", false, 0, "")
+
+	r.setText(textColor)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.MultiCell(60, lineHeight, r.tr(party.Name), "", "L", false)
+
+	pdf.SetFont("Helvetica", "", 9)
+
+	taxID := ""
+	if party.TaxID != "" {
+		taxID = "Tax ID: " + party.TaxID
+	}
+
+	for _, text := range []string{party.Address, party.Email, party.Phone, taxID} {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		pdf.SetX(x)
+		pdf.MultiCell(60, lineHeight-0.5, r.tr(text), "", "L", false)
+	}
+}
+
+func (r *invoicePDF) items() {
+	r.itemsHeading()
+
+	for i, line := range r.doc.Lines {
+		r.itemRow(line, i%2 == 1)
+	}
+}
+
+func (r *invoicePDF) itemsHeading() {
+	pdf := r.pdf
+
+	r.setFill(r.tpl.AccentColor)
+	r.setText(white)
+	pdf.SetFont("Helvetica", "B", 9)
+
+	for _, col := range itemColumns {
+		pdf.CellFormat(col.width, 7, col.title, "", 0, col.align, true, 0, "")
+	}
+
+	pdf.Ln(-1)
+}
+
+func (r *invoicePDF) itemRow(line Line, shaded bool) {
+	pdf := r.pdf
+	pdf.SetFont("Helvetica", "", 9)
+
+	descriptionLines := pdf.SplitLines([]byte(r.tr(line.Description)), itemColumns[0].width-2)
+	rowHeight := float64(max(len(descriptionLines), 1))*lineHeight + 2
+
+	// Rows are kept whole: start a new page, with the table headings repeated, when the row would not fit.
+	_, pageHeight := pdf.GetPageSize()
+	if pdf.GetY()+rowHeight > pageHeight-footerHeight-pageMargin {
+		pdf.AddPage()
+		r.itemsHeading()
+		pdf.SetFont("Helvetica", "", 9)
+	}
+
+	x, y := pdf.GetXY()
+
+	if shaded {
+		r.setFill(Color{R: 249, G: 250, B: 251})
+		pdf.Rect(x, y, 180, rowHeight, "F")
+	}
+
+	r.setText(textColor)
+
+	for i, text := range descriptionLines {
+		pdf.SetXY(x+1, y+1+float64(i)*lineHeight)
+		pdf.CellFormat(itemColumns[0].width-2, lineHeight, string(text), "", 0, "L", false, 0, "")
+	}
+
+	values := []string{
+		strconv.Itoa(line.Quantity),
+		r.amount(line.UnitPrice),
+		r.optionalAmount(line.DiscountAmount),
+		r.lineTaxRates(line),
+		r.amount(line.Amount),
+	}
+
+	pdf.SetXY(x+itemColumns[0].width, y+1)
+
+	for i, value := range values {
+		col := itemColumns[i+1]
+		pdf.CellFormat(col.width, lineHeight, value, "", 0, col.align, false, 0, "")
+	}
+
+	pdf.SetXY(x, y+rowHeight)
+}
+
+func (r *invoicePDF) totals() {
+	pdf := r.pdf
+	pdf.Ln(4)
+
+	rows := [][2]string{{"Subtotal", r.amount(r.doc.Subtotal)}}
+
+	if !r.doc.DiscountAmount.IsZero() {
+		rows = append(rows, [2]string{"Discount", "-" + r.amount(r.doc.DiscountAmount)})
+	}
+
+	for _, tax := range r.doc.TaxBreakdown() {
+		rows = append(rows, [2]string{fmt.Sprintf("%s (%s%%)", tax.Name, formatRate(tax.Rate)), r.amount(tax.Amount)})
+	}
+
+	if !r.doc.ShippingAmount.IsZero() {
+		rows = append(rows, [2]string{"Shipping", r.amount(r.doc.ShippingAmount)})
+	}
+
+	// Keep the totals block together with at least its own height of room.
+	_, pageHeight := pdf.GetPageSize()
+	if pdf.GetY()+float64(len(rows)+4)*6 > pageHeight-footerHeight-pageMargin {
+		pdf.AddPage()
+	}
+
+	for _, row := range rows {
+		r.totalsRow(row[0], row[1], false)
+	}
+
+	r.setDraw(ruleColor)
+	pdf.Line(pageMargin+100, pdf.GetY()+1, pageMargin+180, pdf.GetY()+1)
+	pdf.Ln(2)
+
+	r.totalsRow(fmt.Sprintf("Total (%s)", r.doc.Currency), r.amount(r.doc.TotalAmount), true)
+
+	if !r.doc.AmountPaid.IsZero() {
+		r.totalsRow("Amount paid", "-"+r.amount(r.doc.AmountPaid), false)
+	}
+
+	if !r.doc.AmountCredited.IsZero() {
+		r.totalsRow("Amount credited", "-"+r.amount(r.doc.AmountCredited), false)
+	}
+
+	if !r.doc.AmountPaid.IsZero() || !r.doc.AmountCredited.IsZero() {
+		r.totalsRow("Amount due", r.amount(r.doc.AmountDue), true)
+	}
+}
+
+func (r *invoicePDF) notes() {
+	if strings.TrimSpace(r.doc.Notes) == "" {
+		return
+	}
+
+	pdf := r.pdf
+	pdf.Ln(8)
+
+	r.setText(mutedColor)
+	pdf.SetFont("Helvetica", "B", 8)
+	pdf.CellFormat(180, lineHeight, "NOTES", "", 2, "L", false, 0, "")
+
+	r.setText(textColor)
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.MultiCell(180, lineHeight, r.tr(r.doc.Notes), "", "L", false)
+}
+
+func (r *invoicePDF) totalsRow(label, value string, emphasised bool) {
+	pdf := r.pdf
+	style := ""
+
+	r.setText(textColor)
+	if emphasised {
+		style = "B"
+		r.setText(r.tpl.PrimaryColor)
+	}
+
+	pdf.SetFont("Helvetica", style, 10)
+	pdf.SetX(pageMargin + 100)
+	pdf.CellFormat(50, 6, r.tr(label), "", 0, "L", false, 0, "")
+	pdf.CellFormat(30, 6, value, "", 1, "R", false, 0, "")
+}
+
+func (r *invoicePDF) lineTaxRates(line Line) string {
+	rates := make([]string, 0, len(line.Taxes))
+
+	for _, tax := range line.Taxes {
+		rates = append(rates, formatRate(tax.Rate)+"%")
+	}
+
+	return strings.Join(rates, " + ")
+}
+
+func (r *invoicePDF) amount(value decimal.Decimal) string {
+	return r.doc.Currency.Format(value)
+}
+
+func (r *invoicePDF) optionalAmount(value decimal.Decimal) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	return r.amount(value)
+}
+
+func (r *invoicePDF) setText(c Color) {
+	r.pdf.SetTextColor(c.R, c.G, c.B)
+}
+
+func (r *invoicePDF) setFill(c Color) {
+	r.pdf.SetFillColor(c.R, c.G, c.B)
+}
+
+func (r *invoicePDF) setDraw(c Color) {
+	r.pdf.SetDrawColor(c.R, c.G, c.B)
+}
+
+func formatRate(rate decimal.Decimal) string {
+	if rate.Equal(rate.Round(2)) {
+		return rate.StringFixed(2)
+	}
+
+	return rate.String()
+}
+
+func titleCase(title string) string {
+	words := strings.Fields(strings.ToLower(title))
+
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+
+	return strings.Join(words, " ")
+}
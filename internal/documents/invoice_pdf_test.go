@@ -0,0 +1,140 @@
+package documents
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/pkg/money"
+)
+
+func testDocument(lines int) *InvoiceDocument {
+	doc := &InvoiceDocument{
+		Number:    "INV0000042",
+		Status:    "PENDING_PAYMENT",
+		IssueDate: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		DueDate:   time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC),
+		Currency:  money.Currency("EUR"),
+		Sender:    Party{Name: "Acme Café", Address: "1 Rue de Rivoli\nParis", Email: "billing@acme.test"},
+		Customer:  Party{Name: "Globex", Address: "42 Main Street", Phone: "+1 555 0100", TaxID: "DE123456789"},
+	}
+
+	for i := 0; i < lines; i++ {
+		doc.Lines = append(doc.Lines, Line{
+			Description: fmt.Sprintf("Consulting services, item %d", i+1),
+			Quantity:    2,
+			UnitPrice:   decimal.RequireFromString("50"),
+			Amount:      decimal.RequireFromString("100"),
+			Taxes: []LineTax{
+				{Name: "VAT", Rate: decimal.RequireFromString("20"), Amount: decimal.RequireFromString("20")},
+			},
+		})
+	}
+
+	doc.Subtotal = decimal.NewFromInt(int64(100 * lines))
+	doc.TaxAmount = decimal.NewFromInt(int64(20 * lines))
+	doc.TotalAmount = doc.Subtotal.Add(doc.TaxAmount)
+	doc.AmountDue = doc.TotalAmount
+
+	return doc
+}
+
+func pageCount(t *testing.T, pdf []byte) int {
+	t.Helper()
+
+	return len(regexp.MustCompile(`/Type /Page\b`).FindAll(pdf, -1))
+}
+
+func TestRenderInvoicePDF(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines int
+		pages int
+	}{
+		{"single page", 3, 1},
+		{"paginated", 120, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			err := RenderInvoicePDF(&buf, testDocument(tt.lines), DefaultTemplate)
+			require.NoError(t, err)
+
+			assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+			assert.GreaterOrEqual(t, pageCount(t, buf.Bytes()), tt.pages)
+		})
+	}
+}
+
+func TestRenderCreditNotePDF(t *testing.T) {
+	doc := testDocument(2)
+	doc.Title = "CREDIT NOTE"
+	doc.Number = "CN0000001"
+	doc.CreditedInvoice = "INV0000042"
+	doc.Notes = "Two licences returned"
+
+	var buf bytes.Buffer
+
+	err := RenderInvoicePDF(&buf, doc, DefaultTemplate)
+	require.NoError(t, err)
+
+	assert.True(t, doc.IsCreditNote())
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+}
+
+func TestRenderInvoicePDFRejectsInvalidLogo(t *testing.T) {
+	tpl := DefaultTemplate
+	tpl.Logo = []byte("GIF89a not supported")
+
+	err := RenderInvoicePDF(&bytes.Buffer{}, testDocument(1), tpl)
+	assert.ErrorIs(t, err, ErrInvalidLogo)
+}
+
+func TestTaxBreakdown(t *testing.T) {
+	doc := testDocument(2)
+	doc.Lines[1].Taxes = append(doc.Lines[1].Taxes, LineTax{
+		Name: "Eco tax", Rate: decimal.RequireFromString("2.5"), Amount: decimal.RequireFromString("2.5"),
+	})
+
+	breakdown := doc.TaxBreakdown()
+	require.Len(t, breakdown, 2)
+	assert.Equal(t, "VAT", breakdown[0].Name)
+	assert.True(t, decimal.RequireFromString("40").Equal(breakdown[0].Amount))
+	assert.Equal(t, "Eco tax", breakdown[1].Name)
+	assert.True(t, decimal.RequireFromString("2.5").Equal(breakdown[1].Amount))
+}
+
+func TestParseHexColor(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Color
+		valid    bool
+	}{
+		{"#2563EB", Color{R: 37, G: 99, B: 235}, true},
+		{"ff0000", Color{R: 255}, true},
+		{"#FFF", Color{}, false},
+		{"#GG0000", Color{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			color, err := ParseHexColor(tt.value)
+			if !tt.valid {
+				assert.ErrorIs(t, err, ErrInvalidColor)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, color)
+		})
+	}
+
+	assert.Equal(t, "#2563EB", Color{R: 37, G: 99, B: 235}.Hex())
+}
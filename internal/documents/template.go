@@ -0,0 +1,69 @@
+package documents
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// MaxLogoSize bounds the logos users can upload, so every rendered document stays small.
+const MaxLogoSize = 512 * 1024
+
+var (
+	ErrInvalidColor = errors.New("invalid colour")
+	ErrInvalidLogo  = errors.New("invalid logo")
+)
+
+type Color struct {
+	R, G, B int
+}
+
+// Template holds the branding applied when rendering documents.
+type Template struct {
+	Logo         []byte // PNG or JPEG, optional
+	PrimaryColor Color  // Titles and totals
+	AccentColor  Color  // Table headings
+	FooterText   string
+}
+
+var DefaultTemplate = Template{
+	PrimaryColor: Color{R: 31, G: 41, B: 55},
+	AccentColor:  Color{R: 37, G: 99, B: 235},
+}
+
+// ParseHexColor parses a #RRGGBB colour.
+func ParseHexColor(value string) (Color, error) {
+	hex := strings.TrimPrefix(value, "#")
+	if len(hex) != 6 {
+		return Color{}, fmt.Errorf("%w: %q is not in #RRGGBB form", ErrInvalidColor, value)
+	}
+
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("%w: %q is not in #RRGGBB form", ErrInvalidColor, value)
+	}
+
+	return Color{R: int(rgb >> 16 & 0xff), G: int(rgb >> 8 & 0xff), B: int(rgb & 0xff)}, nil
+}
+
+func (c Color) Hex() string {
+	return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+}
+
+// LogoImageType returns the fpdf image type of a logo, rejecting anything but PNG and JPEG.
+func LogoImageType(logo []byte) (string, error) {
+	if len(logo) > MaxLogoSize {
+		return "", fmt.Errorf("%w: larger than %d bytes", ErrInvalidLogo, MaxLogoSize)
+	}
+
+	switch contentType := http.DetectContentType(logo); contentType {
+	case "image/png":
+		return "PNG", nil
+	case "image/jpeg":
+		return "JPG", nil
+	default:
+		return "", fmt.Errorf("%w: %s is not supported, use PNG or JPEG", ErrInvalidLogo, contentType)
+	}
+}
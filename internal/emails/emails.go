@@ -0,0 +1,56 @@
+package emails
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmlTemplate "html/template"
+	"strings"
+	textTemplate "text/template"
+)
+
+const (
+	TemplateInvoice  = "invoice"
+	TemplateReminder = "reminder"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+var (
+	textTemplates = textTemplate.Must(textTemplate.ParseFS(templatesFS, "templates/*.subject.tmpl", "templates/*.txt.tmpl"))
+	htmlTemplates = htmlTemplate.Must(htmlTemplate.ParseFS(templatesFS, "templates/*.html.tmpl"))
+)
+
+// Content is a rendered email, ready to be put into a message.
+type Content struct {
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+// Render renders the subject, plain text and HTML templates sharing the given name, e.g. templates/invoice.*.tmpl.
+func Render(name string, data any) (*Content, error) {
+	var subject, text, html bytes.Buffer
+
+	err := textTemplates.ExecuteTemplate(&subject, name+".subject.tmpl", data)
+	if err != nil {
+		return nil, fmt.Errorf("render %s subject: %w", name, err)
+	}
+
+	err = textTemplates.ExecuteTemplate(&text, name+".txt.tmpl", data)
+	if err != nil {
+		return nil, fmt.Errorf("render %s text body: %w", name, err)
+	}
+
+	err = htmlTemplates.ExecuteTemplate(&html, name+".html.tmpl", data)
+	if err != nil {
+		return nil, fmt.Errorf("render %s html body: %w", name, err)
+	}
+
+	return &Content{
+		Subject:  strings.TrimSpace(subject.String()),
+		TextBody: text.String(),
+		HTMLBody: html.String(),
+	}, nil
+}
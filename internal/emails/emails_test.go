@@ -0,0 +1,64 @@
+package emails
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderInvoice(t *testing.T) {
+	content, err := Render(TemplateInvoice, InvoiceEmail{
+		SenderName:    "Acme & Sons",
+		SenderEmail:   "billing@acme.test",
+		CustomerName:  "Globex",
+		InvoiceNumber: "INV0000042",
+		Currency:      "EUR",
+		TotalAmount:   "120.00",
+		AmountDue:     "120.00",
+		DueDate:       "31 Oct 2026",
+		Message:       "Thanks for the <great> project!",
+		PrimaryColor:  "#1F2937",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Invoice INV0000042 from Acme & Sons", content.Subject)
+	assert.Contains(t, content.TextBody, "Thanks for the <great> project!")
+	assert.Contains(t, content.TextBody, "The amount due of 120.00 EUR is payable by 31 Oct 2026.")
+	assert.Contains(t, content.HTMLBody, "Thanks for the &lt;great&gt; project!")
+	assert.Contains(t, content.HTMLBody, "Acme &amp; Sons")
+	assert.NotContains(t, content.HTMLBody, "border-top:1px solid #e5e7eb")
+}
+
+func TestRenderUnknownTemplate(t *testing.T) {
+	_, err := Render("unknown", nil)
+	assert.Error(t, err)
+}
+
+func TestRenderReminder(t *testing.T) {
+	data := ReminderEmail{
+		SenderName:    "Acme & Sons",
+		CustomerName:  "Globex",
+		InvoiceNumber: "INV0000042",
+		Currency:      "EUR",
+		AmountDue:     "80.00",
+		DueDate:       "31 Oct 2026",
+		PrimaryColor:  "#1F2937",
+	}
+
+	content, err := Render(TemplateReminder, data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Reminder: invoice INV0000042 from Acme & Sons is due 31 Oct 2026", content.Subject)
+	assert.Contains(t, content.TextBody, "invoice INV0000042 is due on 31 Oct 2026, with 80.00 EUR outstanding.")
+	assert.Contains(t, content.HTMLBody, "Payment reminder INV0000042")
+
+	data.Overdue = true
+
+	content, err = Render(TemplateReminder, data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Overdue: invoice INV0000042 from Acme & Sons", content.Subject)
+	assert.Contains(t, content.TextBody, "was due on 31 Oct 2026 and 80.00 EUR is still outstanding.")
+	assert.Contains(t, content.HTMLBody, "Overdue invoice INV0000042")
+}
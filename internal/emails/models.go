@@ -0,0 +1,30 @@
+package emails
+
+// InvoiceEmail is the data available to the invoice templates. Amounts and dates are preformatted.
+type InvoiceEmail struct {
+	SenderName    string
+	SenderEmail   string
+	CustomerName  string
+	InvoiceNumber string
+	Currency      string
+	TotalAmount   string
+	AmountDue     string
+	DueDate       string
+	Message       string // Optional personal note from the sender
+	PrimaryColor  string // #RRGGBB
+	FooterText    string
+}
+
+// ReminderEmail is the data available to the payment reminder templates. Amounts and dates are preformatted.
+type ReminderEmail struct {
+	SenderName    string
+	SenderEmail   string
+	CustomerName  string
+	InvoiceNumber string
+	Currency      string
+	AmountDue     string
+	DueDate       string
+	Overdue       bool   // The due date has passed
+	PrimaryColor  string // #RRGGBB
+	FooterText    string
+}
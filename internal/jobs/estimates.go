@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/estimates"
+
+	"github.com/rs/zerolog"
+)
+
+// EstimateExpiryJob marks sent estimates past their expiry date as expired. The update is a single statement that
+// can safely run on several replicas at once, so the job takes no lock.
+type EstimateExpiryJob struct {
+	estimatesRepo estimates.Repository
+	logger        *zerolog.Logger
+	now           func() time.Time
+}
+
+func NewEstimateExpiryJob(estimatesRepo estimates.Repository, logger *zerolog.Logger) *EstimateExpiryJob {
+	return &EstimateExpiryJob{
+		estimatesRepo: estimatesRepo,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// Start runs the job right away and then every interval until ctx is cancelled.
+func (j *EstimateExpiryJob) Start(ctx context.Context, interval time.Duration) {
+	every(ctx, interval, func() {
+		count, err := j.Run(ctx)
+		if err != nil {
+			j.logger.Err(err).Msg("expiring estimates failed")
+		} else if count > 0 {
+			j.logger.Info().Int64("count", count).Msg("expired estimates")
+		}
+	})
+}
+
+// Run expires the estimates whose expiry date is before today and returns how many were.
+func (j *EstimateExpiryJob) Run(ctx context.Context) (int64, error) {
+	return j.estimatesRepo.ExpireEstimates(auth.AsSystem(context.WithoutCancel(ctx)), j.now())
+}
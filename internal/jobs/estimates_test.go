@@ -0,0 +1,47 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/estimates"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEstimates struct {
+	estimates.Repository
+
+	today time.Time
+	count int64
+}
+
+func (e *fakeEstimates) ExpireEstimates(ctx context.Context, today time.Time) (int64, error) {
+	if !auth.IsSystem(ctx) {
+		return 0, errors.New("estimates must be expired as the system")
+	}
+
+	e.today = today
+
+	return e.count, nil
+}
+
+func TestEstimateExpiryJobExpiresEstimatesAsTheSystem(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	repo := &fakeEstimates{count: 3}
+	logger := zerolog.Nop()
+
+	job := NewEstimateExpiryJob(repo, &logger)
+	job.now = func() time.Time { return now }
+
+	count, err := job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), count)
+	assert.Equal(t, now, repo.today)
+}
@@ -0,0 +1,118 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/activities"
+	activityEnums "invoice-backend/internal/repositories/activities/enums"
+	"invoice-backend/internal/repositories/invoices/enums"
+	"invoice-backend/internal/repositories/locks"
+	"invoice-backend/internal/repositories/unitofwork"
+
+	"github.com/rs/zerolog"
+)
+
+// OverdueJob moves PENDING_PAYMENT and PARTIALLY_PAID invoices past their due date to OVERDUE. Every batch runs in
+// its own transaction holding an advisory lock, so only one replica works at a time and a failure only rolls back the
+// current batch.
+type OverdueJob struct {
+	unitOfWork unitofwork.UnitOfWork
+	logger     *zerolog.Logger
+	batchSize  int
+	now        func() time.Time
+}
+
+func NewOverdueJob(unitOfWork unitofwork.UnitOfWork, logger *zerolog.Logger, batchSize int) *OverdueJob {
+	return &OverdueJob{
+		unitOfWork: unitOfWork,
+		logger:     logger,
+		batchSize:  batchSize,
+		now:        time.Now,
+	}
+}
+
+// Start runs the job right away and then every interval until ctx is cancelled. A batch in progress is allowed to
+// finish, so Start only returns once no transaction is open anymore.
+func (j *OverdueJob) Start(ctx context.Context, interval time.Duration) {
+	every(ctx, interval, func() {
+		count, err := j.Run(ctx)
+		if err != nil {
+			j.logger.Err(err).Int("count", count).Msg("marking overdue invoices failed")
+		} else if count > 0 {
+			j.logger.Info().Int("count", count).Msg("marked invoices overdue")
+		}
+	})
+}
+
+// Run marks every invoice due before today as overdue and returns how many were updated. It stops early without an
+// error when another replica holds the lock.
+func (j *OverdueJob) Run(ctx context.Context) (int, error) {
+	total := 0
+
+	for ctx.Err() == nil {
+		count, err := j.runBatch(auth.AsSystem(context.WithoutCancel(ctx)))
+		total += count
+
+		if err != nil {
+			return total, err
+		}
+
+		if count < j.batchSize {
+			break
+		}
+	}
+
+	return total, nil
+}
+
+func (j *OverdueJob) runBatch(ctx context.Context) (int, error) {
+	count := 0
+
+	err := j.unitOfWork.Do(ctx, func(repos *unitofwork.Repositories) error {
+		locked, err := repos.Locks.TryLock(ctx, locks.KeyOverdueInvoices)
+		if err != nil || !locked {
+			return err
+		}
+
+		overdue, err := repos.Invoices.ListOverdueInvoices(ctx, startOfDay(j.now()), j.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, invoice := range overdue {
+			err = repos.Invoices.TransitionInvoiceStatus(ctx, invoice.ID, invoice.Status, enums.InvoiceStatusOVERDUE)
+			if err != nil {
+				return err
+			}
+
+			err = repos.Activities.CreateActivity(ctx, activities.NewInvoiceActivity(
+				activityEnums.ActivityTypeStatusChanged,
+				invoice.UserID,
+				invoice.ID,
+				fmt.Sprintf("Invoice %s status changed from %s to %s", invoice.InvoiceNumber, invoice.Status, enums.InvoiceStatusOVERDUE),
+			))
+			if err != nil {
+				return err
+			}
+		}
+
+		count = len(overdue)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// startOfDay keeps an invoice due today out of the job until the day is over.
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,174 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/activities"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/invoices/enums"
+	"invoice-backend/internal/repositories/locks"
+	"invoice-backend/internal/repositories/unitofwork"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUnitOfWork struct {
+	repos        *unitofwork.Repositories
+	transactions int
+}
+
+func (u *fakeUnitOfWork) Do(_ context.Context, fn func(repos *unitofwork.Repositories) error) error {
+	u.transactions++
+
+	return fn(u.repos)
+}
+
+type fakeLocks struct {
+	locked bool
+}
+
+func (l *fakeLocks) TryLock(_ context.Context, _ locks.Key) (bool, error) {
+	return l.locked, nil
+}
+
+type fakeInvoices struct {
+	invoices.Repository
+
+	pending     []*invoices.Invoice
+	dueBefore   time.Time
+	transitions map[uuid.UUID]enums.InvoiceStatus
+	system      bool
+	err         error
+}
+
+func (r *fakeInvoices) ListOverdueInvoices(ctx context.Context, dueBefore time.Time, limit int) ([]*invoices.Invoice, error) {
+	r.dueBefore = dueBefore
+	r.system = auth.IsSystem(ctx)
+
+	batch := r.pending[:min(limit, len(r.pending))]
+	r.pending = r.pending[len(batch):]
+
+	return batch, nil
+}
+
+func (r *fakeInvoices) TransitionInvoiceStatus(_ context.Context, id uuid.UUID, _, to enums.InvoiceStatus) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.transitions[id] = to
+
+	return nil
+}
+
+type fakeActivities struct {
+	activities.Repository
+
+	created []*activities.Activity
+}
+
+func (r *fakeActivities) CreateActivity(_ context.Context, activity *activities.Activity) error {
+	r.created = append(r.created, activity)
+
+	return nil
+}
+
+func newTestJob(pending int, locked bool) (*OverdueJob, *fakeUnitOfWork, *fakeInvoices, *fakeActivities) {
+	invoicesRepo := &fakeInvoices{transitions: map[uuid.UUID]enums.InvoiceStatus{}}
+	for range pending {
+		invoicesRepo.pending = append(invoicesRepo.pending, &invoices.Invoice{
+			ID:            uuid.New(),
+			UserID:        uuid.New(),
+			InvoiceNumber: "INV-0001",
+			Status:        enums.InvoiceStatusPENDINGPAYMENT,
+		})
+	}
+
+	activitiesRepo := &fakeActivities{}
+	uow := &fakeUnitOfWork{repos: &unitofwork.Repositories{
+		Activities: activitiesRepo,
+		Invoices:   invoicesRepo,
+		Locks:      &fakeLocks{locked: locked},
+	}}
+
+	logger := zerolog.Nop()
+	job := NewOverdueJob(uow, &logger, 2)
+	job.now = func() time.Time { return time.Date(2026, 10, 18, 14, 30, 0, 0, time.UTC) }
+
+	return job, uow, invoicesRepo, activitiesRepo
+}
+
+func TestOverdueJobMarksInvoicesInBatches(t *testing.T) {
+	job, uow, invoicesRepo, activitiesRepo := newTestJob(3, true)
+
+	count, err := job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, count)
+	assert.Equal(t, 2, uow.transactions)
+	assert.Len(t, invoicesRepo.transitions, 3)
+	for _, status := range invoicesRepo.transitions {
+		assert.Equal(t, enums.InvoiceStatusOVERDUE, status)
+	}
+	assert.True(t, invoicesRepo.system)
+	assert.Equal(t, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), invoicesRepo.dueBefore)
+
+	require.Len(t, activitiesRepo.created, 3)
+	assert.Equal(t, "Invoice INV-0001 status changed from PENDING_PAYMENT to OVERDUE", activitiesRepo.created[0].Description)
+}
+
+func TestOverdueJobMarksPartiallyPaidInvoices(t *testing.T) {
+	job, _, invoicesRepo, activitiesRepo := newTestJob(1, true)
+	invoice := invoicesRepo.pending[0]
+	invoice.Status = enums.InvoiceStatusPARTIALLYPAID
+
+	count, err := job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, count)
+	assert.Equal(t, enums.InvoiceStatusOVERDUE, invoicesRepo.transitions[invoice.ID])
+	require.Len(t, activitiesRepo.created, 1)
+	assert.Equal(t, "Invoice INV-0001 status changed from PARTIALLY_PAID to OVERDUE", activitiesRepo.created[0].Description)
+}
+
+func TestOverdueJobSkipsWhenAnotherReplicaHoldsTheLock(t *testing.T) {
+	job, uow, invoicesRepo, activitiesRepo := newTestJob(3, false)
+
+	count, err := job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Zero(t, count)
+	assert.Equal(t, 1, uow.transactions)
+	assert.Empty(t, invoicesRepo.transitions)
+	assert.Empty(t, activitiesRepo.created)
+}
+
+func TestOverdueJobStopsOnError(t *testing.T) {
+	job, uow, invoicesRepo, _ := newTestJob(3, true)
+	invoicesRepo.err = invoices.ErrInvoiceNotFound
+
+	count, err := job.Run(context.Background())
+
+	assert.True(t, errors.Is(err, invoices.ErrInvoiceNotFound))
+	assert.Zero(t, count)
+	assert.Equal(t, 1, uow.transactions)
+}
+
+func TestOverdueJobDoesNotStartBatchesOnceCancelled(t *testing.T) {
+	job, uow, _, _ := newTestJob(3, true)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	count, err := job.Run(ctx)
+	require.NoError(t, err)
+
+	assert.Zero(t, count)
+	assert.Zero(t, uow.transactions)
+}
@@ -0,0 +1,93 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/recurringinvoices"
+
+	"github.com/rs/zerolog"
+)
+
+// RecurringInvoiceGenerator generates the invoices of recurring invoices, v1.RecurringInvoicesHandler implements it.
+type RecurringInvoiceGenerator interface {
+	// GenerateNext generates the next invoice of a recurring invoice due on or before today and returns both, the
+	// recurring invoice is nil when none is due. The invoice is nil when the recurring invoice was paused instead.
+	GenerateNext(ctx context.Context, today time.Time) (*invoices.Invoice, *recurringinvoices.RecurringInvoice, error)
+	// SendGenerated emails a generated invoice to its customer and issues it.
+	SendGenerated(ctx context.Context, invoice *invoices.Invoice) error
+}
+
+// RecurringInvoiceJob generates the invoices of recurring invoices once their next run is due. Each invoice is
+// generated in its own transaction locking the recurring invoice, so replicas can run the job concurrently and a
+// run is never generated twice. Runs missed while the worker was down are caught up one invoice at a time.
+type RecurringInvoiceJob struct {
+	generator RecurringInvoiceGenerator
+	logger    *zerolog.Logger
+	batchSize int
+	now       func() time.Time
+}
+
+func NewRecurringInvoiceJob(generator RecurringInvoiceGenerator, logger *zerolog.Logger, batchSize int) *RecurringInvoiceJob {
+	return &RecurringInvoiceJob{
+		generator: generator,
+		logger:    logger,
+		batchSize: batchSize,
+		now:       time.Now,
+	}
+}
+
+// Start runs the job right away and then every interval until ctx is cancelled. An invoice being generated is
+// allowed to finish, so Start only returns once no transaction is open anymore.
+func (j *RecurringInvoiceJob) Start(ctx context.Context, interval time.Duration) {
+	every(ctx, interval, func() {
+		count, err := j.Run(ctx)
+		if err != nil {
+			j.logger.Err(err).Int("count", count).Msg("generating recurring invoices failed")
+		} else if count > 0 {
+			j.logger.Info().Int("count", count).Msg("generated recurring invoices")
+		}
+	})
+}
+
+// Run generates up to the batch size of due invoices and returns how many were generated. Invoices failing to be
+// sent are logged and left as drafts; other errors stop the run.
+func (j *RecurringInvoiceJob) Run(ctx context.Context) (int, error) {
+	systemCtx := auth.AsSystem(context.WithoutCancel(ctx))
+	today := j.now()
+	generated := 0
+
+	for generated < j.batchSize && ctx.Err() == nil {
+		invoice, recurring, err := j.generator.GenerateNext(systemCtx, today)
+		if err != nil {
+			return generated, err
+		}
+
+		if recurring == nil {
+			break
+		}
+
+		if invoice == nil {
+			j.logger.Warn().
+				Str("recurringInvoiceID", recurring.ID.String()).
+				Msg("recurring invoice paused, its customer or tax rates no longer exist")
+
+			continue
+		}
+
+		generated++
+
+		if !recurring.AutoSend {
+			continue
+		}
+
+		sendErr := j.generator.SendGenerated(systemCtx, invoice)
+		if sendErr != nil {
+			j.logger.Warn().Err(sendErr).Str("invoiceID", invoice.ID.String()).Msg("recurring invoice not sent")
+		}
+	}
+
+	return generated, nil
+}
@@ -0,0 +1,148 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/recurringinvoices"
+	"invoice-backend/internal/repositories/recurringinvoices/enums"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGenerator generates one invoice per due run of its recurring invoices.
+type fakeGenerator struct {
+	recurring []*recurringinvoices.RecurringInvoice
+	orphaned  map[uuid.UUID]bool
+	generated []*invoices.Invoice
+	sent      []*invoices.Invoice
+	sendErr   error
+}
+
+func (g *fakeGenerator) GenerateNext(
+	ctx context.Context,
+	today time.Time,
+) (*invoices.Invoice, *recurringinvoices.RecurringInvoice, error) {
+	if !auth.IsSystem(ctx) {
+		return nil, nil, errors.New("recurring invoices must be generated as the system")
+	}
+
+	for _, recurring := range g.recurring {
+		if recurring.Status != enums.RecurringStatusActive || recurring.NextRunOn.After(today) {
+			continue
+		}
+
+		if g.orphaned[recurring.ID] {
+			recurring.Status = enums.RecurringStatusPaused
+
+			return nil, recurring, nil
+		}
+
+		invoice := &invoices.Invoice{ID: uuid.New(), UserID: recurring.UserID, IssueDate: *recurring.NextRunOn}
+		g.generated = append(g.generated, invoice)
+		recurring.Advance(*recurring.NextRunOn)
+
+		return invoice, recurring, nil
+	}
+
+	return nil, nil, nil
+}
+
+func (g *fakeGenerator) SendGenerated(_ context.Context, invoice *invoices.Invoice) error {
+	if g.sendErr != nil {
+		return g.sendErr
+	}
+
+	g.sent = append(g.sent, invoice)
+
+	return nil
+}
+
+func newTestRecurringJob(batchSize int, recurring ...*recurringinvoices.RecurringInvoice) (*RecurringInvoiceJob, *fakeGenerator) {
+	generator := &fakeGenerator{recurring: recurring, orphaned: map[uuid.UUID]bool{}}
+	logger := zerolog.Nop()
+
+	job := NewRecurringInvoiceJob(generator, &logger, batchSize)
+	job.now = func() time.Time { return time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC) }
+
+	return job, generator
+}
+
+func newMonthlyRecurringInvoice(start time.Time, autoSend bool) *recurringinvoices.RecurringInvoice {
+	recurring := &recurringinvoices.RecurringInvoice{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Frequency: enums.FrequencyMonthly,
+		Interval:  1,
+		StartDate: start,
+		AutoSend:  autoSend,
+		Status:    enums.RecurringStatusActive,
+	}
+	recurring.NextRunOn = recurring.NextRunFrom(start)
+
+	return recurring
+}
+
+func TestRecurringInvoiceJobCatchesUpMissedRuns(t *testing.T) {
+	recurring := newMonthlyRecurringInvoice(time.Date(2026, 8, 18, 0, 0, 0, 0, time.UTC), false)
+	job, generator := newTestRecurringJob(10, recurring)
+
+	count, err := job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, count, "August, September and October")
+	assert.Equal(t, 3, recurring.GeneratedCount)
+	assert.Equal(t, time.Date(2026, 11, 18, 0, 0, 0, 0, time.UTC), *recurring.NextRunOn)
+	assert.Empty(t, generator.sent)
+
+	count, err = job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Zero(t, count)
+}
+
+func TestRecurringInvoiceJobStopsAtTheBatchSize(t *testing.T) {
+	recurring := newMonthlyRecurringInvoice(time.Date(2026, 8, 18, 0, 0, 0, 0, time.UTC), false)
+	job, _ := newTestRecurringJob(2, recurring)
+
+	count, err := job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, count)
+	assert.Equal(t, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), *recurring.NextRunOn, "left for the next run")
+}
+
+func TestRecurringInvoiceJobSendsGeneratedInvoices(t *testing.T) {
+	autoSent := newMonthlyRecurringInvoice(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), true)
+	orphaned := newMonthlyRecurringInvoice(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), true)
+	job, generator := newTestRecurringJob(10, orphaned, autoSent)
+	generator.orphaned[orphaned.ID] = true
+
+	count, err := job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, count)
+	assert.Equal(t, enums.RecurringStatusPaused, orphaned.Status)
+	require.Len(t, generator.sent, 1)
+	assert.Equal(t, autoSent.UserID, generator.sent[0].UserID)
+}
+
+func TestRecurringInvoiceJobKeepsGeneratingWhenSendingFails(t *testing.T) {
+	first := newMonthlyRecurringInvoice(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), true)
+	second := newMonthlyRecurringInvoice(time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC), true)
+	job, generator := newTestRecurringJob(10, first, second)
+	generator.sendErr = errors.New("smtp unavailable")
+
+	count, err := job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, count)
+	assert.Len(t, generator.generated, 2)
+}
@@ -0,0 +1,174 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/activities"
+	activityEnums "invoice-backend/internal/repositories/activities/enums"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/invoicesitems"
+	"invoice-backend/internal/repositories/reminders"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+)
+
+// ReminderChannel delivers payment reminders, v1.DeliveriesHandler sends them by email.
+type ReminderChannel interface {
+	// SendReminder notifies the customer of an invoice, loaded together with its items, that it awaits payment.
+	SendReminder(ctx context.Context, invoice *invoices.Invoice) error
+}
+
+// ReminderJob sends the payment reminders scheduled by the reminder rules of each user for their unpaid invoices.
+// Every reminder is claimed in the database before it is sent, so replicas can run the job concurrently and a
+// restart never sends a reminder twice. A claimed reminder that fails to send is not retried.
+type ReminderJob struct {
+	remindersRepo     reminders.Repository
+	invoicesRepo      invoices.Repository
+	invoicesItemsRepo invoicesitems.Repository
+	activitiesRepo    activities.Repository
+	channel           ReminderChannel
+	logger            *zerolog.Logger
+	batchSize         int
+	now               func() time.Time
+}
+
+func NewReminderJob(
+	remindersRepo reminders.Repository,
+	invoicesRepo invoices.Repository,
+	invoicesItemsRepo invoicesitems.Repository,
+	activitiesRepo activities.Repository,
+	channel ReminderChannel,
+	logger *zerolog.Logger,
+	batchSize int,
+) *ReminderJob {
+	return &ReminderJob{
+		remindersRepo:     remindersRepo,
+		invoicesRepo:      invoicesRepo,
+		invoicesItemsRepo: invoicesItemsRepo,
+		activitiesRepo:    activitiesRepo,
+		channel:           channel,
+		logger:            logger,
+		batchSize:         batchSize,
+		now:               time.Now,
+	}
+}
+
+// Start runs the job right away and then every interval until ctx is cancelled. A reminder being sent is allowed
+// to finish, so Start only returns once none is in flight.
+func (j *ReminderJob) Start(ctx context.Context, interval time.Duration) {
+	every(ctx, interval, func() {
+		count, err := j.Run(ctx)
+		if err != nil {
+			j.logger.Err(err).Int("count", count).Msg("sending payment reminders failed")
+		} else if count > 0 {
+			j.logger.Info().Int("count", count).Msg("sent payment reminders")
+		}
+	})
+}
+
+// Run sends the reminders due today and returns how many were sent. Reminders failing to send are logged and
+// skipped; other errors stop the run.
+func (j *ReminderJob) Run(ctx context.Context) (int, error) {
+	systemCtx := auth.AsSystem(context.WithoutCancel(ctx))
+	today := j.now()
+
+	rules, err := j.remindersRepo.ListRules(systemCtx)
+	if err != nil {
+		return 0, err
+	}
+
+	rulesByUser := lo.GroupBy(rules, func(rule *reminders.Rule) uuid.UUID {
+		return rule.UserID
+	})
+
+	userIDs := lo.Keys(rulesByUser)
+	sent := 0
+	afterID := uuid.Nil
+
+	for ctx.Err() == nil {
+		batch, err := j.invoicesRepo.ListRemindableInvoices(systemCtx, userIDs, afterID, j.batchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, invoice := range batch {
+			if ctx.Err() != nil {
+				return sent, nil
+			}
+
+			scheduledOn, ok := reminders.Due(rulesByUser[invoice.UserID], invoice.IssueDate, invoice.DueDate, today)
+			if !ok {
+				continue
+			}
+
+			ok, err = j.remind(systemCtx, invoice, scheduledOn)
+			if err != nil {
+				return sent, err
+			}
+
+			if ok {
+				sent++
+			}
+		}
+
+		if len(batch) < j.batchSize {
+			break
+		}
+
+		afterID = batch[len(batch)-1].ID
+	}
+
+	return sent, nil
+}
+
+// remind claims the reminder of an invoice for the given day and sends it, it reports whether it was sent.
+func (j *ReminderJob) remind(ctx context.Context, invoice *invoices.Invoice, scheduledOn time.Time) (bool, error) {
+	// Everything the reminder needs is loaded before claiming it, a claim is only left pending by a crash.
+	items, err := j.invoicesItemsRepo.GetInvoiceItemsByInvoiceID(ctx, invoice.ID)
+	if err != nil {
+		return false, err
+	}
+
+	invoice.Items = lo.ToSlicePtr(items)
+
+	reminder := &reminders.Reminder{
+		ID:          uuid.New(),
+		UserID:      invoice.UserID,
+		InvoiceID:   invoice.ID,
+		ScheduledOn: scheduledOn,
+	}
+
+	claimed, err := j.remindersRepo.ClaimReminder(ctx, reminder)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	sendErr := j.channel.SendReminder(ctx, invoice)
+	if sendErr != nil {
+		j.logger.Warn().Err(sendErr).Str("invoiceID", invoice.ID.String()).Msg("payment reminder not sent")
+
+		return false, j.remindersRepo.MarkFailed(ctx, reminder.ID, sendErr.Error())
+	}
+
+	err = j.remindersRepo.MarkSent(ctx, reminder.ID, j.now())
+	if err != nil {
+		return false, err
+	}
+
+	err = j.activitiesRepo.CreateActivity(ctx, activities.NewInvoiceActivity(
+		activityEnums.ActivityTypeReminderSent,
+		invoice.UserID,
+		invoice.ID,
+		fmt.Sprintf("Payment reminder sent for invoice %s", invoice.InvoiceNumber),
+	))
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,184 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/invoices/enums"
+	"invoice-backend/internal/repositories/invoicesitems"
+	"invoice-backend/internal/repositories/reminders"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeReminders struct {
+	reminders.Repository
+
+	rules   []*reminders.Rule
+	claimed map[string]bool
+	sent    []uuid.UUID
+	failed  []uuid.UUID
+}
+
+func (r *fakeReminders) ListRules(_ context.Context) ([]*reminders.Rule, error) {
+	return r.rules, nil
+}
+
+func (r *fakeReminders) ClaimReminder(ctx context.Context, reminder *reminders.Reminder) (bool, error) {
+	if !auth.IsSystem(ctx) {
+		return false, errors.New("reminders must be claimed as the system")
+	}
+
+	key := reminder.InvoiceID.String() + reminder.ScheduledOn.Format(time.DateOnly)
+	if r.claimed[key] {
+		return false, nil
+	}
+
+	r.claimed[key] = true
+
+	return true, nil
+}
+
+func (r *fakeReminders) MarkSent(_ context.Context, reminderID uuid.UUID, _ time.Time) error {
+	r.sent = append(r.sent, reminderID)
+
+	return nil
+}
+
+func (r *fakeReminders) MarkFailed(_ context.Context, reminderID uuid.UUID, _ string) error {
+	r.failed = append(r.failed, reminderID)
+
+	return nil
+}
+
+type fakeRemindableInvoices struct {
+	invoices.Repository
+
+	invoices []*invoices.Invoice
+}
+
+func (r *fakeRemindableInvoices) ListRemindableInvoices(
+	_ context.Context,
+	userIDs []uuid.UUID,
+	afterID uuid.UUID,
+	limit int,
+) ([]*invoices.Invoice, error) {
+	batch := make([]*invoices.Invoice, 0)
+
+	for _, invoice := range r.invoices {
+		if invoice.ID.String() > afterID.String() && len(batch) < limit && slices.Contains(userIDs, invoice.UserID) {
+			batch = append(batch, invoice)
+		}
+	}
+
+	return batch, nil
+}
+
+type fakeItems struct {
+	invoicesitems.Repository
+}
+
+func (r *fakeItems) GetInvoiceItemsByInvoiceID(_ context.Context, _ uuid.UUID) ([]invoicesitems.InvoiceItem, error) {
+	return []invoicesitems.InvoiceItem{{Description: "Consulting"}}, nil
+}
+
+type fakeChannel struct {
+	sent []*invoices.Invoice
+	err  error
+}
+
+func (c *fakeChannel) SendReminder(_ context.Context, invoice *invoices.Invoice) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	c.sent = append(c.sent, invoice)
+
+	return nil
+}
+
+func newTestReminderJob(invoiceCount int) (*ReminderJob, *fakeReminders, *fakeChannel, *fakeActivities) {
+	userID := uuid.New()
+	today := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	remindersRepo := &fakeReminders{
+		rules:   []*reminders.Rule{{UserID: userID, OffsetDays: 0}},
+		claimed: map[string]bool{},
+	}
+
+	invoicesRepo := &fakeRemindableInvoices{}
+	for range invoiceCount {
+		invoicesRepo.invoices = append(invoicesRepo.invoices, &invoices.Invoice{
+			ID:        uuid.New(),
+			UserID:    userID,
+			Status:    enums.InvoiceStatusPENDINGPAYMENT,
+			IssueDate: today.AddDate(0, 0, -30),
+			DueDate:   today,
+		})
+	}
+
+	// Invoices of users without reminder rules are never reminded.
+	invoicesRepo.invoices = append(invoicesRepo.invoices, &invoices.Invoice{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Status:    enums.InvoiceStatusOVERDUE,
+		IssueDate: today.AddDate(0, 0, -30),
+		DueDate:   today,
+	})
+
+	// ListRemindableInvoices pages by ID.
+	slices.SortFunc(invoicesRepo.invoices, func(a, b *invoices.Invoice) int {
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+
+	channel := &fakeChannel{}
+	activitiesRepo := &fakeActivities{}
+	logger := zerolog.Nop()
+
+	job := NewReminderJob(remindersRepo, invoicesRepo, &fakeItems{}, activitiesRepo, channel, &logger, 2)
+	job.now = func() time.Time { return today }
+
+	return job, remindersRepo, channel, activitiesRepo
+}
+
+func TestReminderJobSendsDueRemindersOnce(t *testing.T) {
+	job, remindersRepo, channel, activitiesRepo := newTestReminderJob(3)
+
+	count, err := job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, count)
+	assert.Len(t, channel.sent, 3)
+	assert.Len(t, remindersRepo.sent, 3)
+	assert.Len(t, channel.sent[0].Items, 1)
+	require.Len(t, activitiesRepo.created, 3)
+	assert.Equal(t, "reminder_sent", activitiesRepo.created[0].Type.String())
+
+	// A second run, e.g. after a restart, finds every reminder claimed already.
+	count, err = job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Zero(t, count)
+	assert.Len(t, channel.sent, 3)
+}
+
+func TestReminderJobRecordsFailedReminders(t *testing.T) {
+	job, remindersRepo, channel, activitiesRepo := newTestReminderJob(2)
+	channel.err = errors.New("smtp unavailable")
+
+	count, err := job.Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Zero(t, count)
+	assert.Len(t, remindersRepo.failed, 2)
+	assert.Empty(t, activitiesRepo.created)
+}
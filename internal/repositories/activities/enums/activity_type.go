@@ -0,0 +1,26 @@
+package enums
+
+// ActivityType ENUM(
+//
+// invoice_created
+// invoice_updated
+// invoice_deleted
+// invoice_sent
+// reminder_sent
+// status_changed
+// payment_recorded
+// payment_refunded
+// credit_note_issued
+// estimate_sent
+// estimate_accepted
+// estimate_declined
+// estimate_converted
+// customer_created
+// customer_updated
+// customer_deleted
+// customer_restored
+//
+// )
+//
+//go:generate go run github.com/abice/go-enum@v0.5.5
+type ActivityType string
@@ -0,0 +1,90 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package enums
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// ActivityTypeInvoiceCreated is a ActivityType of type invoice_created.
+	ActivityTypeInvoiceCreated ActivityType = "invoice_created"
+	// ActivityTypeInvoiceUpdated is a ActivityType of type invoice_updated.
+	ActivityTypeInvoiceUpdated ActivityType = "invoice_updated"
+	// ActivityTypeInvoiceDeleted is a ActivityType of type invoice_deleted.
+	ActivityTypeInvoiceDeleted ActivityType = "invoice_deleted"
+	// ActivityTypeInvoiceSent is a ActivityType of type invoice_sent.
+	ActivityTypeInvoiceSent ActivityType = "invoice_sent"
+	// ActivityTypeReminderSent is a ActivityType of type reminder_sent.
+	ActivityTypeReminderSent ActivityType = "reminder_sent"
+	// ActivityTypeStatusChanged is a ActivityType of type status_changed.
+	ActivityTypeStatusChanged ActivityType = "status_changed"
+	// ActivityTypePaymentRecorded is a ActivityType of type payment_recorded.
+	ActivityTypePaymentRecorded ActivityType = "payment_recorded"
+	// ActivityTypePaymentRefunded is a ActivityType of type payment_refunded.
+	ActivityTypePaymentRefunded ActivityType = "payment_refunded"
+	// ActivityTypeCreditNoteIssued is a ActivityType of type credit_note_issued.
+	ActivityTypeCreditNoteIssued ActivityType = "credit_note_issued"
+	// ActivityTypeEstimateSent is a ActivityType of type estimate_sent.
+	ActivityTypeEstimateSent ActivityType = "estimate_sent"
+	// ActivityTypeEstimateAccepted is a ActivityType of type estimate_accepted.
+	ActivityTypeEstimateAccepted ActivityType = "estimate_accepted"
+	// ActivityTypeEstimateDeclined is a ActivityType of type estimate_declined.
+	ActivityTypeEstimateDeclined ActivityType = "estimate_declined"
+	// ActivityTypeEstimateConverted is a ActivityType of type estimate_converted.
+	ActivityTypeEstimateConverted ActivityType = "estimate_converted"
+	// ActivityTypeCustomerCreated is a ActivityType of type customer_created.
+	ActivityTypeCustomerCreated ActivityType = "customer_created"
+	// ActivityTypeCustomerUpdated is a ActivityType of type customer_updated.
+	ActivityTypeCustomerUpdated ActivityType = "customer_updated"
+	// ActivityTypeCustomerDeleted is a ActivityType of type customer_deleted.
+	ActivityTypeCustomerDeleted ActivityType = "customer_deleted"
+	// ActivityTypeCustomerRestored is a ActivityType of type customer_restored.
+	ActivityTypeCustomerRestored ActivityType = "customer_restored"
+)
+
+var ErrInvalidActivityType = errors.New("not a valid ActivityType")
+
+// String implements the Stringer interface.
+func (x ActivityType) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x ActivityType) IsValid() bool {
+	_, err := ParseActivityType(string(x))
+	return err == nil
+}
+
+var _ActivityTypeValue = map[string]ActivityType{
+	"invoice_created":    ActivityTypeInvoiceCreated,
+	"invoice_updated":    ActivityTypeInvoiceUpdated,
+	"invoice_deleted":    ActivityTypeInvoiceDeleted,
+	"invoice_sent":       ActivityTypeInvoiceSent,
+	"reminder_sent":      ActivityTypeReminderSent,
+	"status_changed":     ActivityTypeStatusChanged,
+	"payment_recorded":   ActivityTypePaymentRecorded,
+	"payment_refunded":   ActivityTypePaymentRefunded,
+	"credit_note_issued": ActivityTypeCreditNoteIssued,
+	"estimate_sent":      ActivityTypeEstimateSent,
+	"estimate_accepted":  ActivityTypeEstimateAccepted,
+	"estimate_declined":  ActivityTypeEstimateDeclined,
+	"estimate_converted": ActivityTypeEstimateConverted,
+	"customer_created":   ActivityTypeCustomerCreated,
+	"customer_updated":   ActivityTypeCustomerUpdated,
+	"customer_deleted":   ActivityTypeCustomerDeleted,
+	"customer_restored":  ActivityTypeCustomerRestored,
+}
+
+// ParseActivityType attempts to convert a string to a ActivityType.
+func ParseActivityType(name string) (ActivityType, error) {
+	if x, ok := _ActivityTypeValue[name]; ok {
+		return x, nil
+	}
+	return ActivityType(""), fmt.Errorf("%s is %w", name, ErrInvalidActivityType)
+}
@@ -0,0 +1,26 @@
+package activities
+
+import (
+	"github.com/google/uuid"
+	"invoice-backend/internal/repositories/activities/enums"
+)
+
+func NewInvoiceActivity(activityType enums.ActivityType, userID, invoiceID uuid.UUID, description string) *Activity {
+	return &Activity{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Type:        activityType,
+		Description: description,
+		InvoiceID:   &invoiceID,
+	}
+}
+
+func NewCustomerActivity(activityType enums.ActivityType, userID, customerID uuid.UUID, description string) *Activity {
+	return &Activity{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Type:        activityType,
+		Description: description,
+		CustomerID:  &customerID,
+	}
+}
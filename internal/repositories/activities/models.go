@@ -1,15 +1,27 @@
 package activities
 
 import (
-	"github.com/google/uuid"
-	"invoice-backend/internal/repositories/invoices"
 	"time"
+
+	"github.com/google/uuid"
+	"invoice-backend/internal/repositories/activities/enums"
 )
 
 type Activity struct {
-	ID          uuid.UUID        `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
-	Description string           `gorm:"not null"`
-	InvoiceID   uuid.UUID        `gorm:"not null"`
-	Invoice     invoices.Invoice `gorm:"foreignKey:InvoiceID"`
-	CreatedAt   time.Time        `gorm:"autoCreateTime"`
+	ID          uuid.UUID          `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID      uuid.UUID          `gorm:"type:uuid;not null"`
+	Type        enums.ActivityType `gorm:"not null"`
+	Description string             `gorm:"not null"`
+	InvoiceID   *uuid.UUID         `gorm:"type:uuid"`
+	CustomerID  *uuid.UUID         `gorm:"type:uuid"`
+	CreatedAt   time.Time          `gorm:"autoCreateTime"`
+}
+
+type ActivityDBFilter struct {
+	Type          []*enums.ActivityType `json:"type,omitempty"`
+	UserID        []*uuid.UUID          `json:"user_id,omitempty"`
+	InvoiceID     []*uuid.UUID          `json:"invoice_id,omitempty"`
+	CustomerID    []*uuid.UUID          `json:"customer_id,omitempty"`
+	CreatedAfter  *string               `json:"created_after,omitempty"`
+	CreatedBefore *string               `json:"created_before,omitempty"`
 }
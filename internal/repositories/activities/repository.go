@@ -3,23 +3,26 @@ package activities
 import (
 	"context"
 	"errors"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"invoice-backend/internal/shared"
+)
+
+const (
+	tableName = "activities"
 )
 
 type Repository interface {
 	// CreateActivity Create a new activity log
 	CreateActivity(ctx context.Context, activity *Activity) error
 
-	// ListRecentActivities Retrieve all activities, optionally filtered by type
-	ListRecentActivities(ctx context.Context, limit, offset int) ([]Activity, error)
+	// ListActivities Retrieve the most recent activities matching the given filters
+	ListActivities(ctx context.Context, filters *ActivityDBFilter, pagination shared.Pagination) ([]*Activity, error)
 
 	// GetActivitiesByInvoiceID Retrieve activities related to a specific invoice
 	GetActivitiesByInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]Activity, error)
 
-	// SearchActivitiesByType Search activities by type (e.g., Invoice Created, Payment Confirmed)
-	SearchActivitiesByType(ctx context.Context, activityType string, limit, offset int) ([]Activity, error)
-
 	DeleteActivity(ctx context.Context, id uuid.UUID) error
 }
 
@@ -31,40 +34,37 @@ func (s SQLRepository) CreateActivity(ctx context.Context, activity *Activity) e
 	if activity.ID == uuid.Nil {
 		activity.ID = uuid.New() // Generate a new UUID if not provided
 	}
-	return s.db.WithContext(ctx).Create(activity).Error
+	if err := shared.CheckOwner(ctx, activity.UserID); err != nil {
+		return err
+	}
+	return s.db.WithContext(ctx).Table(tableName).Create(activity).Error
 }
 
-func (s SQLRepository) ListRecentActivities(ctx context.Context, limit, offset int) ([]Activity, error) {
-	var activities []Activity
-	err := s.db.WithContext(ctx).
-		Order("created_at DESC"). // Order by most recent
-		Limit(limit).
-		Offset(offset).
-		Find(&activities).Error
+func (s SQLRepository) ListActivities(ctx context.Context, filters *ActivityDBFilter, pagination shared.Pagination) ([]*Activity, error) {
+	activities := make([]*Activity, 0)
+
+	dataset, err := shared.BuildDataset(ctx, s.db, tableName, filters)
 	if err != nil {
 		return nil, err
 	}
-	return activities, nil
-}
 
-func (s SQLRepository) GetActivitiesByInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]Activity, error) {
-	var activities []Activity
-	err := s.db.WithContext(ctx).
-		Where("invoice_id = ?", invoiceID).
-		Find(&activities).Error
-	if err != nil {
-		return nil, err
+	paginatedDataset := shared.PaginateDataset(dataset.Scopes(shared.OwnedBy(ctx)).Order("created_at DESC"), pagination)
+
+	result := paginatedDataset.Find(&activities)
+	if result.Error != nil {
+		return nil, result.Error
 	}
+
 	return activities, nil
 }
 
-func (s SQLRepository) SearchActivitiesByType(ctx context.Context, activityType string, limit, offset int) ([]Activity, error) {
+func (s SQLRepository) GetActivitiesByInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]Activity, error) {
 	var activities []Activity
 	err := s.db.WithContext(ctx).
-		Where("description LIKE ?", "%"+activityType+"%").
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("invoice_id = ?", invoiceID).
 		Order("created_at DESC").
-		Limit(limit).
-		Offset(offset).
 		Find(&activities).Error
 	if err != nil {
 		return nil, err
@@ -74,6 +74,8 @@ func (s SQLRepository) SearchActivitiesByType(ctx context.Context, activityType
 
 func (s SQLRepository) DeleteActivity(ctx context.Context, id uuid.UUID) error {
 	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
 		Where("id = ?", id).
 		Delete(&Activity{})
 	if result.Error != nil {
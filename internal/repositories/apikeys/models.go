@@ -0,0 +1,51 @@
+package apikeys
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKey is a key for server-to-server calls. Only its prefix and the SHA-256 hash of the key are stored.
+type APIKey struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID     uuid.UUID `gorm:"type:uuid;not null"`
+	Name       string    `gorm:"not null"`
+	Prefix     string    `gorm:"not null"`
+	KeyHash    string    `gorm:"type:char(64);not null"`
+	Scopes     Scopes    `gorm:"type:text;not null"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// IsActive reports whether the key may still authenticate requests.
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// Scopes are stored space separated, like OAuth scopes.
+type Scopes []string
+
+func (s Scopes) Value() (driver.Value, error) {
+	return strings.Join(s, " "), nil
+}
+
+func (s *Scopes) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case string:
+		*s = strings.Fields(value)
+	case []byte:
+		*s = strings.Fields(string(value))
+	case nil:
+		*s = Scopes{}
+	default:
+		return fmt.Errorf("cannot scan %T into Scopes", src)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package apikeys
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"invoice-backend/internal/shared"
+)
+
+const (
+	tableName = "api_keys"
+)
+
+var ErrAPIKeyNotFound = errors.New("no API key found with the given ID")
+
+type Repository interface {
+	CreateAPIKey(ctx context.Context, apiKey *APIKey) (*APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]*APIKey, error)
+	// RevokeAPIKey revokes a key of the caller. Revoking a revoked key succeeds and keeps the first revocation time.
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	// GetAPIKeyByPrefix finds the key presented by a request. It runs before the caller is known, so it is not
+	// scoped to an owner.
+	GetAPIKeyByPrefix(ctx context.Context, prefix string) (*APIKey, error)
+	TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) CreateAPIKey(ctx context.Context, apiKey *APIKey) (*APIKey, error) {
+	if apiKey.ID == uuid.Nil {
+		apiKey.ID = uuid.New()
+	}
+
+	err := shared.CheckOwner(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Table(tableName).Create(apiKey).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKey, nil
+}
+
+func (s *SQLRepository) ListAPIKeys(ctx context.Context) ([]*APIKey, error) {
+	list := make([]*APIKey, 0)
+
+	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Order("created_at DESC").
+		Find(&list).Error
+
+	return list, err
+}
+
+func (s *SQLRepository) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", id).
+		Update("revoked_at", gorm.Expr("COALESCE(revoked_at, ?)", time.Now()))
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+func (s *SQLRepository) GetAPIKeyByPrefix(ctx context.Context, prefix string) (*APIKey, error) {
+	var apiKey APIKey
+
+	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Where("prefix = ?", prefix).
+		First(&apiKey).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
+
+func (s *SQLRepository) TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	return s.db.WithContext(ctx).
+		Table(tableName).
+		Where("id = ?", id).
+		Update("last_used_at", usedAt).
+		Error
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,44 @@
+package branding
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"invoice-backend/internal/documents"
+)
+
+// BrandingSettings customise the documents rendered for a user. Empty sender details fall back to the user
+// account when rendering.
+type BrandingSettings struct {
+	UserID       uuid.UUID `json:"user_id" gorm:"type:uuid;primaryKey"`
+	CompanyName  string    `json:"company_name" gorm:"not null"`
+	Address      string    `json:"address" gorm:"not null"`
+	Email        string    `json:"email" gorm:"not null"`
+	Phone        string    `json:"phone" gorm:"not null"`
+	Logo         []byte    `json:"logo"`                          // PNG or JPEG, nil when no logo is set
+	PrimaryColor string    `json:"primary_color" gorm:"not null"` // #RRGGBB
+	AccentColor  string    `json:"accent_color" gorm:"not null"`  // #RRGGBB
+	FooterText   string    `json:"footer_text" gorm:"not null"`
+	CreatedAt    time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// Template converts the settings into the template used by the document renderers.
+func (s *BrandingSettings) Template() (documents.Template, error) {
+	primaryColor, err := documents.ParseHexColor(s.PrimaryColor)
+	if err != nil {
+		return documents.Template{}, err
+	}
+
+	accentColor, err := documents.ParseHexColor(s.AccentColor)
+	if err != nil {
+		return documents.Template{}, err
+	}
+
+	return documents.Template{
+		Logo:         s.Logo,
+		PrimaryColor: primaryColor,
+		AccentColor:  accentColor,
+		FooterText:   s.FooterText,
+	}, nil
+}
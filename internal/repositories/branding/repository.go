@@ -0,0 +1,71 @@
+package branding
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"invoice-backend/internal/documents"
+)
+
+const (
+	tableName = "branding_settings"
+)
+
+type Repository interface {
+	// GetSettings returns the branding of a user, falling back to the default template when none was saved
+	GetSettings(ctx context.Context, userID uuid.UUID) (*BrandingSettings, error)
+	SaveSettings(ctx context.Context, settings *BrandingSettings) (*BrandingSettings, error)
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) GetSettings(ctx context.Context, userID uuid.UUID) (*BrandingSettings, error) {
+	var settings BrandingSettings
+
+	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Where("user_id = ?", userID).
+		First(&settings).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &BrandingSettings{
+				UserID:       userID,
+				PrimaryColor: documents.DefaultTemplate.PrimaryColor.Hex(),
+				AccentColor:  documents.DefaultTemplate.AccentColor.Hex(),
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+func (s *SQLRepository) SaveSettings(ctx context.Context, settings *BrandingSettings) (*BrandingSettings, error) {
+	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"company_name", "address", "email", "phone", "logo", "primary_color", "accent_color", "footer_text",
+				"updated_at",
+			}),
+		}).
+		Create(settings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,203 @@
+package creditnotes
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/invoicesitems"
+	"invoice-backend/pkg/money"
+)
+
+var ErrInvalidCredit = errors.New("invalid credit")
+
+// Line selects the quantity of an invoice line to credit.
+type Line struct {
+	InvoiceItemID uuid.UUID
+	Quantity      int
+}
+
+// credited is what the credit notes issued so far took off an invoice line.
+type credited struct {
+	quantity int
+	net      decimal.Decimal
+	taxes    map[int]decimal.Decimal // By position of the tax on the line
+}
+
+// Remaining returns the lines and shipping of an invoice, loaded together with its items, that the given credit
+// notes did not credit yet. Crediting them settles the whole invoice.
+func Remaining(invoice *invoices.Invoice, issued []*CreditNote) ([]Line, decimal.Decimal) {
+	creditedItems, creditedShipping := creditedSoFar(issued)
+	lines := make([]Line, 0, len(invoice.Items))
+
+	for _, item := range invoice.Items {
+		left := item.Quantity - creditedItems[item.ID].quantity
+		if left > 0 {
+			lines = append(lines, Line{InvoiceItemID: item.ID, Quantity: left})
+		}
+	}
+
+	return lines, invoice.ShippingAmount.Sub(creditedShipping)
+}
+
+// Build prices the given lines and shipping of an invoice, loaded together with its items, into note. Every line
+// is credited its share of what was invoiced for it, after discounts and with its taxes; the last units of a line
+// are credited whatever is left of it, so a line credited in several steps adds up exactly. A line or shipping can
+// never be credited beyond what the issued credit notes left of it.
+func Build(
+	note *CreditNote,
+	invoice *invoices.Invoice,
+	issued []*CreditNote,
+	lines []Line,
+	shippingAmount decimal.Decimal,
+) error {
+	netAmounts, err := invoices.LineNetAmounts(
+		invoice.Items,
+		invoices.Discount{Type: invoice.DiscountType, Value: invoice.DiscountValue},
+		invoice.Currency,
+	)
+	if err != nil {
+		return err
+	}
+
+	positions := make(map[uuid.UUID]int, len(invoice.Items))
+	for i, item := range invoice.Items {
+		positions[item.ID] = i
+	}
+
+	creditedItems, creditedShipping := creditedSoFar(issued)
+	seen := make(map[uuid.UUID]bool, len(lines))
+
+	note.Items = make([]*CreditNoteItem, 0, len(lines))
+	note.Subtotal = decimal.Zero
+	note.TaxAmount = decimal.Zero
+
+	for position, line := range lines {
+		i, ok := positions[line.InvoiceItemID]
+		if !ok {
+			return fmt.Errorf("%w: item %s is not on invoice %s", ErrInvalidCredit, line.InvoiceItemID, invoice.InvoiceNumber)
+		}
+
+		if seen[line.InvoiceItemID] {
+			return fmt.Errorf("%w: item %s is listed twice", ErrInvalidCredit, line.InvoiceItemID)
+		}
+
+		seen[line.InvoiceItemID] = true
+
+		item := invoice.Items[i]
+		previous := creditedItems[item.ID]
+		left := item.Quantity - previous.quantity
+
+		if line.Quantity < 1 || line.Quantity > left {
+			return fmt.Errorf("%w: quantity of %q must be between 1 and %d", ErrInvalidCredit, item.Description, left)
+		}
+
+		creditItem := creditLine(item, netAmounts[i], previous, line.Quantity, invoice.Currency)
+		creditItem.CreditNoteID = note.ID
+		creditItem.Position = position
+
+		note.Items = append(note.Items, creditItem)
+		note.Subtotal = note.Subtotal.Add(creditItem.NetAmount)
+		note.TaxAmount = note.TaxAmount.Add(creditItem.TaxAmount)
+	}
+
+	shippingLeft := invoice.ShippingAmount.Sub(creditedShipping)
+
+	if shippingAmount.IsNegative() || shippingAmount.GreaterThan(shippingLeft) {
+		return fmt.Errorf("%w: shipping must be between 0 and %s", ErrInvalidCredit, invoice.Currency.Format(shippingLeft))
+	}
+
+	if !shippingAmount.Equal(invoice.Currency.Round(shippingAmount)) {
+		return fmt.Errorf("%w: %s has more decimal places than %s allows", money.ErrInvalidAmount, shippingAmount, invoice.Currency)
+	}
+
+	note.UserID = invoice.UserID
+	note.InvoiceID = invoice.ID
+	note.CustomerID = invoice.CustomerID
+	note.Currency = invoice.Currency
+	note.ShippingAmount = shippingAmount
+	note.TotalAmount = note.Subtotal.Add(note.TaxAmount).Add(shippingAmount)
+
+	if !note.TotalAmount.IsPositive() {
+		return fmt.Errorf("%w: nothing left to credit", ErrInvalidCredit)
+	}
+
+	return nil
+}
+
+func creditLine(
+	item *invoicesitems.InvoiceItem,
+	netAmount decimal.Decimal,
+	previous credited,
+	quantity int,
+	currency money.Currency,
+) *CreditNoteItem {
+	left := item.Quantity - previous.quantity
+
+	share := func(amount, creditedAmount decimal.Decimal) decimal.Decimal {
+		remaining := amount.Sub(creditedAmount)
+		if quantity == left {
+			return remaining
+		}
+
+		prorated := currency.Round(amount.Mul(decimal.NewFromInt(int64(quantity))).Div(decimal.NewFromInt(int64(item.Quantity))))
+
+		return decimal.Min(prorated, remaining)
+	}
+
+	creditItem := &CreditNoteItem{
+		ID:            uuid.New(),
+		InvoiceItemID: item.ID,
+		Description:   item.Description,
+		Quantity:      quantity,
+		UnitPrice:     item.UnitPrice,
+		NetAmount:     share(netAmount, previous.net),
+		TaxAmount:     decimal.Zero,
+		Taxes:         make(Taxes, 0, len(item.Taxes)),
+	}
+
+	for _, tax := range item.Taxes {
+		amount := share(tax.Amount, previous.taxes[tax.Position])
+
+		creditItem.Taxes = append(creditItem.Taxes, Tax{
+			Position: tax.Position,
+			Name:     tax.Name,
+			Rate:     tax.Rate,
+			Compound: tax.Compound,
+			Amount:   amount,
+		})
+		creditItem.TaxAmount = creditItem.TaxAmount.Add(amount)
+	}
+
+	return creditItem
+}
+
+func creditedSoFar(issued []*CreditNote) (map[uuid.UUID]credited, decimal.Decimal) {
+	items := map[uuid.UUID]credited{}
+	shipping := decimal.Zero
+
+	for _, note := range issued {
+		shipping = shipping.Add(note.ShippingAmount)
+
+		for _, item := range note.Items {
+			previous := items[item.InvoiceItemID]
+			if previous.taxes == nil {
+				previous.taxes = map[int]decimal.Decimal{}
+				previous.net = decimal.Zero
+			}
+
+			previous.quantity += item.Quantity
+			previous.net = previous.net.Add(item.NetAmount)
+
+			for _, tax := range item.Taxes {
+				previous.taxes[tax.Position] = previous.taxes[tax.Position].Add(tax.Amount)
+			}
+
+			items[item.InvoiceItemID] = previous
+		}
+	}
+
+	return items, shipping
+}
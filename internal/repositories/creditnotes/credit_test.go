@@ -0,0 +1,121 @@
+package creditnotes
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/invoicesitems"
+)
+
+func dec(value string) decimal.Decimal {
+	return decimal.RequireFromString(value)
+}
+
+// creditableInvoice is three units at 10.00 taxed 20% plus 5.00 shipping, and a single unit at 0.01.
+func creditableInvoice(t *testing.T) *invoices.Invoice {
+	t.Helper()
+
+	invoice := &invoices.Invoice{
+		ID:             uuid.New(),
+		UserID:         uuid.New(),
+		CustomerID:     uuid.New(),
+		InvoiceNumber:  "INV-0000001",
+		Currency:       "USD",
+		ShippingAmount: dec("5"),
+		Items: []*invoicesitems.InvoiceItem{
+			{
+				ID:        uuid.New(),
+				Quantity:  3,
+				UnitPrice: dec("10"),
+				Taxes:     []*invoicesitems.InvoiceItemTax{{Name: "VAT", Rate: dec("20")}},
+			},
+			{ID: uuid.New(), Quantity: 1, UnitPrice: dec("0.01")},
+		},
+	}
+
+	_, err := invoices.CalculateTotals(invoice.Items, invoices.Discount{}, invoice.ShippingAmount, invoice.Currency)
+	require.NoError(t, err)
+
+	return invoice
+}
+
+func TestBuildCreditsTheShareOfALine(t *testing.T) {
+	invoice := creditableInvoice(t)
+	note := &CreditNote{ID: uuid.New()}
+
+	err := Build(note, invoice, nil, []Line{{InvoiceItemID: invoice.Items[0].ID, Quantity: 1}}, decimal.Zero)
+	require.NoError(t, err)
+
+	require.Len(t, note.Items, 1)
+	assert.Equal(t, "10", note.Items[0].NetAmount.String())
+	assert.Equal(t, "2", note.Items[0].TaxAmount.String())
+	assert.Equal(t, "2", note.Items[0].Taxes[0].Amount.String())
+	assert.Equal(t, note.ID, note.Items[0].CreditNoteID)
+	assert.Equal(t, "12", note.TotalAmount.String())
+	assert.Equal(t, invoice.CustomerID, note.CustomerID)
+}
+
+func TestBuildCreditsWhatIsLeftOfALine(t *testing.T) {
+	invoice := creditableInvoice(t)
+	invoice.Items[0].UnitPrice = dec("3.33")
+	_, err := invoices.CalculateTotals(invoice.Items, invoices.Discount{}, invoice.ShippingAmount, invoice.Currency)
+	require.NoError(t, err)
+
+	first := &CreditNote{}
+	require.NoError(t, Build(first, invoice, nil, []Line{{InvoiceItemID: invoice.Items[0].ID, Quantity: 2}}, decimal.Zero))
+
+	second := &CreditNote{}
+	require.NoError(t, Build(second, invoice, []*CreditNote{first}, []Line{{InvoiceItemID: invoice.Items[0].ID, Quantity: 1}}, decimal.Zero))
+
+	assert.Equal(t, "9.99", first.Items[0].NetAmount.Add(second.Items[0].NetAmount).String())
+	assert.Equal(t, invoice.Items[0].TaxAmount.String(), first.Items[0].TaxAmount.Add(second.Items[0].TaxAmount).String())
+}
+
+func TestBuildRejectsInvalidLines(t *testing.T) {
+	invoice := creditableInvoice(t)
+	itemID := invoice.Items[0].ID
+
+	issued := &CreditNote{}
+	require.NoError(t, Build(issued, invoice, nil, []Line{{InvoiceItemID: itemID, Quantity: 2}}, dec("5")))
+
+	tests := []struct {
+		name     string
+		lines    []Line
+		shipping string
+	}{
+		{"unknown item", []Line{{InvoiceItemID: uuid.New(), Quantity: 1}}, "0"},
+		{"item listed twice", []Line{{InvoiceItemID: itemID, Quantity: 1}, {InvoiceItemID: itemID, Quantity: 1}}, "0"},
+		{"zero quantity", []Line{{InvoiceItemID: itemID, Quantity: 0}}, "0"},
+		{"quantity already credited", []Line{{InvoiceItemID: itemID, Quantity: 2}}, "0"},
+		{"shipping already credited", nil, "0.01"},
+		{"nothing to credit", nil, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Build(&CreditNote{}, invoice, []*CreditNote{issued}, tt.lines, dec(tt.shipping))
+			assert.ErrorIs(t, err, ErrInvalidCredit)
+		})
+	}
+}
+
+func TestRemainingCreditsTheWholeInvoice(t *testing.T) {
+	invoice := creditableInvoice(t)
+
+	partial := &CreditNote{}
+	require.NoError(t, Build(partial, invoice, nil, []Line{{InvoiceItemID: invoice.Items[0].ID, Quantity: 1}}, dec("2")))
+
+	lines, shipping := Remaining(invoice, []*CreditNote{partial})
+	assert.Equal(t, []Line{{InvoiceItemID: invoice.Items[0].ID, Quantity: 2}, {InvoiceItemID: invoice.Items[1].ID, Quantity: 1}}, lines)
+	assert.Equal(t, "3", shipping.String())
+
+	rest := &CreditNote{}
+	require.NoError(t, Build(rest, invoice, []*CreditNote{partial}, lines, shipping))
+
+	// 36.00 of lines and taxes, 0.01 and 5.00 of shipping
+	assert.Equal(t, "41.01", partial.TotalAmount.Add(rest.TotalAmount).String())
+}
@@ -0,0 +1,100 @@
+package creditnotes
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"invoice-backend/pkg/money"
+)
+
+// CreditNote reduces the balance of an issued invoice, in full or for some of its lines. Issued invoices are never
+// edited, credit notes are how they are corrected.
+type CreditNote struct {
+	ID               uuid.UUID         `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID           uuid.UUID         `gorm:"type:uuid;not null"`
+	InvoiceID        uuid.UUID         `gorm:"type:uuid;not null"`
+	CustomerID       uuid.UUID         `gorm:"type:uuid;not null"`
+	CreditNoteNumber string            `gorm:"not null"`
+	Currency         money.Currency    `gorm:"type:char(3);not null"`
+	Reason           string            `gorm:"not null"`
+	IssueDate        time.Time         `gorm:"type:date;not null"`
+	Subtotal         decimal.Decimal   `gorm:"type:numeric(19,4);not null"` // Credited net amount of the lines, discounts deducted
+	TaxAmount        decimal.Decimal   `gorm:"type:numeric(19,4);not null"`
+	ShippingAmount   decimal.Decimal   `gorm:"type:numeric(19,4);not null"`
+	TotalAmount      decimal.Decimal   `gorm:"type:numeric(19,4);not null"` // Subtotal + TaxAmount + ShippingAmount
+	Items            []*CreditNoteItem `gorm:"-"`
+	CreatedAt        time.Time         `gorm:"autoCreateTime"`
+}
+
+// CreditNoteItem credits some of the quantity of an invoice line. The amounts are the matching share of what the
+// line was invoiced for.
+type CreditNoteItem struct {
+	ID            uuid.UUID       `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	CreditNoteID  uuid.UUID       `gorm:"type:uuid;not null"`
+	InvoiceItemID uuid.UUID       `gorm:"type:uuid;not null"`
+	Position      int             `gorm:"not null"`
+	Description   string          `gorm:"not null"` // Copied from the invoice line
+	Quantity      int             `gorm:"not null"`
+	UnitPrice     decimal.Decimal `gorm:"type:numeric(19,4);not null"` // Copied from the invoice line
+	NetAmount     decimal.Decimal `gorm:"type:numeric(19,4);not null"` // Credited share of the line's taxable amount
+	TaxAmount     decimal.Decimal `gorm:"type:numeric(19,4);not null"`
+	Taxes         Taxes           `gorm:"type:jsonb;not null"`
+}
+
+// Tax is the credited share of a tax of the invoice line.
+type Tax struct {
+	Position int             `json:"position"` // Position of the tax on the invoice line
+	Name     string          `json:"name"`
+	Rate     decimal.Decimal `json:"rate"`
+	Compound bool            `json:"compound"`
+	Amount   decimal.Decimal `json:"amount"`
+}
+
+// Taxes are stored as a JSON array.
+type Taxes []Tax
+
+func (t Taxes) Value() (driver.Value, error) {
+	if t == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+func (t *Taxes) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(value), t)
+	case []byte:
+		return json.Unmarshal(value, t)
+	case nil:
+		*t = Taxes{}
+	default:
+		return fmt.Errorf("cannot scan %T into Taxes", src)
+	}
+
+	return nil
+}
+
+type CreditNoteDBFilter struct {
+	UserID     []*uuid.UUID `json:"user_id,omitempty"`
+	InvoiceID  []*uuid.UUID `json:"invoice_id,omitempty"`
+	CustomerID []*uuid.UUID `json:"customer_id,omitempty"`
+}
+
+type FindAllCreditNotesResult struct {
+	CreditNotes []*CreditNote `json:"credit_notes"`
+	Page        int64         `json:"page"`
+	PageSize    int64         `json:"page_size"`
+	PageCount   int64         `json:"page_count"`
+	TotalCount  int64         `json:"total_count"`
+}
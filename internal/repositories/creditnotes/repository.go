@@ -0,0 +1,202 @@
+package creditnotes
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"invoice-backend/internal/shared"
+)
+
+const (
+	tableName      = "credit_notes"
+	itemsTableName = "credit_note_items"
+)
+
+var ErrCreditNoteNotFound = errors.New("no credit note found with the given ID")
+
+type Repository interface {
+	// CreateCreditNote stores a credit note together with its items. Callers wanting both to be atomic must pass a
+	// repository bound to a transaction.
+	CreateCreditNote(ctx context.Context, note *CreditNote) (*CreditNote, error)
+	GetCreditNoteByID(ctx context.Context, id uuid.UUID) (*CreditNote, error)
+	// ListCreditNotes returns a page of the credit notes matching the filters, together with the total number of
+	// matches.
+	ListCreditNotes(ctx context.Context, filters *CreditNoteDBFilter, pagination shared.Pagination) (*FindAllCreditNotesResult, error)
+	ListCreditNotesByInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]*CreditNote, error)
+	CountCreditNotes(ctx context.Context, invoiceID uuid.UUID) (int64, error)
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) CreateCreditNote(ctx context.Context, note *CreditNote) (*CreditNote, error) {
+	if note.ID == uuid.Nil {
+		note.ID = uuid.New()
+	}
+
+	err := shared.CheckOwner(ctx, note.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Table(tableName).Create(note).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(note.Items) == 0 {
+		return note, nil
+	}
+
+	for _, item := range note.Items {
+		if item.ID == uuid.Nil {
+			item.ID = uuid.New()
+		}
+
+		item.CreditNoteID = note.ID
+	}
+
+	err = s.db.WithContext(ctx).Table(itemsTableName).Create(note.Items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+func (s *SQLRepository) GetCreditNoteByID(ctx context.Context, id uuid.UUID) (*CreditNote, error) {
+	var note CreditNote
+
+	err := s.db.WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("id = ?", id).First(&note).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	err = s.loadItems(ctx, []*CreditNote{&note})
+	if err != nil {
+		return nil, err
+	}
+
+	return &note, nil
+}
+
+func (s *SQLRepository) ListCreditNotes(
+	ctx context.Context,
+	filters *CreditNoteDBFilter,
+	pagination shared.Pagination,
+) (*FindAllCreditNotesResult, error) {
+	list := make([]*CreditNote, 0)
+
+	dataset, err := shared.BuildDataset(ctx, s.db, tableName, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	// The count and the page run as separate statements on the same conditions.
+	dataset = dataset.Scopes(shared.OwnedBy(ctx)).Session(&gorm.Session{})
+
+	var total int64
+
+	err = dataset.Count(&total).Error
+	if err != nil {
+		return nil, err
+	}
+
+	paginatedDataset := shared.PaginateDataset(dataset.Order("issue_date DESC, created_at DESC, id DESC"), pagination)
+
+	err = paginatedDataset.Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.loadItems(ctx, list)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FindAllCreditNotesResult{
+		CreditNotes: list,
+		Page:        int64(pagination.PageNumber()),
+		PageSize:    int64(pagination.PageSize()),
+		PageCount:   pagination.PageCount(total),
+		TotalCount:  total,
+	}, nil
+}
+
+func (s *SQLRepository) ListCreditNotesByInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]*CreditNote, error) {
+	list := make([]*CreditNote, 0)
+
+	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("invoice_id = ?", invoiceID).
+		Order("issue_date ASC, created_at ASC").
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.loadItems(ctx, list)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (s *SQLRepository) CountCreditNotes(ctx context.Context, invoiceID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := s.db.WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("invoice_id = ?", invoiceID).Count(&count).Error
+
+	return count, err
+}
+
+// loadItems fills in the items of notes with a single query.
+func (s *SQLRepository) loadItems(ctx context.Context, notes []*CreditNote) error {
+	if len(notes) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*CreditNote, len(notes))
+	ids := make([]uuid.UUID, 0, len(notes))
+
+	for _, note := range notes {
+		note.Items = make([]*CreditNoteItem, 0)
+		byID[note.ID] = note
+		ids = append(ids, note.ID)
+	}
+
+	items := make([]*CreditNoteItem, 0)
+
+	err := s.db.WithContext(ctx).
+		Table(itemsTableName).
+		Scopes(shared.OwnedThrough(ctx, "credit_note_id", tableName)).
+		Where("credit_note_id IN ?", ids).
+		Order("position ASC").
+		Find(&items).Error
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if note, ok := byID[item.CreditNoteID]; ok {
+			note.Items = append(note.Items, item)
+		}
+	}
+
+	return nil
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,72 @@
+package creditnotes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/shared"
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestCreateCreditNoteRejectsAnotherOwner(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+
+	_, err := NewSQLRepository(db).CreateCreditNote(ctx, &CreditNote{UserID: uuid.New()})
+
+	assert.Error(t, err)
+	assert.Empty(t, recorder.Statements())
+}
+
+func TestCreateCreditNoteStoresItsItems(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	userID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: userID})
+
+	note, err := NewSQLRepository(db).CreateCreditNote(ctx, &CreditNote{
+		UserID: userID,
+		Items:  []*CreditNoteItem{{Quantity: 1}},
+	})
+	require.NoError(t, err)
+
+	statements := recorder.Statements()
+	require.Len(t, statements, 2)
+	assert.Contains(t, statements[0], `INSERT INTO "credit_notes"`)
+	assert.Contains(t, statements[1], `INSERT INTO "credit_note_items"`)
+	assert.Contains(t, statements[1], note.ID.String())
+	assert.Contains(t, statements[1], `'[]'`)
+}
+
+func TestListCreditNotesByInvoiceIDLoadsItemsOfOwnedNotes(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	userID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: userID})
+
+	_, err := NewSQLRepository(db).ListCreditNotesByInvoiceID(ctx, uuid.New())
+	require.NoError(t, err)
+
+	assert.Contains(t, recorder.Last(), `"credit_notes"."user_id" = '`+userID.String()+`'`)
+	assert.Contains(t, recorder.Last(), `ORDER BY issue_date ASC, created_at ASC`)
+}
+
+func TestListCreditNotesCountsAndFetchesAPage(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	userID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: userID})
+
+	result, err := NewSQLRepository(db).ListCreditNotes(ctx, nil, shared.Pagination{Limit: lo.ToPtr(10), Page: lo.ToPtr(3)})
+	require.NoError(t, err)
+
+	ownerCondition := `WHERE "credit_notes"."user_id" = '` + userID.String() + `'`
+	require.Len(t, recorder.Statements(), 2)
+	assert.Equal(t, `SELECT count(*) FROM "credit_notes" `+ownerCondition, recorder.Statements()[0])
+	assert.Equal(t, `SELECT * FROM "credit_notes" `+ownerCondition+
+		` ORDER BY issue_date DESC, created_at DESC, id DESC LIMIT 10 OFFSET 20`, recorder.Statements()[1])
+	assert.Equal(t, int64(3), result.Page)
+	assert.Equal(t, int64(10), result.PageSize)
+}
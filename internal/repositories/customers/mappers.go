@@ -2,13 +2,42 @@ package customers
 
 func FromDBCustomer(dbCustomer *DBCustomer) *Customer {
 	return &Customer{
-		ID:        dbCustomer.ID,
-		Name:      dbCustomer.Name,
-		Email:     dbCustomer.Email,
-		Phone:     dbCustomer.Phone,
-		Address:   dbCustomer.Address,
-		UserID:    dbCustomer.UserID,
-		CreatedAt: dbCustomer.CreatedAt,
-		UpdatedAt: dbCustomer.UpdatedAt,
+		ID:              dbCustomer.ID,
+		Name:            dbCustomer.Name,
+		Email:           dbCustomer.Email,
+		Phone:           dbCustomer.Phone,
+		BillingAddress:  dbCustomer.BillingAddress,
+		ShippingAddress: dbCustomer.ShippingAddress,
+		TaxID:           dbCustomer.TaxID,
+		Contacts:        dbCustomer.Contacts,
+		UserID:          dbCustomer.UserID,
+		CreatedAt:       dbCustomer.CreatedAt,
+		UpdatedAt:       dbCustomer.UpdatedAt,
+	}
+}
+
+func FromDBCustomerList(dbCustomers []*DBCustomer) []*Customer {
+	customers := make([]*Customer, 0, len(dbCustomers))
+
+	for _, dbCustomer := range dbCustomers {
+		customers = append(customers, FromDBCustomer(dbCustomer))
+	}
+
+	return customers
+}
+
+func ToDBCustomer(customer *Customer) *DBCustomer {
+	return &DBCustomer{
+		ID:              customer.ID,
+		UserID:          customer.UserID,
+		Name:            customer.Name,
+		Email:           customer.Email,
+		Phone:           customer.Phone,
+		BillingAddress:  customer.BillingAddress,
+		ShippingAddress: customer.ShippingAddress,
+		TaxID:           customer.TaxID,
+		Contacts:        customer.Contacts,
+		CreatedAt:       customer.CreatedAt,
+		UpdatedAt:       customer.UpdatedAt,
 	}
 }
@@ -1,35 +1,137 @@
 package customers
 
 import (
-	"github.com/google/uuid"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type DBCustomer struct {
-	ID        uuid.UUID      `json:"ID" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
-	UserID    uuid.UUID      `json:"user_id" gorm:"not null"`
-	Name      string         `gorm:"type:varchar(255);not null" json:"name"`
-	Email     string         `gorm:"type:varchar(255);unique;not null" json:"email"`
-	Phone     string         `gorm:"type:varchar(20);unique" json:"phone"`
-	Address   string         `gorm:"type:text" json:"address"`
-	CreatedAt time.Time      `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	ID              uuid.UUID      `json:"ID" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID          uuid.UUID      `json:"user_id" gorm:"not null"`
+	Name            string         `gorm:"type:varchar(255);not null" json:"name"`
+	Email           string         `gorm:"type:varchar(255);not null" json:"email"` // Primary billing email
+	Phone           string         `gorm:"type:varchar(20)" json:"phone"`
+	BillingAddress  Address        `gorm:"embedded;embeddedPrefix:billing_" json:"billing_address"`
+	ShippingAddress Address        `gorm:"embedded;embeddedPrefix:shipping_" json:"shipping_address"`
+	TaxID           string         `gorm:"type:varchar(32);not null" json:"tax_id"`
+	Contacts        Contacts       `gorm:"type:jsonb;not null" json:"contacts"`
+	CreatedAt       time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"deleted_at"`
 }
 
 type Customer struct {
-	ID        uuid.UUID `json:"ID"`
-	UserID    uuid.UUID `json:"user_id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Phone     string    `json:"phone"`
-	Address   string    `json:"address"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              uuid.UUID `json:"ID"`
+	UserID          uuid.UUID `json:"user_id"`
+	Name            string    `json:"name"`
+	Email           string    `json:"email"`
+	Phone           string    `json:"phone"`
+	BillingAddress  Address   `json:"billing_address"`
+	ShippingAddress Address   `json:"shipping_address"`
+	TaxID           string    `json:"tax_id"` // Canonical form, see taxid.Validate
+	Contacts        Contacts  `json:"contacts"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// Address is a postal address. Country is an ISO 3166-1 alpha-2 code, empty for addresses migrated from the
+// free-text address field.
+type Address struct {
+	Line1      string `gorm:"type:varchar(255);not null" json:"line1"`
+	Line2      string `gorm:"type:varchar(255);not null" json:"line2"`
+	City       string `gorm:"type:varchar(255);not null" json:"city"`
+	Region     string `gorm:"type:varchar(255);not null" json:"region"`
+	PostalCode string `gorm:"type:varchar(32);not null" json:"postal_code"`
+	Country    string `gorm:"type:varchar(2);not null" json:"country"`
+}
+
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
+
+// String formats the address over several lines, as printed on documents.
+func (a Address) String() string {
+	cityLine := strings.TrimSpace(a.PostalCode + " " + a.City)
+
+	lines := make([]string, 0, 5)
+
+	for _, line := range []string{a.Line1, a.Line2, cityLine, a.Region, a.Country} {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Contact is a person at the customer. Contacts with CC set are copied on the invoice emails sent to the
+// customer's billing email.
+type Contact struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+	CC    bool   `json:"cc"`
+}
+
+// Contacts are stored as a JSON array.
+type Contacts []Contact
+
+// CC returns the contacts copied on invoice emails.
+func (c Contacts) CC() []Contact {
+	cc := make([]Contact, 0, len(c))
+
+	for _, contact := range c {
+		if contact.CC {
+			cc = append(cc, contact)
+		}
+	}
+
+	return cc
+}
+
+func (c Contacts) Value() (driver.Value, error) {
+	if c == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+func (c *Contacts) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(value), c)
+	case []byte:
+		return json.Unmarshal(value, c)
+	case nil:
+		*c = Contacts{}
+	default:
+		return fmt.Errorf("cannot scan %T into Contacts", src)
+	}
+
+	return nil
 }
 
 type CustomerDBFilter struct {
-	UserID []string `json:"user_id"`
+	UserID []uuid.UUID `json:"user_id"`
+	Search string      `json:"search"` // Case-insensitive substring of the name, email or phone
+}
+
+type FindAllCustomersResult struct {
+	Customers  []*Customer `json:"customers"`
+	Page       int64       `json:"page"`
+	PageSize   int64       `json:"page_size"`
+	PageCount  int64       `json:"page_count"`
+	TotalCount int64       `json:"total_count"`
 }
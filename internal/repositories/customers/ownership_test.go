@@ -0,0 +1,93 @@
+package customers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/shared"
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestRepositoryScopesEveryQueryToTheOwner(t *testing.T) {
+	owner := uuid.New()
+	customerID := uuid.New()
+	ownerCondition := `"customers"."user_id" = '` + owner.String() + `'`
+
+	testCases := []struct {
+		name string
+		run  func(ctx context.Context, repo *SQLRepository) error
+	}{
+		{
+			name: "get",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				_, err := repo.GetCustomerByID(ctx, customerID)
+				return err
+			},
+		},
+		{
+			name: "list",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				_, err := repo.ListCustomers(ctx, nil, shared.Sort{Column: "name"}, shared.Pagination{})
+				return err
+			},
+		},
+		{
+			name: "update",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				return repo.UpdateCustomer(ctx, customerID, &Customer{Name: "Acme"})
+			},
+		},
+		{
+			name: "delete",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				return repo.DeleteCustomer(ctx, customerID)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, recorder := sqltest.NewDryRunDB(t)
+
+			_ = tc.run(auth.WithPrincipal(context.Background(), &auth.Principal{UserID: owner}), NewSQLRepository(db))
+
+			require.NotEmpty(t, recorder.Statements())
+			for _, statement := range recorder.Statements() {
+				assert.Contains(t, statement, ownerCondition)
+			}
+		})
+
+		t.Run(tc.name+" without a user", func(t *testing.T) {
+			db, recorder := sqltest.NewDryRunDB(t)
+
+			err := tc.run(context.Background(), NewSQLRepository(db))
+
+			assert.ErrorIs(t, err, shared.ErrNoOwner)
+			assert.Empty(t, recorder.Statements())
+		})
+	}
+}
+
+func TestRepositoryMutationsOfOtherUsersCustomersAreNotFound(t *testing.T) {
+	db, _ := sqltest.NewDryRunDB(t)
+	repo := NewSQLRepository(db)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+
+	// The dry run database matches no rows, just like the scoped query on another user's customer.
+	assert.ErrorIs(t, repo.UpdateCustomer(ctx, uuid.New(), &Customer{Name: "Acme"}), ErrCustomerNotFound)
+	assert.ErrorIs(t, repo.DeleteCustomer(ctx, uuid.New()), ErrCustomerNotFound)
+}
+
+func TestCreateCustomerRejectsAnotherOwner(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+
+	_, err := NewSQLRepository(db).CreateCustomer(ctx, &DBCustomer{UserID: uuid.New(), Name: "Acme"})
+
+	assert.ErrorIs(t, err, shared.ErrNotOwner)
+	assert.Empty(t, recorder.Statements())
+}
@@ -3,20 +3,45 @@ package customers
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"invoice-backend/internal/shared"
 )
 
 const (
 	tableName = "customers"
+
+	uniqueViolationCode  = "23505"
+	emailUniqueIndexName = "idx_customers_user_email"
+	phoneUniqueIndexName = "idx_customers_user_phone"
 )
 
+var (
+	ErrCustomerNotFound = errors.New("no customer found with the given ID")
+	ErrDuplicateEmail   = errors.New("a customer with this email already exists")
+	ErrDuplicatePhone   = errors.New("a customer with this phone number already exists")
+)
+
+// Repository stores customers. Deleted customers are kept as soft-deleted rows: every method except
+// GetCustomerByIDWithDeleted and RestoreCustomer ignores them.
 type Repository interface {
 	CreateCustomer(ctx context.Context, customer *DBCustomer) (*Customer, error)
-	ListCustomers(ctx context.Context, filters *CustomerDBFilter) ([]*Customer, error)
+	ListCustomers(
+		ctx context.Context,
+		filters *CustomerDBFilter,
+		sort shared.Sort,
+		pagination shared.Pagination,
+	) (*FindAllCustomersResult, error)
 	GetCustomerByID(ctx context.Context, customerID uuid.UUID) (*Customer, error)
+	// GetCustomerByIDWithDeleted also finds deleted customers, for documents issued before the deletion.
+	GetCustomerByIDWithDeleted(ctx context.Context, customerID uuid.UUID) (*Customer, error)
 	UpdateCustomer(ctx context.Context, customerID uuid.UUID, updatedData *Customer) error
 	DeleteCustomer(ctx context.Context, customerID uuid.UUID) error
+	RestoreCustomer(ctx context.Context, customerID uuid.UUID) error
 }
 
 type SQLRepository struct {
@@ -28,54 +53,169 @@ func (s SQLRepository) CreateCustomer(ctx context.Context, customer *DBCustomer)
 		customer.ID = uuid.New()
 	}
 
+	err := shared.CheckOwner(ctx, customer.UserID)
+	if err != nil {
+		return nil, err
+	}
+
 	result := s.db.WithContext(ctx).Table(tableName).Create(customer)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, mapUniqueViolation(result.Error)
 	}
 
 	return FromDBCustomer(customer), nil
 }
 
-func (s SQLRepository) ListCustomers(ctx context.Context, filters *CustomerDBFilter) ([]*Customer, error) {
-	var customers []*Customer
-	query := s.db.WithContext(ctx)
+// ListCustomers returns a page of the customers matching the filters, together with the total number of matches.
+func (s SQLRepository) ListCustomers(
+	ctx context.Context,
+	filters *CustomerDBFilter,
+	sort shared.Sort,
+	pagination shared.Pagination,
+) (*FindAllCustomersResult, error) {
+	query := s.db.WithContext(ctx).Table(tableName).Model(&DBCustomer{}).Scopes(shared.OwnedBy(ctx))
 
 	if filters != nil && len(filters.UserID) > 0 {
-		query = query.Where("id IN ?", filters.UserID)
+		query = query.Where("user_id IN ?", filters.UserID)
+	}
+
+	if filters != nil && filters.Search != "" {
+		pattern := shared.ContainsPattern(filters.Search)
+		query = query.Where("name ILIKE ? OR email ILIKE ? OR phone ILIKE ?", pattern, pattern, pattern)
+	}
+
+	// The count and the page run as separate statements on the same conditions.
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, err
 	}
 
-	if err := query.Find(&customers).Error; err != nil {
+	customers := make([]*DBCustomer, 0)
+	if err := shared.PaginateDataset(shared.OrderDataset(query, sort), pagination).Find(&customers).Error; err != nil {
 		return nil, err
 	}
 
-	return customers, nil
+	return &FindAllCustomersResult{
+		Customers:  FromDBCustomerList(customers),
+		Page:       int64(pagination.PageNumber()),
+		PageSize:   int64(pagination.PageSize()),
+		PageCount:  pagination.PageCount(total),
+		TotalCount: total,
+	}, nil
 }
 
 func (s SQLRepository) GetCustomerByID(ctx context.Context, customerID uuid.UUID) (*Customer, error) {
-	var customer Customer
-	err := s.db.WithContext(ctx).First(&customer, "id = ?", customerID).Error
+	return s.getCustomer(ctx, s.db.WithContext(ctx), customerID)
+}
+
+func (s SQLRepository) GetCustomerByIDWithDeleted(ctx context.Context, customerID uuid.UUID) (*Customer, error) {
+	return s.getCustomer(ctx, s.db.WithContext(ctx).Unscoped(), customerID)
+}
+
+func (s SQLRepository) getCustomer(ctx context.Context, db *gorm.DB, customerID uuid.UUID) (*Customer, error) {
+	var customer DBCustomer
+	err := db.Table(tableName).Scopes(shared.OwnedBy(ctx)).First(&customer, "id = ?", customerID).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, nil
 	} else if err != nil {
 		return nil, err
 	}
 
-	return &customer, nil
+	return FromDBCustomer(&customer), nil
 }
 
+// UpdateCustomer stores the details, addresses, tax ID and contacts of the customer, empty values included.
 func (s SQLRepository) UpdateCustomer(ctx context.Context, customerID uuid.UUID, updatedData *Customer) error {
-	return s.db.WithContext(ctx).
-		Model(&Customer{}).
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Model(&DBCustomer{}).
+		Scopes(shared.OwnedBy(ctx)).
 		Where("id = ?", customerID).
-		Updates(updatedData).
-		Error
+		Updates(updateColumns(updatedData))
+	if result.Error != nil {
+		return mapUniqueViolation(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrCustomerNotFound
+	}
+	return nil
 }
 
 func (s SQLRepository) DeleteCustomer(ctx context.Context, customerID uuid.UUID) error {
-	return s.db.WithContext(ctx).
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
 		Where("id = ?", customerID).
-		Delete(&Customer{}).
-		Error
+		Delete(&DBCustomer{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrCustomerNotFound
+	}
+	return nil
+}
+
+// RestoreCustomer undeletes a customer. Restoring a customer that is not deleted succeeds. It fails with
+// ErrDuplicateEmail or ErrDuplicatePhone when another live customer took over its email or phone in the meantime.
+func (s SQLRepository) RestoreCustomer(ctx context.Context, customerID uuid.UUID) error {
+	result := s.db.WithContext(ctx).
+		Unscoped().
+		Table(tableName).
+		Model(&DBCustomer{}).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", customerID).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return mapUniqueViolation(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrCustomerNotFound
+	}
+	return nil
+}
+
+func updateColumns(customer *Customer) map[string]interface{} {
+	columns := map[string]interface{}{
+		"name":       customer.Name,
+		"email":      customer.Email,
+		"phone":      customer.Phone,
+		"tax_id":     customer.TaxID,
+		"contacts":   customer.Contacts,
+		"updated_at": time.Now(),
+	}
+
+	for prefix, address := range map[string]Address{
+		"billing_":  customer.BillingAddress,
+		"shipping_": customer.ShippingAddress,
+	} {
+		columns[prefix+"line1"] = address.Line1
+		columns[prefix+"line2"] = address.Line2
+		columns[prefix+"city"] = address.City
+		columns[prefix+"region"] = address.Region
+		columns[prefix+"postal_code"] = address.PostalCode
+		columns[prefix+"country"] = address.Country
+	}
+
+	return columns
+}
+
+func mapUniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolationCode {
+		return err
+	}
+
+	switch {
+	case strings.Contains(pgErr.ConstraintName, emailUniqueIndexName):
+		return ErrDuplicateEmail
+	case strings.Contains(pgErr.ConstraintName, phoneUniqueIndexName):
+		return ErrDuplicatePhone
+	default:
+		return err
+	}
 }
 
 func NewSQLRepository(db *gorm.DB) *SQLRepository {
@@ -0,0 +1,137 @@
+package customers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/shared"
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestDeleteCustomerIsSoft(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+
+	_ = NewSQLRepository(db).DeleteCustomer(ctx, uuid.New())
+
+	require.Len(t, recorder.Statements(), 1)
+	assert.Contains(t, recorder.Last(), `UPDATE "customers" SET "deleted_at"=`)
+	assert.Contains(t, recorder.Last(), `"customers"."deleted_at" IS NULL`)
+}
+
+func TestDeletedCustomersAreOnlyFoundWithDeleted(t *testing.T) {
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+
+	db, recorder := sqltest.NewDryRunDB(t)
+	_, _ = NewSQLRepository(db).GetCustomerByID(ctx, uuid.New())
+	assert.Contains(t, recorder.Last(), `"customers"."deleted_at" IS NULL`)
+
+	db, recorder = sqltest.NewDryRunDB(t)
+	_, _ = NewSQLRepository(db).GetCustomerByIDWithDeleted(ctx, uuid.New())
+	assert.NotContains(t, recorder.Last(), `deleted_at`)
+}
+
+func TestRestoreCustomerClearsDeletedAt(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+
+	err := NewSQLRepository(db).RestoreCustomer(ctx, uuid.New())
+
+	assert.ErrorIs(t, err, ErrCustomerNotFound)
+	require.Len(t, recorder.Statements(), 1)
+	assert.Contains(t, recorder.Last(), `SET "deleted_at"=NULL`)
+	assert.NotContains(t, recorder.Last(), `"deleted_at" IS NULL`)
+}
+
+func TestMapUniqueViolation(t *testing.T) {
+	other := errors.New("connection reset")
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{
+			name:     "email",
+			err:      &pgconn.PgError{Code: uniqueViolationCode, ConstraintName: emailUniqueIndexName},
+			expected: ErrDuplicateEmail,
+		},
+		{
+			name:     "phone",
+			err:      &pgconn.PgError{Code: uniqueViolationCode, ConstraintName: phoneUniqueIndexName},
+			expected: ErrDuplicatePhone,
+		},
+		{
+			name:     "other constraint",
+			err:      &pgconn.PgError{Code: uniqueViolationCode, ConstraintName: "customers_pkey"},
+			expected: nil,
+		},
+		{
+			name:     "other error",
+			err:      other,
+			expected: other,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := mapUniqueViolation(tc.err)
+
+			if tc.expected == nil {
+				assert.Same(t, tc.err, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestUpdateCustomerStoresAddressesAndContacts(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+
+	_ = NewSQLRepository(db).UpdateCustomer(ctx, uuid.New(), &Customer{
+		Name:            "Acme",
+		BillingAddress:  Address{Line1: "1 Main St", Country: "US"},
+		ShippingAddress: Address{Line1: "2 Dock Rd"},
+		Contacts:        Contacts{{Email: "ap@acme.test", CC: true}},
+	})
+
+	assert.Contains(t, recorder.Last(), `"billing_line1"='1 Main St'`)
+	assert.Contains(t, recorder.Last(), `"billing_country"='US'`)
+	assert.Contains(t, recorder.Last(), `"shipping_line1"='2 Dock Rd'`)
+	assert.Contains(t, recorder.Last(), `"contacts"='[{"name":"","email":"ap@acme.test","phone":"","cc":true}]'`)
+}
+
+func TestListCustomersFiltersSortsAndPaginates(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	owner := uuid.New()
+	other := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: owner})
+
+	result, err := NewSQLRepository(db).ListCustomers(
+		ctx,
+		&CustomerDBFilter{UserID: []uuid.UUID{other}, Search: "50%"},
+		shared.Sort{Column: "created_at", Descending: true},
+		shared.Pagination{Limit: lo.ToPtr(10), Page: lo.ToPtr(3)},
+	)
+	require.NoError(t, err)
+
+	conditions := `WHERE user_id IN ('` + other.String() + `') ` +
+		`AND (name ILIKE '%50\%%' OR email ILIKE '%50\%%' OR phone ILIKE '%50\%%') ` +
+		`AND "customers"."user_id" = '` + owner.String() + `' AND "customers"."deleted_at" IS NULL`
+
+	require.Len(t, recorder.Statements(), 2)
+	assert.Equal(t, `SELECT count(*) FROM "customers" `+conditions, recorder.Statements()[0])
+	assert.Equal(t, `SELECT * FROM "customers" `+conditions+
+		` ORDER BY "customers"."created_at" DESC,"customers"."id" DESC LIMIT 10 OFFSET 20`, recorder.Statements()[1])
+	assert.Equal(t, int64(3), result.Page)
+	assert.Equal(t, int64(10), result.PageSize)
+}
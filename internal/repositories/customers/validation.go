@@ -0,0 +1,93 @@
+package customers
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"invoice-backend/pkg/country"
+	"invoice-backend/pkg/taxid"
+)
+
+var (
+	ErrInvalidAddress = errors.New("invalid address")
+	ErrInvalidContact = errors.New("invalid contact")
+	ErrMissingCountry = errors.New("a tax ID requires a billing address country")
+)
+
+// Validate checks the addresses, contacts and tax ID of the customer. Country codes and the tax ID are normalised
+// in place, the tax ID is validated against the format of the billing country.
+func (c *Customer) Validate() error {
+	err := c.BillingAddress.normalise()
+	if err != nil {
+		return fmt.Errorf("billing address: %w", err)
+	}
+
+	err = c.ShippingAddress.normalise()
+	if err != nil {
+		return fmt.Errorf("shipping address: %w", err)
+	}
+
+	err = c.Contacts.validate()
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(c.TaxID) == "" {
+		c.TaxID = ""
+
+		return nil
+	}
+
+	if c.BillingAddress.Country == "" {
+		return ErrMissingCountry
+	}
+
+	c.TaxID, err = taxid.Validate(country.Code(c.BillingAddress.Country), c.TaxID)
+
+	return err
+}
+
+func (a *Address) normalise() error {
+	if a.IsZero() {
+		return nil
+	}
+
+	if strings.TrimSpace(a.Line1) == "" {
+		return fmt.Errorf("%w: line1 is required", ErrInvalidAddress)
+	}
+
+	if a.Country == "" {
+		return nil
+	}
+
+	code, err := country.Parse(a.Country)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidAddress, err)
+	}
+
+	a.Country = code.String()
+
+	return nil
+}
+
+func (c Contacts) validate() error {
+	seen := make(map[string]struct{}, len(c))
+
+	for i, contact := range c {
+		_, err := mail.ParseAddress(contact.Email)
+		if err != nil {
+			return fmt.Errorf("%w: contact %d has an invalid email", ErrInvalidContact, i+1)
+		}
+
+		email := strings.ToLower(contact.Email)
+		if _, ok := seen[email]; ok {
+			return fmt.Errorf("%w: %s is listed more than once", ErrInvalidContact, contact.Email)
+		}
+
+		seen[email] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package customers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"invoice-backend/pkg/country"
+	"invoice-backend/pkg/taxid"
+)
+
+func TestCustomerValidateNormalises(t *testing.T) {
+	customer := &Customer{
+		BillingAddress: Address{Line1: "Unter den Linden 1", City: "Berlin", PostalCode: "10117", Country: "de"},
+		TaxID:          "de 123 456 789",
+		Contacts:       Contacts{{Name: "Jane", Email: "jane@example.com", CC: true}},
+	}
+
+	assert.NoError(t, customer.Validate())
+	assert.Equal(t, "DE", customer.BillingAddress.Country)
+	assert.Equal(t, "DE123456789", customer.TaxID)
+}
+
+func TestCustomerValidateRejectsInvalidDetails(t *testing.T) {
+	germany := Address{Line1: "Unter den Linden 1", Country: "DE"}
+
+	testCases := []struct {
+		name     string
+		customer Customer
+		expected error
+	}{
+		{
+			name:     "address without line1",
+			customer: Customer{BillingAddress: Address{City: "Berlin"}},
+			expected: ErrInvalidAddress,
+		},
+		{
+			name:     "unknown country",
+			customer: Customer{ShippingAddress: Address{Line1: "1 Main St", Country: "XX"}},
+			expected: country.ErrUnknownCountry,
+		},
+		{
+			name:     "tax ID without country",
+			customer: Customer{TaxID: "DE123456789"},
+			expected: ErrMissingCountry,
+		},
+		{
+			name:     "tax ID of another country",
+			customer: Customer{BillingAddress: germany, TaxID: "FR40303265045"},
+			expected: taxid.ErrInvalidTaxID,
+		},
+		{
+			name:     "contact without email",
+			customer: Customer{Contacts: Contacts{{Name: "Jane"}}},
+			expected: ErrInvalidContact,
+		},
+		{
+			name: "duplicate contact",
+			customer: Customer{Contacts: Contacts{
+				{Email: "jane@example.com"},
+				{Email: "Jane@Example.com"},
+			}},
+			expected: ErrInvalidContact,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.customer.Validate(), tc.expected)
+		})
+	}
+}
+
+func TestAddressString(t *testing.T) {
+	address := Address{Line1: "1 Main St", Line2: "Suite 5", City: "Springfield", Region: "IL", PostalCode: "62701", Country: "US"}
+
+	assert.Equal(t, "1 Main St\nSuite 5\n62701 Springfield\nIL\nUS", address.String())
+	assert.Equal(t, "", Address{}.String())
+}
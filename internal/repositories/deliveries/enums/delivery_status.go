@@ -0,0 +1,6 @@
+package enums
+
+// DeliveryStatus ENUM(pending, sent, failed)
+//
+//go:generate go run github.com/abice/go-enum@v0.5.5
+type DeliveryStatus string
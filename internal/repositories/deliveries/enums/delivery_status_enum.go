@@ -0,0 +1,48 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package enums
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// DeliveryStatusPending is a DeliveryStatus of type pending.
+	DeliveryStatusPending DeliveryStatus = "pending"
+	// DeliveryStatusSent is a DeliveryStatus of type sent.
+	DeliveryStatusSent DeliveryStatus = "sent"
+	// DeliveryStatusFailed is a DeliveryStatus of type failed.
+	DeliveryStatusFailed DeliveryStatus = "failed"
+)
+
+var ErrInvalidDeliveryStatus = errors.New("not a valid DeliveryStatus")
+
+// String implements the Stringer interface.
+func (x DeliveryStatus) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x DeliveryStatus) IsValid() bool {
+	_, err := ParseDeliveryStatus(string(x))
+	return err == nil
+}
+
+var _DeliveryStatusValue = map[string]DeliveryStatus{
+	"pending": DeliveryStatusPending,
+	"sent":    DeliveryStatusSent,
+	"failed":  DeliveryStatusFailed,
+}
+
+// ParseDeliveryStatus attempts to convert a string to a DeliveryStatus.
+func ParseDeliveryStatus(name string) (DeliveryStatus, error) {
+	if x, ok := _DeliveryStatusValue[name]; ok {
+		return x, nil
+	}
+	return DeliveryStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidDeliveryStatus)
+}
@@ -0,0 +1,23 @@
+package deliveries
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"invoice-backend/internal/repositories/deliveries/enums"
+)
+
+// Delivery records one attempt at emailing a document to a customer.
+type Delivery struct {
+	ID        uuid.UUID            `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	InvoiceID uuid.UUID            `gorm:"type:uuid;not null"`
+	UserID    uuid.UUID            `gorm:"type:uuid;not null"`
+	Template  string               `gorm:"not null"` // Email template used, e.g. invoice
+	Recipient string               `gorm:"not null"`
+	Subject   string               `gorm:"not null"`
+	Status    enums.DeliveryStatus `gorm:"not null"`
+	Error     *string              // Transport error of failed deliveries
+	SentAt    *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
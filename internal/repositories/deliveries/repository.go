@@ -0,0 +1,100 @@
+package deliveries
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"invoice-backend/internal/repositories/deliveries/enums"
+	"invoice-backend/internal/shared"
+)
+
+const (
+	tableName = "deliveries"
+)
+
+var ErrDeliveryNotFound = errors.New("delivery not found")
+
+type Repository interface {
+	CreateDelivery(ctx context.Context, delivery *Delivery) (*Delivery, error)
+	MarkSent(ctx context.Context, deliveryID uuid.UUID, sentAt time.Time) error
+	MarkFailed(ctx context.Context, deliveryID uuid.UUID, reason string) error
+	ListDeliveriesByInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]*Delivery, error)
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) CreateDelivery(ctx context.Context, delivery *Delivery) (*Delivery, error) {
+	if delivery.ID == uuid.Nil {
+		delivery.ID = uuid.New()
+	}
+
+	err := shared.CheckOwner(ctx, delivery.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Table(tableName).Create(delivery).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (s *SQLRepository) MarkSent(ctx context.Context, deliveryID uuid.UUID, sentAt time.Time) error {
+	return s.updateStatus(ctx, deliveryID, map[string]interface{}{
+		"status":  enums.DeliveryStatusSent,
+		"sent_at": sentAt,
+		"error":   nil,
+	})
+}
+
+func (s *SQLRepository) MarkFailed(ctx context.Context, deliveryID uuid.UUID, reason string) error {
+	return s.updateStatus(ctx, deliveryID, map[string]interface{}{
+		"status": enums.DeliveryStatusFailed,
+		"error":  reason,
+	})
+}
+
+func (s *SQLRepository) updateStatus(ctx context.Context, deliveryID uuid.UUID, updates map[string]interface{}) error {
+	updates["updated_at"] = time.Now()
+
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", deliveryID).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrDeliveryNotFound
+	}
+
+	return nil
+}
+
+func (s *SQLRepository) ListDeliveriesByInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]*Delivery, error) {
+	list := make([]*Delivery, 0)
+
+	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("invoice_id = ?", invoiceID).
+		Order("created_at ASC").
+		Find(&list).Error
+
+	return list, err
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
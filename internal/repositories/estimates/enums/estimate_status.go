@@ -0,0 +1,6 @@
+package enums
+
+// EstimateStatus ENUM(DRAFT, SENT, ACCEPTED, DECLINED, EXPIRED)
+//
+//go:generate go run github.com/abice/go-enum@v0.5.5
+type EstimateStatus string
@@ -0,0 +1,54 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package enums
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// EstimateStatusDRAFT is a EstimateStatus of type DRAFT.
+	EstimateStatusDRAFT EstimateStatus = "DRAFT"
+	// EstimateStatusSENT is a EstimateStatus of type SENT.
+	EstimateStatusSENT EstimateStatus = "SENT"
+	// EstimateStatusACCEPTED is a EstimateStatus of type ACCEPTED.
+	EstimateStatusACCEPTED EstimateStatus = "ACCEPTED"
+	// EstimateStatusDECLINED is a EstimateStatus of type DECLINED.
+	EstimateStatusDECLINED EstimateStatus = "DECLINED"
+	// EstimateStatusEXPIRED is a EstimateStatus of type EXPIRED.
+	EstimateStatusEXPIRED EstimateStatus = "EXPIRED"
+)
+
+var ErrInvalidEstimateStatus = errors.New("not a valid EstimateStatus")
+
+// String implements the Stringer interface.
+func (x EstimateStatus) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x EstimateStatus) IsValid() bool {
+	_, err := ParseEstimateStatus(string(x))
+	return err == nil
+}
+
+var _EstimateStatusValue = map[string]EstimateStatus{
+	"DRAFT":    EstimateStatusDRAFT,
+	"SENT":     EstimateStatusSENT,
+	"ACCEPTED": EstimateStatusACCEPTED,
+	"DECLINED": EstimateStatusDECLINED,
+	"EXPIRED":  EstimateStatusEXPIRED,
+}
+
+// ParseEstimateStatus attempts to convert a string to a EstimateStatus.
+func ParseEstimateStatus(name string) (EstimateStatus, error) {
+	if x, ok := _EstimateStatusValue[name]; ok {
+		return x, nil
+	}
+	return EstimateStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidEstimateStatus)
+}
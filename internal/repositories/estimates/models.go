@@ -0,0 +1,80 @@
+package estimates
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"invoice-backend/internal/repositories/estimates/enums"
+	"invoice-backend/internal/repositories/invoicesitems"
+	itemEnums "invoice-backend/internal/repositories/invoicesitems/enums"
+	"invoice-backend/pkg/money"
+)
+
+// Estimate is a quote sent to a customer ahead of an invoice. Its lines and totals are priced like those of an
+// invoice; an accepted estimate is converted into a draft invoice carrying the same lines.
+type Estimate struct {
+	ID              uuid.UUID               `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID          uuid.UUID               `gorm:"type:uuid;not null"`
+	CustomerID      uuid.UUID               `gorm:"type:uuid;not null"`
+	EstimateNumber  string                  `gorm:"not null"`
+	Status          enums.EstimateStatus    `gorm:"not null"`
+	Currency        money.Currency          `gorm:"type:char(3);not null"`
+	IssueDate       time.Time               `gorm:"type:date;not null"`
+	ExpiryDate      time.Time               `gorm:"type:date;not null"` // Last day the customer may accept the estimate
+	DiscountType    *itemEnums.DiscountType `gorm:"type:varchar(20)"`
+	DiscountValue   decimal.Decimal         `gorm:"type:numeric(19,4);not null"`
+	DiscountAmount  decimal.Decimal         `gorm:"type:numeric(19,4);not null"` // Line discounts plus the estimate discount
+	ShippingAmount  decimal.Decimal         `gorm:"type:numeric(19,4);not null"`
+	Subtotal        decimal.Decimal         `gorm:"type:numeric(19,4);not null"`
+	TaxAmount       decimal.Decimal         `gorm:"type:numeric(19,4);not null"`
+	TotalAmount     decimal.Decimal         `gorm:"type:numeric(19,4);not null"`
+	Notes           string                  `gorm:"not null"`
+	Items           Items                   `gorm:"type:jsonb;not null"`
+	PublicTokenHash *string                 // Hash of the token of the customer link, set once the estimate is sent
+	RespondedAt     *time.Time              // When the customer accepted or declined the estimate
+	InvoiceID       *uuid.UUID              `gorm:"type:uuid"` // Invoice the estimate was converted into
+	CreatedAt       time.Time               `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time               `gorm:"autoUpdateTime"`
+}
+
+// Items are the lines of an estimate, stored as a JSON array. They use the invoice line model, InvoiceID excepted,
+// so that they are priced by invoices.CalculateTotals and copied as they are onto the converted invoice.
+type Items []*invoicesitems.InvoiceItem
+
+func (i Items) Value() (driver.Value, error) {
+	if i == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+func (i *Items) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(value), i)
+	case []byte:
+		return json.Unmarshal(value, i)
+	case nil:
+		*i = Items{}
+	default:
+		return fmt.Errorf("cannot scan %T into Items", src)
+	}
+
+	return nil
+}
+
+type EstimateDBFilter struct {
+	UserID     []*uuid.UUID            `json:"user_id,omitempty"`
+	CustomerID []*uuid.UUID            `json:"customer_id,omitempty"`
+	Status     []*enums.EstimateStatus `json:"status,omitempty"`
+}
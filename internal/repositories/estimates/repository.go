@@ -0,0 +1,197 @@
+package estimates
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"invoice-backend/internal/repositories/estimates/enums"
+	"invoice-backend/internal/shared"
+)
+
+const (
+	tableName = "estimates"
+)
+
+var ErrEstimateNotFound = errors.New("no estimate found with the given ID")
+
+type Repository interface {
+	// CreateEstimate stores the estimate; its number must already be allocated.
+	CreateEstimate(ctx context.Context, estimate *Estimate) (*Estimate, error)
+	GetEstimateByID(ctx context.Context, id uuid.UUID) (*Estimate, error)
+	// LockEstimate loads an estimate and locks its row until the surrounding transaction ends. It must be called
+	// on a repository bound to a transaction.
+	LockEstimate(ctx context.Context, id uuid.UUID) (*Estimate, error)
+	// LockEstimateByTokenHash is LockEstimate for the estimate a customer link points to.
+	LockEstimateByTokenHash(ctx context.Context, tokenHash string) (*Estimate, error)
+	GetEstimateByTokenHash(ctx context.Context, tokenHash string) (*Estimate, error)
+	ListEstimates(ctx context.Context, filters *EstimateDBFilter, pagination shared.Pagination) ([]*Estimate, error)
+	// UpdateEstimate persists the terms, lines, totals and state of an estimate.
+	UpdateEstimate(ctx context.Context, estimate *Estimate) error
+	DeleteEstimate(ctx context.Context, id uuid.UUID) error
+	// ExpireEstimates marks the sent estimates whose expiry date is before the given day as expired and returns
+	// how many were.
+	ExpireEstimates(ctx context.Context, today time.Time) (int64, error)
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) CreateEstimate(ctx context.Context, estimate *Estimate) (*Estimate, error) {
+	if estimate.ID == uuid.Nil {
+		estimate.ID = uuid.New()
+	}
+
+	err := shared.CheckOwner(ctx, estimate.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Table(tableName).Create(estimate).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return estimate, nil
+}
+
+func (s *SQLRepository) GetEstimateByID(ctx context.Context, id uuid.UUID) (*Estimate, error) {
+	return s.first(s.db.WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("id = ?", id))
+}
+
+func (s *SQLRepository) LockEstimate(ctx context.Context, id uuid.UUID) (*Estimate, error) {
+	return s.first(s.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", id))
+}
+
+func (s *SQLRepository) LockEstimateByTokenHash(ctx context.Context, tokenHash string) (*Estimate, error) {
+	return s.first(s.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("public_token_hash = ?", tokenHash))
+}
+
+func (s *SQLRepository) GetEstimateByTokenHash(ctx context.Context, tokenHash string) (*Estimate, error) {
+	return s.first(s.db.WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("public_token_hash = ?", tokenHash))
+}
+
+// first returns the estimate the query selects, nil when there is none.
+func (s *SQLRepository) first(query *gorm.DB) (*Estimate, error) {
+	var estimate Estimate
+
+	err := query.First(&estimate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &estimate, nil
+}
+
+func (s *SQLRepository) ListEstimates(
+	ctx context.Context,
+	filters *EstimateDBFilter,
+	pagination shared.Pagination,
+) ([]*Estimate, error) {
+	list := make([]*Estimate, 0)
+
+	dataset, err := shared.BuildDataset(ctx, s.db, tableName, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	paginatedDataset := shared.PaginateDataset(
+		dataset.Scopes(shared.OwnedBy(ctx)).Order("created_at DESC, id DESC"),
+		pagination,
+	)
+
+	err = paginatedDataset.Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (s *SQLRepository) UpdateEstimate(ctx context.Context, estimate *Estimate) error {
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", estimate.ID).
+		Updates(map[string]interface{}{
+			"customer_id":       estimate.CustomerID,
+			"status":            estimate.Status,
+			"currency":          estimate.Currency,
+			"issue_date":        estimate.IssueDate,
+			"expiry_date":       estimate.ExpiryDate,
+			"discount_type":     estimate.DiscountType,
+			"discount_value":    estimate.DiscountValue,
+			"discount_amount":   estimate.DiscountAmount,
+			"shipping_amount":   estimate.ShippingAmount,
+			"subtotal":          estimate.Subtotal,
+			"tax_amount":        estimate.TaxAmount,
+			"total_amount":      estimate.TotalAmount,
+			"notes":             estimate.Notes,
+			"items":             estimate.Items,
+			"public_token_hash": estimate.PublicTokenHash,
+			"responded_at":      estimate.RespondedAt,
+			"invoice_id":        estimate.InvoiceID,
+			"updated_at":        time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrEstimateNotFound
+	}
+
+	return nil
+}
+
+func (s *SQLRepository) DeleteEstimate(ctx context.Context, id uuid.UUID) error {
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", id).
+		Delete(&Estimate{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrEstimateNotFound
+	}
+
+	return nil
+}
+
+func (s *SQLRepository) ExpireEstimates(ctx context.Context, today time.Time) (int64, error) {
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("status = ? AND expiry_date < ?", enums.EstimateStatusSENT, day(today)).
+		Updates(map[string]interface{}{
+			"status":     enums.EstimateStatusEXPIRED,
+			"updated_at": time.Now(),
+		})
+
+	return result.RowsAffected, result.Error
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
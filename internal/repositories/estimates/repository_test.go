@@ -0,0 +1,45 @@
+package estimates
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestExpireEstimatesOnlyExpiresSentEstimates(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+
+	_, err := NewSQLRepository(db).ExpireEstimates(auth.AsSystem(context.Background()), today)
+	require.NoError(t, err)
+
+	assert.Contains(t, recorder.Last(), `UPDATE "estimates" SET "status"='EXPIRED'`)
+	assert.Contains(t, recorder.Last(), `status = 'SENT' AND expiry_date < '2026-10-18 00:00:00'`)
+}
+
+func TestLockEstimateByTokenHashLocksTheRow(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+
+	_, err := NewSQLRepository(db).LockEstimateByTokenHash(auth.AsSystem(context.Background()), "hash")
+	require.NoError(t, err)
+
+	assert.Contains(t, recorder.Last(), `public_token_hash = 'hash'`)
+	assert.Contains(t, recorder.Last(), `FOR UPDATE`)
+}
+
+func TestUpdateEstimateIsScopedToTheOwner(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	userID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: userID})
+
+	err := NewSQLRepository(db).UpdateEstimate(ctx, &Estimate{ID: uuid.New(), UserID: userID})
+
+	assert.ErrorIs(t, err, ErrEstimateNotFound, "the dry run database affects no rows")
+	assert.Contains(t, recorder.Last(), `UPDATE "estimates" SET`)
+	assert.Contains(t, recorder.Last(), `"items"='[]'`)
+	assert.Contains(t, recorder.Last(), `"estimates"."user_id" = '`+userID.String()+`'`)
+}
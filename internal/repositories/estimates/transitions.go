@@ -0,0 +1,113 @@
+package estimates
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"invoice-backend/internal/repositories/estimates/enums"
+)
+
+var (
+	ErrInvalidEstimate         = errors.New("invalid estimate")
+	ErrInvalidStatusTransition = errors.New("invalid estimate status transition")
+	ErrEstimateExpired         = errors.New("estimate has expired")
+	ErrEstimateConverted       = errors.New("estimate was already converted into an invoice")
+)
+
+// statusTransitions lists, for every status, the statuses an estimate may move to next. Drafts may be accepted
+// directly when the customer agreed outside of the estimate link. Statuses mapping to an empty list are terminal.
+var statusTransitions = map[enums.EstimateStatus][]enums.EstimateStatus{
+	enums.EstimateStatusDRAFT: {
+		enums.EstimateStatusSENT,
+		enums.EstimateStatusACCEPTED,
+	},
+	enums.EstimateStatusSENT: {
+		enums.EstimateStatusACCEPTED,
+		enums.EstimateStatusDECLINED,
+		enums.EstimateStatusEXPIRED,
+	},
+	enums.EstimateStatusACCEPTED: {},
+	enums.EstimateStatusDECLINED: {},
+	enums.EstimateStatusEXPIRED:  {},
+}
+
+func CanTransition(from, to enums.EstimateStatus) bool {
+	return lo.Contains(statusTransitions[from], to)
+}
+
+func ValidateTransition(from, to enums.EstimateStatus) error {
+	if !CanTransition(from, to) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidStatusTransition, from, to)
+	}
+
+	return nil
+}
+
+// Validate checks that the estimate does not expire before it is issued.
+func (e *Estimate) Validate() error {
+	if e.ExpiryDate.Before(day(e.IssueDate)) {
+		return fmt.Errorf("%w: expiry date is before the issue date", ErrInvalidEstimate)
+	}
+
+	return nil
+}
+
+// IsEditable reports whether the lines and terms of an estimate may still change, which is only the case before
+// it is sent.
+func (e *Estimate) IsEditable() bool {
+	return e.Status == enums.EstimateStatusDRAFT
+}
+
+// IsExpired reports whether the expiry date of the estimate is before the given day.
+func (e *Estimate) IsExpired(today time.Time) bool {
+	return e.ExpiryDate.Before(day(today))
+}
+
+// Respond records the answer of the customer to a sent estimate. An estimate past its expiry date fails with
+// ErrEstimateExpired and must be marked expired instead.
+func (e *Estimate) Respond(status enums.EstimateStatus, now time.Time) error {
+	if e.Status == enums.EstimateStatusSENT && e.IsExpired(now) {
+		return ErrEstimateExpired
+	}
+
+	if status != enums.EstimateStatusACCEPTED && status != enums.EstimateStatusDECLINED {
+		return fmt.Errorf("%w: %s is not a response", ErrInvalidStatusTransition, status)
+	}
+
+	err := ValidateTransition(e.Status, status)
+	if err != nil {
+		return err
+	}
+
+	e.Status = status
+	e.RespondedAt = lo.ToPtr(now)
+
+	return nil
+}
+
+// CheckConvertible fails unless the estimate can be converted into an invoice: it must not have been converted
+// already, declined or expired. Converting accepts drafts and sent estimates on behalf of the customer.
+func (e *Estimate) CheckConvertible(today time.Time) error {
+	if e.InvoiceID != nil {
+		return ErrEstimateConverted
+	}
+
+	if e.Status == enums.EstimateStatusACCEPTED {
+		return nil
+	}
+
+	if e.Status == enums.EstimateStatusSENT && e.IsExpired(today) {
+		return ErrEstimateExpired
+	}
+
+	return ValidateTransition(e.Status, enums.EstimateStatusACCEPTED)
+}
+
+// day truncates t to its calendar day in UTC, the time zone dates are stored in.
+func day(t time.Time) time.Time {
+	year, month, dayOfMonth := t.UTC().Date()
+
+	return time.Date(year, month, dayOfMonth, 0, 0, 0, 0, time.UTC)
+}
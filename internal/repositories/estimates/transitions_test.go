@@ -0,0 +1,87 @@
+package estimates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"invoice-backend/internal/repositories/estimates/enums"
+)
+
+var today = time.Date(2026, time.October, 18, 15, 30, 0, 0, time.UTC)
+
+func TestRespond(t *testing.T) {
+	testCases := []struct {
+		name       string
+		status     enums.EstimateStatus
+		expiryDate time.Time
+		response   enums.EstimateStatus
+		err        error
+	}{
+		{name: "sent estimate is accepted", status: enums.EstimateStatusSENT, expiryDate: today, response: enums.EstimateStatusACCEPTED},
+		{name: "sent estimate is declined", status: enums.EstimateStatusSENT, expiryDate: today, response: enums.EstimateStatusDECLINED},
+		{name: "expired estimate cannot be accepted", status: enums.EstimateStatusSENT, expiryDate: today.AddDate(0, 0, -1), response: enums.EstimateStatusACCEPTED, err: ErrEstimateExpired},
+		{name: "declined estimate cannot be accepted", status: enums.EstimateStatusDECLINED, expiryDate: today, response: enums.EstimateStatusACCEPTED, err: ErrInvalidStatusTransition},
+		{name: "accepted estimate cannot be declined", status: enums.EstimateStatusACCEPTED, expiryDate: today, response: enums.EstimateStatusDECLINED, err: ErrInvalidStatusTransition},
+		{name: "expiring is not a response", status: enums.EstimateStatusSENT, expiryDate: today, response: enums.EstimateStatusEXPIRED, err: ErrInvalidStatusTransition},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			estimate := &Estimate{Status: tc.status, ExpiryDate: tc.expiryDate}
+
+			err := estimate.Respond(tc.response, today)
+
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				assert.Equal(t, tc.status, estimate.Status)
+				assert.Nil(t, estimate.RespondedAt)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.response, estimate.Status)
+			assert.Equal(t, lo.ToPtr(today), estimate.RespondedAt)
+		})
+	}
+}
+
+func TestCheckConvertible(t *testing.T) {
+	testCases := []struct {
+		name      string
+		status    enums.EstimateStatus
+		invoiceID *uuid.UUID
+		err       error
+	}{
+		{name: "draft is converted", status: enums.EstimateStatusDRAFT},
+		{name: "sent estimate is converted", status: enums.EstimateStatusSENT},
+		{name: "accepted estimate is converted", status: enums.EstimateStatusACCEPTED},
+		{name: "converted estimate cannot be converted again", status: enums.EstimateStatusACCEPTED, invoiceID: lo.ToPtr(uuid.New()), err: ErrEstimateConverted},
+		{name: "declined estimate cannot be converted", status: enums.EstimateStatusDECLINED, err: ErrInvalidStatusTransition},
+		{name: "expired estimate cannot be converted", status: enums.EstimateStatusEXPIRED, err: ErrInvalidStatusTransition},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			estimate := &Estimate{Status: tc.status, ExpiryDate: today, InvoiceID: tc.invoiceID}
+
+			err := estimate.CheckConvertible(today)
+
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsExpiredComparesCalendarDays(t *testing.T) {
+	estimate := &Estimate{ExpiryDate: time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)}
+
+	assert.False(t, estimate.IsExpired(today), "the estimate can be accepted until the end of its expiry date")
+	assert.True(t, estimate.IsExpired(today.AddDate(0, 0, 1)))
+}
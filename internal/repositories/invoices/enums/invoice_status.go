@@ -1,6 +1,6 @@
 package enums
 
-// InvoiceStatus ENUM(PENDING_PAYMENT, DRAFT, OVERDUE, PAID)
+// InvoiceStatus ENUM(PENDING_PAYMENT, DRAFT, OVERDUE, PARTIALLY_PAID, PAID, VOID, CANCELLED)
 //
 //go:generate go run github.com/abice/go-enum@v0.5.5
 type InvoiceStatus string
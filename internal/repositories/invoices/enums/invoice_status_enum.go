@@ -18,8 +18,14 @@ const (
 	InvoiceStatusDRAFT InvoiceStatus = "DRAFT"
 	// InvoiceStatusOVERDUE is a InvoiceStatus of type OVERDUE.
 	InvoiceStatusOVERDUE InvoiceStatus = "OVERDUE"
+	// InvoiceStatusPARTIALLYPAID is a InvoiceStatus of type PARTIALLY_PAID.
+	InvoiceStatusPARTIALLYPAID InvoiceStatus = "PARTIALLY_PAID"
 	// InvoiceStatusPAID is a InvoiceStatus of type PAID.
 	InvoiceStatusPAID InvoiceStatus = "PAID"
+	// InvoiceStatusVOID is a InvoiceStatus of type VOID.
+	InvoiceStatusVOID InvoiceStatus = "VOID"
+	// InvoiceStatusCANCELLED is a InvoiceStatus of type CANCELLED.
+	InvoiceStatusCANCELLED InvoiceStatus = "CANCELLED"
 )
 
 var ErrInvalidInvoiceStatus = errors.New("not a valid InvoiceStatus")
@@ -39,7 +45,10 @@ var _InvoiceStatusValue = map[string]InvoiceStatus{
 	"PENDING_PAYMENT": InvoiceStatusPENDINGPAYMENT,
 	"DRAFT":           InvoiceStatusDRAFT,
 	"OVERDUE":         InvoiceStatusOVERDUE,
+	"PARTIALLY_PAID":  InvoiceStatusPARTIALLYPAID,
 	"PAID":            InvoiceStatusPAID,
+	"VOID":            InvoiceStatusVOID,
+	"CANCELLED":       InvoiceStatusCANCELLED,
 }
 
 // ParseInvoiceStatus attempts to convert a string to a InvoiceStatus.
@@ -0,0 +1,79 @@
+package invoices
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"invoice-backend/internal/repositories/invoices/enums"
+	"invoice-backend/internal/shared"
+)
+
+const (
+	customersTableName    = "customers"
+	invoiceItemsTableName = "invoice_items"
+)
+
+// SortColumns are the columns invoices can be listed by.
+var SortColumns = []string{"created_at", "issue_date", "due_date", "total_amount", "invoice_number"}
+
+// filterInvoices applies the filters that shared.FilterDataset cannot express.
+func filterInvoices(dataset *gorm.DB, filters *InvoiceDBFilter) *gorm.DB {
+	if filters == nil {
+		return dataset
+	}
+
+	dataset = filterDateRange(dataset, "issue_date", filters.IssueDateFrom, filters.IssueDateTo)
+	dataset = filterDateRange(dataset, "due_date", filters.DueDateFrom, filters.DueDateTo)
+
+	if filters.MinTotal != nil {
+		dataset = dataset.Where("invoices.total_amount >= ?", *filters.MinTotal)
+	}
+
+	if filters.MaxTotal != nil {
+		dataset = dataset.Where("invoices.total_amount <= ?", *filters.MaxTotal)
+	}
+
+	if filters.Overdue {
+		dataset = dataset.Where(
+			"invoices.status = ? OR (invoices.status IN ? AND invoices.due_date < CURRENT_DATE)",
+			enums.InvoiceStatusOVERDUE,
+			dueStatuses,
+		)
+	}
+
+	if filters.Search != "" {
+		pattern := shared.ContainsPattern(filters.Search)
+
+		dataset = dataset.Where(
+			"invoices.invoice_number ILIKE ? OR invoices.customer_id IN (?) OR invoices.id IN (?)",
+			pattern,
+			dataset.Session(&gorm.Session{NewDB: true}).
+				Table(customersTableName).
+				Select("id").
+				Where("name ILIKE ?", pattern),
+			dataset.Session(&gorm.Session{NewDB: true}).
+				Table(invoiceItemsTableName).
+				Select("invoice_id").
+				Where("description ILIKE ?", pattern),
+		)
+	}
+
+	return dataset
+}
+
+// filterDateRange keeps the rows whose column falls on a day between from and to, both included.
+func filterDateRange(dataset *gorm.DB, column string, from, to *time.Time) *gorm.DB {
+	if from != nil {
+		dataset = dataset.Where("invoices."+column+" >= ?", startOfDay(*from))
+	}
+
+	if to != nil {
+		dataset = dataset.Where("invoices."+column+" < ?", startOfDay(*to).AddDate(0, 0, 1))
+	}
+
+	return dataset
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
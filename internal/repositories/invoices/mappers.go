@@ -4,16 +4,29 @@ import "github.com/samber/lo"
 
 func FromDBInvoice(dbInvoice *DBInvoice) *Invoice {
 	return &Invoice{
-		ID:            dbInvoice.ID,
-		CustomerID:    dbInvoice.CustomerID,
-		InvoiceNumber: dbInvoice.InvoiceNumber,
-		Status:        dbInvoice.Status,
-		TotalAmount:   dbInvoice.TotalAmount,
-		DueDate:       dbInvoice.DueDate,
-		IssueDate:     dbInvoice.IssueDate,
-		Items:         dbInvoice.Items,
-		CreatedAt:     dbInvoice.CreatedAt,
-		UpdatedAt:     dbInvoice.UpdatedAt,
+		ID:             dbInvoice.ID,
+		CustomerID:     dbInvoice.CustomerID,
+		UserID:         dbInvoice.UserID,
+		InvoiceNumber:  dbInvoice.InvoiceNumber,
+		Status:         dbInvoice.Status,
+		Currency:       dbInvoice.Currency,
+		Subtotal:       dbInvoice.Subtotal,
+		DiscountType:   dbInvoice.DiscountType,
+		DiscountValue:  dbInvoice.DiscountValue,
+		DiscountAmount: dbInvoice.DiscountAmount,
+		ShippingAmount: dbInvoice.ShippingAmount,
+		TaxAmount:      dbInvoice.TaxAmount,
+		TotalAmount:    dbInvoice.TotalAmount,
+		AmountPaid:     dbInvoice.AmountPaid,
+		AmountCredited: dbInvoice.AmountCredited,
+		AmountDue:      dbInvoice.AmountDue,
+		DueDate:        dbInvoice.DueDate,
+		IssueDate:      dbInvoice.IssueDate,
+		RecurringID:    dbInvoice.RecurringID,
+		EstimateID:     dbInvoice.EstimateID,
+		Items:          dbInvoice.Items,
+		CreatedAt:      dbInvoice.CreatedAt,
+		UpdatedAt:      dbInvoice.UpdatedAt,
 	}
 }
 
@@ -22,3 +35,30 @@ func FromDBInvoiceList(dbInvoices []*DBInvoice) []*Invoice {
 		return FromDBInvoice(invoice)
 	})
 }
+
+func ToDBInvoice(invoice *Invoice) *DBInvoice {
+	return &DBInvoice{
+		ID:             invoice.ID,
+		CustomerID:     invoice.CustomerID,
+		UserID:         invoice.UserID,
+		InvoiceNumber:  invoice.InvoiceNumber,
+		Status:         invoice.Status,
+		Currency:       invoice.Currency,
+		Subtotal:       invoice.Subtotal,
+		DiscountType:   invoice.DiscountType,
+		DiscountValue:  invoice.DiscountValue,
+		DiscountAmount: invoice.DiscountAmount,
+		ShippingAmount: invoice.ShippingAmount,
+		TaxAmount:      invoice.TaxAmount,
+		TotalAmount:    invoice.TotalAmount,
+		AmountPaid:     invoice.AmountPaid,
+		AmountCredited: invoice.AmountCredited,
+		AmountDue:      invoice.AmountDue,
+		DueDate:        invoice.DueDate,
+		IssueDate:      invoice.IssueDate,
+		RecurringID:    invoice.RecurringID,
+		EstimateID:     invoice.EstimateID,
+		CreatedAt:      invoice.CreatedAt,
+		UpdatedAt:      invoice.UpdatedAt,
+	}
+}
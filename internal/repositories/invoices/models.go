@@ -2,37 +2,65 @@ package invoices
 
 import (
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"invoice-backend/internal/repositories/invoices/enums"
 	"invoice-backend/internal/repositories/invoicesitems"
+	itemEnums "invoice-backend/internal/repositories/invoicesitems/enums"
+	"invoice-backend/internal/shared"
+	"invoice-backend/pkg/money"
 	"time"
 )
 
 type DBInvoice struct {
-	ID            uuid.UUID                    `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
-	CustomerID    uuid.UUID                    `json:"customer_id" gorm:"not null"`
-	UserID        uuid.UUID                    `json:"user_id" gorm:"not null"`
-	InvoiceNumber string                       `json:"invoice_number" gorm:"not null;unique"`
-	Status        enums.InvoiceStatus          `json:"status" gorm:"not null"` // e.g., Paid, Overdue, Draft
-	TotalAmount   float64                      `json:"total_amount" gorm:"not null"`
-	DueDate       time.Time                    `json:"due_date" gorm:"not null"`
-	IssueDate     time.Time                    `json:"issue_date" gorm:"not null"`
-	Items         []*invoicesitems.InvoiceItem `json:"items" gorm:"foreignKey:Invoice_id"` // One-to-Many relationship
-	CreatedAt     time.Time                    `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt     time.Time                    `json:"updated_at" gorm:"autoUpdateTime"`
+	ID             uuid.UUID                    `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	CustomerID     uuid.UUID                    `json:"customer_id" gorm:"not null"`
+	UserID         uuid.UUID                    `json:"user_id" gorm:"not null"`
+	InvoiceNumber  string                       `json:"invoice_number" gorm:"not null"`
+	Status         enums.InvoiceStatus          `json:"status" gorm:"not null"` // e.g., Paid, Overdue, Draft
+	Currency       money.Currency               `json:"currency" gorm:"type:char(3);not null"`
+	Subtotal       decimal.Decimal              `json:"subtotal" gorm:"type:numeric(19,4);not null"`
+	DiscountType   *itemEnums.DiscountType      `json:"discount_type" gorm:"type:varchar(20)"`
+	DiscountValue  decimal.Decimal              `json:"discount_value" gorm:"type:numeric(19,4);not null"`
+	DiscountAmount decimal.Decimal              `json:"discount_amount" gorm:"type:numeric(19,4);not null"` // Line discounts plus the invoice discount
+	ShippingAmount decimal.Decimal              `json:"shipping_amount" gorm:"type:numeric(19,4);not null"`
+	TaxAmount      decimal.Decimal              `json:"tax_amount" gorm:"type:numeric(19,4);not null"`
+	TotalAmount    decimal.Decimal              `json:"total_amount" gorm:"type:numeric(19,4);not null"`
+	AmountPaid     decimal.Decimal              `json:"amount_paid" gorm:"type:numeric(19,4);not null"`     // Payments minus refunds
+	AmountCredited decimal.Decimal              `json:"amount_credited" gorm:"type:numeric(19,4);not null"` // Sum of the credit notes
+	AmountDue      decimal.Decimal              `json:"amount_due" gorm:"type:numeric(19,4);not null"`      // TotalAmount - AmountPaid - AmountCredited
+	DueDate        time.Time                    `json:"due_date" gorm:"not null"`
+	IssueDate      time.Time                    `json:"issue_date" gorm:"not null"`
+	RecurringID    *uuid.UUID                   `json:"recurring_invoice_id" gorm:"column:recurring_invoice_id;type:uuid"`
+	EstimateID     *uuid.UUID                   `json:"estimate_id" gorm:"type:uuid"`      // Estimate the invoice was converted from
+	Items          []*invoicesitems.InvoiceItem `json:"items" gorm:"foreignKey:InvoiceID"` // One-to-Many relationship
+	CreatedAt      time.Time                    `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time                    `json:"updated_at" gorm:"autoUpdateTime"`
 }
 
 type Invoice struct {
-	ID            uuid.UUID                    `json:"id"`
-	CustomerID    uuid.UUID                    `json:"customer_id"`
-	UserID        uuid.UUID                    `json:"user_id"`
-	InvoiceNumber string                       `json:"invoice_number"`
-	Status        enums.InvoiceStatus          `json:"status"` // e.g., Paid, Overdue, Draft
-	TotalAmount   float64                      `json:"total_amount"`
-	DueDate       time.Time                    `json:"due_date"`
-	IssueDate     time.Time                    `json:"issue_date"`
-	Items         []*invoicesitems.InvoiceItem `json:"items"` //One-to-Many relationship
-	CreatedAt     time.Time                    `json:"created_at"`
-	UpdatedAt     time.Time                    `json:"updated_at"`
+	ID             uuid.UUID                    `json:"id"`
+	CustomerID     uuid.UUID                    `json:"customer_id"`
+	UserID         uuid.UUID                    `json:"user_id"`
+	InvoiceNumber  string                       `json:"invoice_number"`
+	Status         enums.InvoiceStatus          `json:"status"` // e.g., Paid, Overdue, Draft
+	Currency       money.Currency               `json:"currency"`
+	Subtotal       decimal.Decimal              `json:"subtotal"`
+	DiscountType   *itemEnums.DiscountType      `json:"discount_type"`
+	DiscountValue  decimal.Decimal              `json:"discount_value"`
+	DiscountAmount decimal.Decimal              `json:"discount_amount"`
+	ShippingAmount decimal.Decimal              `json:"shipping_amount"`
+	TaxAmount      decimal.Decimal              `json:"tax_amount"`
+	TotalAmount    decimal.Decimal              `json:"total_amount"`
+	AmountPaid     decimal.Decimal              `json:"amount_paid"`
+	AmountCredited decimal.Decimal              `json:"amount_credited"`
+	AmountDue      decimal.Decimal              `json:"amount_due"`
+	DueDate        time.Time                    `json:"due_date"`
+	IssueDate      time.Time                    `json:"issue_date"`
+	RecurringID    *uuid.UUID                   `json:"recurring_invoice_id"`
+	EstimateID     *uuid.UUID                   `json:"estimate_id"`
+	Items          []*invoicesitems.InvoiceItem `json:"items"` //One-to-Many relationship
+	CreatedAt      time.Time                    `json:"created_at"`
+	UpdatedAt      time.Time                    `json:"updated_at"`
 }
 
 type InvoiceDBFilter struct {
@@ -41,12 +69,29 @@ type InvoiceDBFilter struct {
 	ID            []*uuid.UUID           `json:"id,omitempty"`
 	InvoiceNumber []*string              `json:"invoice_number,omitempty"`
 	Status        []*enums.InvoiceStatus `json:"status,omitempty"`
+	RecurringID   []*uuid.UUID           `json:"recurring_invoice_id,omitempty"`
+	CreatedAfter  *string                `json:"created_after,omitempty"`  // YYYY-MM-DD, exclusive
+	CreatedBefore *string                `json:"created_before,omitempty"` // YYYY-MM-DD, exclusive
+
+	// The filters below are applied by ListInvoices rather than shared.FilterDataset. Date bounds are inclusive
+	// and compare calendar days.
+	IssueDateFrom *time.Time       `json:"-"`
+	IssueDateTo   *time.Time       `json:"-"`
+	DueDateFrom   *time.Time       `json:"-"`
+	DueDateTo     *time.Time       `json:"-"`
+	MinTotal      *decimal.Decimal `json:"-"`
+	MaxTotal      *decimal.Decimal `json:"-"`
+	Overdue       bool             `json:"-"` // Overdue, or open and past the due date
+	Search        string           `json:"-"` // Case-insensitive substring of the number, customer name or an item description
 }
 
+// FindAllInvoicesResult is a page of invoices. Page, PageCount and TotalCount are only set when paginating by
+// page number; NextCursor is set whenever another page follows.
 type FindAllInvoicesResult struct {
-	Accounts   []*Invoice `json:"accounts"`
-	Page       int64      `json:"page"`
-	PageSize   int64      `json:"page_size"`
-	PageCount  int64      `json:"page_count"`
-	TotalCount int64      `json:"total_count"`
+	Invoices   []*Invoice     `json:"invoices"`
+	Page       int64          `json:"page"`
+	PageSize   int64          `json:"page_size"`
+	PageCount  int64          `json:"page_count"`
+	TotalCount int64          `json:"total_count"`
+	NextCursor *shared.Cursor `json:"next_cursor"`
 }
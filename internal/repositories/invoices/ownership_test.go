@@ -0,0 +1,131 @@
+package invoices
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/repositories/invoices/enums"
+	"invoice-backend/internal/shared"
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestRepositoryScopesEveryQueryToTheOwner(t *testing.T) {
+	owner := uuid.New()
+	invoiceID := uuid.New()
+	ownerCondition := `"invoices"."user_id" = '` + owner.String() + `'`
+
+	testCases := []struct {
+		name string
+		run  func(ctx context.Context, repo *SQLRepository) error
+	}{
+		{
+			name: "get",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				_, err := repo.GetInvoiceByID(ctx, invoiceID)
+				return err
+			},
+		},
+		{
+			name: "lock",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				_, err := repo.LockInvoice(ctx, invoiceID)
+				return err
+			},
+		},
+		{
+			name: "list",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				_, err := repo.ListInvoices(ctx, nil, shared.CursorOrder, shared.Pagination{})
+				return err
+			},
+		},
+		{
+			name: "list filtered by another user",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				other := uuid.New()
+				_, err := repo.ListInvoices(ctx, &InvoiceDBFilter{UserID: []*uuid.UUID{&other}}, shared.CursorOrder, shared.Pagination{})
+				return err
+			},
+		},
+		{
+			name: "update",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				return repo.UpdateInvoice(ctx, &Invoice{ID: invoiceID, UserID: owner})
+			},
+		},
+		{
+			name: "update totals",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				return repo.UpdateTotals(ctx, invoiceID, Totals{})
+			},
+		},
+		{
+			name: "transition status",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				return repo.TransitionInvoiceStatus(ctx, invoiceID, enums.InvoiceStatusDRAFT, enums.InvoiceStatusPENDINGPAYMENT)
+			},
+		},
+		{
+			name: "update balance",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				return repo.UpdateBalance(ctx, invoiceID, enums.InvoiceStatusPAID, decimal.Zero, decimal.Zero, decimal.Zero)
+			},
+		},
+		{
+			name: "delete",
+			run: func(ctx context.Context, repo *SQLRepository) error {
+				return repo.DeleteInvoice(ctx, invoiceID)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, recorder := sqltest.NewDryRunDB(t)
+			repo := NewSQLRepository(db)
+
+			_ = tc.run(auth.WithPrincipal(context.Background(), &auth.Principal{UserID: owner}), repo)
+
+			require.NotEmpty(t, recorder.Statements())
+			for _, statement := range recorder.Statements() {
+				assert.Contains(t, statement, ownerCondition)
+			}
+		})
+
+		t.Run(tc.name+" without a user", func(t *testing.T) {
+			db, recorder := sqltest.NewDryRunDB(t)
+
+			err := tc.run(context.Background(), NewSQLRepository(db))
+
+			assert.ErrorIs(t, err, shared.ErrNoOwner)
+			assert.Empty(t, recorder.Statements())
+		})
+	}
+}
+
+func TestRepositoryMutationsOfOtherUsersInvoicesAreNotFound(t *testing.T) {
+	db, _ := sqltest.NewDryRunDB(t)
+	repo := NewSQLRepository(db)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+
+	// The dry run database matches no rows, just like the scoped query on another user's invoice.
+	assert.ErrorIs(t, repo.UpdateInvoice(ctx, &Invoice{ID: uuid.New()}), ErrInvoiceNotFound)
+	assert.ErrorIs(t, repo.UpdateBalance(ctx, uuid.New(), enums.InvoiceStatusPAID, decimal.Zero, decimal.Zero, decimal.Zero), ErrInvoiceNotFound)
+	assert.ErrorIs(t, repo.DeleteInvoice(ctx, uuid.New()), ErrInvoiceNotFound)
+}
+
+func TestCreateInvoiceRejectsAnotherOwner(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	repo := NewSQLRepository(db)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+
+	_, err := repo.CreateInvoice(ctx, &DBInvoice{UserID: uuid.New()})
+
+	assert.ErrorIs(t, err, shared.ErrNotOwner)
+	assert.Empty(t, recorder.Statements())
+}
@@ -3,48 +3,85 @@ package invoices
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/plugin/dbresolver"
+	"invoice-backend/internal/repositories/invoices/enums"
 	"invoice-backend/internal/shared"
+	"strings"
 	"time"
 )
 
 const (
 	tableName = "invoices"
+
+	uniqueViolationCode          = "23505"
+	invoiceNumberUniqueIndexName = "invoices_user_id_invoice_number_key"
+)
+
+var (
+	ErrInvoiceNotFound = errors.New("no invoice found with the given ID")
+	// ErrDuplicateInvoiceNumber is returned when the numbering settings hand out a number that was already issued,
+	// typically after a change of template or reset policy restarted the sequence. The failed creation rolls the
+	// sequence back, so the settings have to be changed again.
+	ErrDuplicateInvoiceNumber = errors.New("invoice number was already issued, change the invoice numbering settings")
 )
 
 type Repository interface {
 	CreateInvoice(ctx context.Context, invoice *DBInvoice) (*Invoice, error)
 	GetInvoiceByID(ctx context.Context, id uuid.UUID) (*Invoice, error)
+	LockInvoice(ctx context.Context, id uuid.UUID) (*Invoice, error)
 	UpdateInvoice(ctx context.Context, invoice *Invoice) error
+	UpdateTotals(ctx context.Context, id uuid.UUID, totals Totals) error
+	TransitionInvoiceStatus(ctx context.Context, id uuid.UUID, from, to enums.InvoiceStatus) error
+	UpdateBalance(ctx context.Context, id uuid.UUID, status enums.InvoiceStatus, amountPaid, amountCredited, amountDue decimal.Decimal) error
 	DeleteInvoice(ctx context.Context, id uuid.UUID) error
-	ListInvoices(ctx context.Context, filters *InvoiceDBFilter, pagination shared.Pagination) ([]*Invoice, error)
-	GetTotalInvoiceAmount(ctx context.Context, customerID uuid.UUID) (float64, error)
-	ListOverdueInvoices(ctx context.Context, limit, offset int) ([]Invoice, error)
-	FetchLastInvoice(ctx context.Context) (*Invoice, error)
+	ListInvoices(
+		ctx context.Context,
+		filters *InvoiceDBFilter,
+		sort shared.Sort,
+		pagination shared.Pagination,
+	) (*FindAllInvoicesResult, error)
+	// ListOverdueInvoices locks and returns up to limit unpaid or partially paid invoices due before dueBefore, skipping
+	// invoices locked by another transaction. It must be called on a repository bound to a transaction.
+	ListOverdueInvoices(ctx context.Context, dueBefore time.Time, limit int) ([]*Invoice, error)
+	// ListRemindableInvoices returns up to limit unpaid or partially paid invoices of the given users that payment
+	// reminders are sent for, ordered by ID and starting after afterID.
+	ListRemindableInvoices(ctx context.Context, userIDs []uuid.UUID, afterID uuid.UUID, limit int) ([]*Invoice, error)
 }
 
 type SQLRepository struct {
 	db *gorm.DB
 }
 
+// CreateInvoice stores the invoice header only. Line items are stored through the invoicesitems repository and
+// the invoice number must already be allocated, see the unitofwork package for the combined flow.
 func (s *SQLRepository) CreateInvoice(ctx context.Context, invoice *DBInvoice) (*Invoice, error) {
 	if invoice.ID == uuid.Nil {
 		invoice.ID = uuid.New()
 	}
-	result := s.db.WithContext(ctx).Table(tableName).Create(invoice)
 
-	if result.Error != nil {
-		return nil, result.Error
+	err := shared.CheckOwner(ctx, invoice.UserID)
+	if err != nil {
+		return nil, err
 	}
+
+	err = s.db.WithContext(ctx).Table(tableName).Omit(clause.Associations).Create(invoice).Error
+	if err != nil {
+		return nil, mapUniqueViolation(err, invoice.InvoiceNumber)
+	}
+
 	return FromDBInvoice(invoice), nil
 }
 
 func (s *SQLRepository) GetInvoiceByID(ctx context.Context, id uuid.UUID) (*Invoice, error) {
 	var invoice DBInvoice
 
-	err := s.db.Clauses(dbresolver.Write).WithContext(ctx).Table(tableName).Where("id = ?", id).First(&invoice).Error
+	err := s.db.Clauses(dbresolver.Write).WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("id = ?", id).First(&invoice).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -56,75 +93,249 @@ func (s *SQLRepository) GetInvoiceByID(ctx context.Context, id uuid.UUID) (*Invo
 	return FromDBInvoice(&invoice), err
 }
 
+// LockInvoice loads an invoice and locks its row until the surrounding transaction ends. It must be called on a
+// repository bound to a transaction, see the unitofwork package.
+func (s *SQLRepository) LockInvoice(ctx context.Context, id uuid.UUID) (*Invoice, error) {
+	var invoice DBInvoice
+
+	err := s.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", id).
+		First(&invoice).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return FromDBInvoice(&invoice), nil
+}
+
+// UpdateInvoice persists the editable fields of an invoice, its due date. Status changes must go through
+// TransitionInvoiceStatus, and the amounts are only written by UpdateTotals and UpdateBalance so that an invoice read
+// without a lock cannot put back a stale balance.
 func (s *SQLRepository) UpdateInvoice(ctx context.Context, invoice *Invoice) error {
-	return s.db.WithContext(ctx).Save(invoice).Error
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", invoice.ID).
+		Updates(map[string]interface{}{
+			"due_date":   invoice.DueDate,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvoiceNotFound
+	}
+	return nil
+}
+
+func (s *SQLRepository) UpdateTotals(ctx context.Context, id uuid.UUID, totals Totals) error {
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"subtotal":        totals.Subtotal,
+			"discount_amount": totals.DiscountAmount,
+			"shipping_amount": totals.ShippingAmount,
+			"tax_amount":      totals.TaxAmount,
+			"total_amount":    totals.TotalAmount,
+			"amount_due":      gorm.Expr("? - amount_paid - amount_credited", totals.TotalAmount),
+			"updated_at":      time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvoiceNotFound
+	}
+	return nil
+}
+
+// TransitionInvoiceStatus moves an invoice from one status to another. The update only applies while the
+// invoice is still in the expected status, so concurrent transitions cannot both succeed.
+func (s *SQLRepository) TransitionInvoiceStatus(ctx context.Context, id uuid.UUID, from, to enums.InvoiceStatus) error {
+	validationErr := ValidateTransition(from, to)
+	if validationErr != nil {
+		return validationErr
+	}
+
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ? AND status = ?", id, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: invoice is no longer %s", ErrInvalidStatusTransition, from)
+	}
+	return nil
+}
+
+// UpdateBalance stores the outcome of a payment, refund or credit note. Unlike TransitionInvoiceStatus it does not
+// go through the state machine, since refunds may reopen paid invoices; callers hold the row lock taken by
+// LockInvoice.
+func (s *SQLRepository) UpdateBalance(
+	ctx context.Context,
+	id uuid.UUID,
+	status enums.InvoiceStatus,
+	amountPaid, amountCredited, amountDue decimal.Decimal,
+) error {
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":          status,
+			"amount_paid":     amountPaid,
+			"amount_credited": amountCredited,
+			"amount_due":      amountDue,
+			"updated_at":      time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvoiceNotFound
+	}
+	return nil
 }
 
 func (s *SQLRepository) DeleteInvoice(ctx context.Context, id uuid.UUID) error {
-	result := s.db.WithContext(ctx).Where("id = ?", id).Delete(&Invoice{})
+	result := s.db.WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("id = ?", id).Delete(&DBInvoice{})
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return errors.New("no invoice found with the given ID")
+		return ErrInvoiceNotFound
 	}
 	return nil
 }
 
-func (s *SQLRepository) ListInvoices(ctx context.Context, filters *InvoiceDBFilter, pagination shared.Pagination) ([]*Invoice, error) {
-	invoices := make([]*DBInvoice, 0)
+// ListInvoices returns a page of the invoices matching the filters. Pages selected by number come with the total
+// counts; counting is skipped for pages selected by cursor. Cursors are only available in shared.CursorOrder.
+func (s *SQLRepository) ListInvoices(
+	ctx context.Context,
+	filters *InvoiceDBFilter,
+	sort shared.Sort,
+	pagination shared.Pagination,
+) (*FindAllInvoicesResult, error) {
+	if pagination.Cursor != nil && sort != shared.CursorOrder {
+		return nil, shared.ErrCursorOrder
+	}
 
 	dataset, err := shared.BuildDataset(ctx, s.db, tableName, filters)
 	if err != nil {
 		return nil, err
 	}
 
-	paginatedDataset := shared.PaginateDataset(dataset, pagination)
+	// The count and the page run as separate statements on the same conditions.
+	dataset = filterInvoices(dataset.Scopes(shared.OwnedBy(ctx)), filters).Session(&gorm.Session{})
 
-	result := paginatedDataset.Find(&invoices)
-	if result.Error != nil {
-		return nil, result.Error
+	result := &FindAllInvoicesResult{PageSize: int64(pagination.PageSize())}
+
+	if pagination.Cursor == nil {
+		err = dataset.Count(&result.TotalCount).Error
+		if err != nil {
+			return nil, err
+		}
+
+		result.Page = int64(pagination.PageNumber())
+		result.PageCount = pagination.PageCount(result.TotalCount)
 	}
 
-	return FromDBInvoiceList(invoices), nil
-}
+	invoices := make([]*DBInvoice, 0)
 
-func (s *SQLRepository) GetTotalInvoiceAmount(ctx context.Context, customerID uuid.UUID) (float64, error) {
-	var total float64
-	err := s.db.WithContext(ctx).
-		Model(&Invoice{}).
-		Where("customer_id = ?", customerID).
-		Select("SUM(total_amount)").
-		Scan(&total).Error
-	return total, err
+	// One row more than the page size tells whether another page follows.
+	err = shared.PaginateDataset(shared.OrderDataset(dataset, sort), pagination).
+		Limit(pagination.PageSize() + 1).
+		Find(&invoices).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var next *shared.Cursor
+
+	invoices, next = shared.TrimPage(invoices, pagination.PageSize(), func(invoice *DBInvoice) shared.Cursor {
+		return shared.Cursor{CreatedAt: invoice.CreatedAt, ID: invoice.ID}
+	})
+
+	if sort == shared.CursorOrder {
+		result.NextCursor = next
+	}
+
+	result.Invoices = FromDBInvoiceList(invoices)
+
+	return result, nil
 }
 
-func (s *SQLRepository) ListOverdueInvoices(ctx context.Context, limit, offset int) ([]Invoice, error) {
-	var invoices []Invoice
-	now := time.Now()
+func (s *SQLRepository) ListOverdueInvoices(ctx context.Context, dueBefore time.Time, limit int) ([]*Invoice, error) {
+	invoices := make([]*DBInvoice, 0)
+
 	err := s.db.WithContext(ctx).
-		Where("due_date < ? AND status != ?", now, "Paid").
-		Order("due_date ASC").
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("status IN ? AND due_date < ?", dueStatuses, dueBefore).
+		Order("due_date ASC, id ASC").
 		Limit(limit).
-		Offset(offset).
 		Find(&invoices).Error
-	return invoices, err
+	if err != nil {
+		return nil, err
+	}
+
+	return FromDBInvoiceList(invoices), nil
 }
 
-func (s *SQLRepository) FetchLastInvoice(ctx context.Context) (*Invoice, error) {
-	var invoice Invoice
-	err := s.db.WithContext(ctx).
-		Order("created_at DESC").
-		First(&invoice).Error
+func (s *SQLRepository) ListRemindableInvoices(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+	afterID uuid.UUID,
+	limit int,
+) ([]*Invoice, error) {
+	invoices := make([]*DBInvoice, 0)
+
+	if len(userIDs) == 0 {
+		return FromDBInvoiceList(invoices), nil
+	}
 
+	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("status IN ? AND user_id IN ? AND id > ?", remindableStatuses, userIDs, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&invoices).Error
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil // No invoice found
-		}
 		return nil, err
 	}
 
-	return &invoice, nil
+	return FromDBInvoiceList(invoices), nil
+}
+
+func mapUniqueViolation(err error, invoiceNumber string) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolationCode {
+		return err
+	}
+
+	if strings.Contains(pgErr.ConstraintName, invoiceNumberUniqueIndexName) {
+		return fmt.Errorf("%w: %s", ErrDuplicateInvoiceNumber, invoiceNumber)
+	}
+
+	return err
 }
 
 func NewSQLRepository(db *gorm.DB) *SQLRepository {
@@ -0,0 +1,169 @@
+package invoices
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/shared"
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestListInvoicesByPageCountsAndFetchesOneMoreRow(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	owner := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: owner})
+
+	result, err := NewSQLRepository(db).ListInvoices(ctx, nil, shared.CursorOrder, shared.Pagination{Limit: lo.ToPtr(25), Page: lo.ToPtr(2)})
+	require.NoError(t, err)
+
+	ownerCondition := `WHERE "invoices"."user_id" = '` + owner.String() + `'`
+	require.Len(t, recorder.Statements(), 2)
+	assert.Equal(t, `SELECT count(*) FROM "invoices" `+ownerCondition, recorder.Statements()[0])
+	assert.Equal(t, `SELECT * FROM "invoices" `+ownerCondition+
+		` ORDER BY "invoices"."created_at" DESC,"invoices"."id" DESC LIMIT 26 OFFSET 25`, recorder.Statements()[1])
+	assert.Equal(t, int64(2), result.Page)
+	assert.Equal(t, int64(25), result.PageSize)
+	assert.Nil(t, result.NextCursor)
+}
+
+func TestListInvoicesByCursorSkipsTheCount(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+	cursor := &shared.Cursor{CreatedAt: time.Now(), ID: uuid.New()}
+
+	result, err := NewSQLRepository(db).ListInvoices(ctx, nil, shared.CursorOrder, shared.Pagination{Limit: lo.ToPtr(25), Cursor: cursor})
+	require.NoError(t, err)
+
+	require.Len(t, recorder.Statements(), 1)
+	assert.Contains(t, recorder.Last(), `("invoices"."created_at", "invoices"."id") < (`)
+	assert.Contains(t, recorder.Last(), `LIMIT 26`)
+	assert.NotContains(t, recorder.Last(), `OFFSET`)
+	assert.Zero(t, result.Page)
+	assert.Zero(t, result.TotalCount)
+}
+
+func TestListInvoicesAppliesRangeOverdueAndSearchFilters(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+	issuedFrom := time.Date(2026, 1, 1, 15, 0, 0, 0, time.UTC)
+	dueTo := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewSQLRepository(db).ListInvoices(ctx, &InvoiceDBFilter{
+		CreatedAfter:  lo.ToPtr("2025-12-31"),
+		IssueDateFrom: &issuedFrom,
+		DueDateTo:     &dueTo,
+		MinTotal:      lo.ToPtr(decimal.RequireFromString("100")),
+		MaxTotal:      lo.ToPtr(decimal.RequireFromString("250.50")),
+		Overdue:       true,
+		Search:        "acme",
+	}, shared.Sort{Column: "total_amount", Descending: true}, shared.Pagination{})
+	require.NoError(t, err)
+
+	query := recorder.Last()
+	assert.Contains(t, query, `DATE(created_at) > '2025-12-31'`)
+	assert.Contains(t, query, `invoices.issue_date >= '2026-01-01 00:00:00'`)
+	assert.Contains(t, query, `invoices.due_date < '2026-04-01 00:00:00'`)
+	assert.Contains(t, query, `invoices.total_amount >= '100' AND invoices.total_amount <= '250.5'`)
+	assert.Contains(t, query, `(invoices.status = 'OVERDUE' OR (invoices.status IN ('PENDING_PAYMENT','PARTIALLY_PAID') AND invoices.due_date < CURRENT_DATE))`)
+	assert.Contains(t, query, `(invoices.invoice_number ILIKE '%acme%' OR invoices.customer_id IN `+
+		`(SELECT id FROM "customers" WHERE name ILIKE '%acme%') OR invoices.id IN `+
+		`(SELECT invoice_id FROM "invoice_items" WHERE description ILIKE '%acme%'))`)
+	assert.Contains(t, query, `ORDER BY "invoices"."total_amount" DESC,"invoices"."id" DESC`)
+}
+
+func TestListInvoicesRejectsCursorInAnotherOrder(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+	cursor := &shared.Cursor{CreatedAt: time.Now(), ID: uuid.New()}
+
+	_, err := NewSQLRepository(db).ListInvoices(ctx, nil, shared.Sort{Column: "due_date"}, shared.Pagination{Cursor: cursor})
+
+	assert.ErrorIs(t, err, shared.ErrCursorOrder)
+	assert.Empty(t, recorder.Statements())
+}
+
+func TestListOverdueInvoicesLocksOpenInvoicesPastTheirDueDate(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	dueBefore := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewSQLRepository(db).ListOverdueInvoices(auth.AsSystem(context.Background()), dueBefore, 50)
+	require.NoError(t, err)
+
+	assert.Equal(t, `SELECT * FROM "invoices" WHERE status IN ('PENDING_PAYMENT','PARTIALLY_PAID') AND due_date < '2026-10-18 00:00:00'`+
+		` ORDER BY due_date ASC, id ASC LIMIT 50 FOR UPDATE SKIP LOCKED`, recorder.Last())
+}
+
+func TestUpdateInvoiceOnlyWritesTheDueDate(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	invoice := &Invoice{
+		ID:          uuid.New(),
+		DueDate:     time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC),
+		TotalAmount: decimal.RequireFromString("100"),
+		AmountDue:   decimal.RequireFromString("100"),
+	}
+
+	err := NewSQLRepository(db).UpdateInvoice(auth.AsSystem(context.Background()), invoice)
+
+	assert.ErrorIs(t, err, ErrInvoiceNotFound, "the dry run database affects no rows")
+	assert.Regexp(t, `^UPDATE "invoices" SET "due_date"='2026-11-30 00:00:00',"updated_at"='[^']+' WHERE id = '`+
+		invoice.ID.String()+`'$`, recorder.Last())
+}
+
+func TestMapUniqueViolation(t *testing.T) {
+	other := errors.New("connection reset")
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{
+			name:     "invoice number",
+			err:      &pgconn.PgError{Code: uniqueViolationCode, ConstraintName: invoiceNumberUniqueIndexName},
+			expected: ErrDuplicateInvoiceNumber,
+		},
+		{
+			name:     "other constraint",
+			err:      &pgconn.PgError{Code: uniqueViolationCode, ConstraintName: "invoices_pkey"},
+			expected: nil,
+		},
+		{
+			name:     "other error",
+			err:      other,
+			expected: other,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := mapUniqueViolation(tc.err, "INV-2026-0001")
+
+			if tc.expected == nil {
+				assert.Same(t, tc.err, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestListRemindableInvoicesIncludesPartiallyPaidInvoices(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	userID := uuid.New()
+
+	_, err := NewSQLRepository(db).ListRemindableInvoices(auth.AsSystem(context.Background()), []uuid.UUID{userID}, uuid.Nil, 50)
+	require.NoError(t, err)
+
+	assert.Equal(t, `SELECT * FROM "invoices" WHERE status IN ('PENDING_PAYMENT','PARTIALLY_PAID','OVERDUE')`+
+		` AND user_id IN ('`+userID.String()+`') AND id > '00000000-0000-0000-0000-000000000000' ORDER BY id ASC LIMIT 50`,
+		recorder.Last())
+}
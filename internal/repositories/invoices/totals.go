@@ -0,0 +1,225 @@
+package invoices
+
+import (
+	"errors"
+	"fmt"
+
+	"invoice-backend/internal/repositories/invoicesitems"
+	itemEnums "invoice-backend/internal/repositories/invoicesitems/enums"
+	"invoice-backend/pkg/money"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidDiscount = errors.New("invalid discount")
+	ErrInvalidTaxRate  = errors.New("invalid tax rate")
+)
+
+var oneHundred = decimal.NewFromInt(100)
+
+// Discount is a percentage or fixed reduction. A nil Type means no discount.
+type Discount struct {
+	Type  *itemEnums.DiscountType
+	Value decimal.Decimal
+}
+
+// Totals is the amount breakdown of an invoice.
+type Totals struct {
+	Subtotal       decimal.Decimal // Sum of quantity * unit price over all lines
+	DiscountAmount decimal.Decimal // Line discounts plus the invoice discount
+	ShippingAmount decimal.Decimal
+	TaxAmount      decimal.Decimal
+	TotalAmount    decimal.Decimal // Subtotal - DiscountAmount + TaxAmount + ShippingAmount
+}
+
+// LineTotal returns quantity * unitPrice rounded to the currency's minor units.
+func LineTotal(quantity int, unitPrice decimal.Decimal, currency money.Currency) decimal.Decimal {
+	return currency.Round(unitPrice.Mul(decimal.NewFromInt(int64(quantity))))
+}
+
+// CalculateTotals prices every line of items, filling in the computed amounts of the items and their taxes, and
+// returns the invoice breakdown.
+//
+// The line discount comes off quantity * unit price first. The invoice discount is then spread over the lines in
+// proportion to what is left of them, so taxes are charged on what the customer actually pays. Non-compound taxes
+// apply to that taxable amount and compound taxes to the taxable amount plus the taxes before them. Shipping is
+// added untaxed. Every amount is rounded to the currency's minor units as soon as it is computed, so the
+// breakdown always adds up to the printed lines.
+func CalculateTotals(
+	items []*invoicesitems.InvoiceItem,
+	discount Discount,
+	shippingAmount decimal.Decimal,
+	currency money.Currency,
+) (Totals, error) {
+	if shippingAmount.IsNegative() {
+		return Totals{}, fmt.Errorf("%w: shipping amount must not be negative", money.ErrInvalidAmount)
+	}
+
+	totals := Totals{
+		Subtotal:       decimal.Zero,
+		DiscountAmount: decimal.Zero,
+		ShippingAmount: currency.Round(shippingAmount),
+		TaxAmount:      decimal.Zero,
+	}
+
+	netAmounts := make([]decimal.Decimal, len(items))
+	netTotal := decimal.Zero
+
+	for i, item := range items {
+		item.TotalPrice = LineTotal(item.Quantity, item.UnitPrice, currency)
+
+		lineDiscount, err := discountAmount(item.DiscountType, item.DiscountValue, item.TotalPrice, currency)
+		if err != nil {
+			return Totals{}, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		item.DiscountAmount = lineDiscount
+		netAmounts[i] = item.TotalPrice.Sub(lineDiscount)
+		netTotal = netTotal.Add(netAmounts[i])
+
+		totals.Subtotal = totals.Subtotal.Add(item.TotalPrice)
+		totals.DiscountAmount = totals.DiscountAmount.Add(lineDiscount)
+	}
+
+	invoiceDiscount, err := discountAmount(discount.Type, discount.Value, netTotal, currency)
+	if err != nil {
+		return Totals{}, err
+	}
+
+	totals.DiscountAmount = totals.DiscountAmount.Add(invoiceDiscount)
+	discountShares := allocate(invoiceDiscount, netAmounts, currency)
+
+	for i, item := range items {
+		taxAmount, taxErr := applyTaxes(item.Taxes, netAmounts[i].Sub(discountShares[i]), currency)
+		if taxErr != nil {
+			return Totals{}, fmt.Errorf("line %d: %w", i+1, taxErr)
+		}
+
+		item.TaxAmount = taxAmount
+		totals.TaxAmount = totals.TaxAmount.Add(taxAmount)
+	}
+
+	totals.TotalAmount = totals.Subtotal.
+		Sub(totals.DiscountAmount).
+		Add(totals.TaxAmount).
+		Add(totals.ShippingAmount)
+
+	return totals, nil
+}
+
+// LineNetAmounts returns the taxable amount of every line of priced items: what is left once the line discount
+// and the line's share of the invoice discount are taken off, exactly as CalculateTotals spread them.
+func LineNetAmounts(items []*invoicesitems.InvoiceItem, discount Discount, currency money.Currency) ([]decimal.Decimal, error) {
+	netAmounts := make([]decimal.Decimal, len(items))
+	netTotal := decimal.Zero
+
+	for i, item := range items {
+		netAmounts[i] = item.TotalPrice.Sub(item.DiscountAmount)
+		netTotal = netTotal.Add(netAmounts[i])
+	}
+
+	invoiceDiscount, err := discountAmount(discount.Type, discount.Value, netTotal, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, share := range allocate(invoiceDiscount, netAmounts, currency) {
+		netAmounts[i] = netAmounts[i].Sub(share)
+	}
+
+	return netAmounts, nil
+}
+
+func discountAmount(
+	discountType *itemEnums.DiscountType,
+	value decimal.Decimal,
+	base decimal.Decimal,
+	currency money.Currency,
+) (decimal.Decimal, error) {
+	if discountType == nil {
+		return decimal.Zero, nil
+	}
+
+	if value.IsNegative() {
+		return decimal.Zero, fmt.Errorf("%w: value must not be negative", ErrInvalidDiscount)
+	}
+
+	switch *discountType {
+	case itemEnums.DiscountTypePercentage:
+		if value.GreaterThan(oneHundred) {
+			return decimal.Zero, fmt.Errorf("%w: percentage must not exceed 100", ErrInvalidDiscount)
+		}
+
+		return currency.Round(base.Mul(value).Div(oneHundred)), nil
+	case itemEnums.DiscountTypeFixed:
+		amount := currency.Round(value)
+		if amount.GreaterThan(base) {
+			return decimal.Zero, fmt.Errorf("%w: %s exceeds the discounted amount %s", ErrInvalidDiscount, amount, base)
+		}
+
+		return amount, nil
+	default:
+		return decimal.Zero, fmt.Errorf("%w: unknown type %q", ErrInvalidDiscount, *discountType)
+	}
+}
+
+// allocate splits amount over weights proportionally. The last weighted share absorbs the rounding difference, so
+// the shares always add up to amount exactly.
+func allocate(amount decimal.Decimal, weights []decimal.Decimal, currency money.Currency) []decimal.Decimal {
+	shares := make([]decimal.Decimal, len(weights))
+	totalWeight := decimal.Zero
+	last := -1
+
+	for i, weight := range weights {
+		shares[i] = decimal.Zero
+		totalWeight = totalWeight.Add(weight)
+
+		if weight.IsPositive() {
+			last = i
+		}
+	}
+
+	if amount.IsZero() || last == -1 {
+		return shares
+	}
+
+	allocated := decimal.Zero
+
+	for i := 0; i < last; i++ {
+		shares[i] = decimal.Min(currency.Round(amount.Mul(weights[i]).Div(totalWeight)), amount.Sub(allocated))
+		allocated = allocated.Add(shares[i])
+	}
+
+	shares[last] = amount.Sub(allocated)
+
+	return shares
+}
+
+func applyTaxes(taxes []*invoicesitems.InvoiceItemTax, taxableAmount decimal.Decimal, currency money.Currency) (decimal.Decimal, error) {
+	lineTax := decimal.Zero
+
+	for _, tax := range taxes {
+		if tax.Rate.IsNegative() {
+			return decimal.Zero, fmt.Errorf("%w: %s must not be negative", ErrInvalidTaxRate, tax.Name)
+		}
+
+		if tax.Compound {
+			continue
+		}
+
+		tax.Amount = currency.Round(taxableAmount.Mul(tax.Rate).Div(oneHundred))
+		lineTax = lineTax.Add(tax.Amount)
+	}
+
+	for _, tax := range taxes {
+		if !tax.Compound {
+			continue
+		}
+
+		tax.Amount = currency.Round(taxableAmount.Add(lineTax).Mul(tax.Rate).Div(oneHundred))
+		lineTax = lineTax.Add(tax.Amount)
+	}
+
+	return lineTax, nil
+}
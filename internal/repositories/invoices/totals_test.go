@@ -0,0 +1,189 @@
+package invoices
+
+import (
+	"testing"
+
+	"invoice-backend/internal/repositories/invoicesitems"
+	itemEnums "invoice-backend/internal/repositories/invoicesitems/enums"
+	"invoice-backend/pkg/money"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func dec(value string) decimal.Decimal {
+	return decimal.RequireFromString(value)
+}
+
+func TestLineTotal(t *testing.T) {
+	tests := []struct {
+		name      string
+		quantity  int
+		unitPrice string
+		currency  money.Currency
+		expected  string
+	}{
+		{"exact cents", 3, "19.99", "USD", "59.97"},
+		{"sub-cent unit price rounds half up", 3, "0.0125", "USD", "0.04"},
+		{"zero decimal currency", 7, "33.3333", "JPY", "233"},
+		{"three decimal currency", 2, "1.2345", "KWD", "2.469"},
+		{"value float32 used to corrupt", 1, "16777217.01", "USD", "16777217.01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total := LineTotal(tt.quantity, dec(tt.unitPrice), tt.currency)
+			assert.Equal(t, tt.expected, total.String())
+		})
+	}
+}
+
+func TestCalculateTotals(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []*invoicesitems.InvoiceItem
+		discount Discount
+		shipping string
+		expected map[string]string
+	}{
+		{
+			name: "plain lines",
+			items: []*invoicesitems.InvoiceItem{
+				{Quantity: 3, UnitPrice: dec("19.99")},
+				{Quantity: 1, UnitPrice: dec("0.01")},
+			},
+			shipping: "0",
+			expected: map[string]string{"subtotal": "59.98", "discount": "0", "tax": "0", "total": "59.98"},
+		},
+		{
+			name: "percentage and fixed line discounts with a non-compound tax",
+			items: []*invoicesitems.InvoiceItem{
+				{
+					Quantity: 2, UnitPrice: dec("50"),
+					DiscountType: lo.ToPtr(itemEnums.DiscountTypePercentage), DiscountValue: dec("10"),
+					Taxes: []*invoicesitems.InvoiceItemTax{{Name: "VAT", Rate: dec("20")}},
+				},
+				{
+					Quantity: 1, UnitPrice: dec("30"),
+					DiscountType: lo.ToPtr(itemEnums.DiscountTypeFixed), DiscountValue: dec("5"),
+					Taxes: []*invoicesitems.InvoiceItemTax{{Name: "VAT", Rate: dec("20")}},
+				},
+			},
+			shipping: "7.50",
+			// Line 1: 100 - 10 = 90, tax 18. Line 2: 30 - 5 = 25, tax 5.
+			expected: map[string]string{"subtotal": "130", "discount": "15", "tax": "23", "total": "145.5"},
+		},
+		{
+			name: "compound tax applies on top of the non-compound tax",
+			items: []*invoicesitems.InvoiceItem{
+				{
+					Quantity: 1, UnitPrice: dec("100"),
+					Taxes: []*invoicesitems.InvoiceItemTax{
+						{Name: "QST", Rate: dec("9.975"), Compound: true},
+						{Name: "GST", Rate: dec("5")},
+					},
+				},
+			},
+			shipping: "0",
+			// GST 5.00, QST on 105.00 = 10.47
+			expected: map[string]string{"subtotal": "100", "discount": "0", "tax": "15.47", "total": "115.47"},
+		},
+		{
+			name: "invoice discount is spread over lines before tax",
+			items: []*invoicesitems.InvoiceItem{
+				{Quantity: 1, UnitPrice: dec("100"), Taxes: []*invoicesitems.InvoiceItemTax{{Name: "VAT", Rate: dec("20")}}},
+				{Quantity: 1, UnitPrice: dec("100")},
+			},
+			discount: Discount{Type: lo.ToPtr(itemEnums.DiscountTypeFixed), Value: dec("50")},
+			shipping: "0",
+			// Each line carries 25 of the discount, so only 75 of the taxed line is taxed.
+			expected: map[string]string{"subtotal": "200", "discount": "50", "tax": "15", "total": "165"},
+		},
+		{
+			name: "allocation remainder goes to the last line",
+			items: []*invoicesitems.InvoiceItem{
+				{Quantity: 1, UnitPrice: dec("10"), Taxes: []*invoicesitems.InvoiceItemTax{{Name: "VAT", Rate: dec("100")}}},
+				{Quantity: 1, UnitPrice: dec("10"), Taxes: []*invoicesitems.InvoiceItemTax{{Name: "VAT", Rate: dec("100")}}},
+				{Quantity: 1, UnitPrice: dec("10"), Taxes: []*invoicesitems.InvoiceItemTax{{Name: "VAT", Rate: dec("100")}}},
+			},
+			discount: Discount{Type: lo.ToPtr(itemEnums.DiscountTypeFixed), Value: dec("10")},
+			shipping: "0",
+			// Shares 3.33, 3.33, 3.34 keep the taxed base at exactly 20.
+			expected: map[string]string{"subtotal": "30", "discount": "10", "tax": "20", "total": "40"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			totals, err := CalculateTotals(tt.items, tt.discount, dec(tt.shipping), "USD")
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expected["subtotal"], totals.Subtotal.String())
+			assert.Equal(t, tt.expected["discount"], totals.DiscountAmount.String())
+			assert.Equal(t, tt.expected["tax"], totals.TaxAmount.String())
+			assert.Equal(t, tt.expected["total"], totals.TotalAmount.String())
+		})
+	}
+}
+
+func TestCalculateTotalsFillsLineAmounts(t *testing.T) {
+	tax := &invoicesitems.InvoiceItemTax{Name: "VAT", Rate: dec("21")}
+	item := &invoicesitems.InvoiceItem{
+		Quantity:      4,
+		UnitPrice:     dec("2.50"),
+		DiscountType:  lo.ToPtr(itemEnums.DiscountTypePercentage),
+		DiscountValue: dec("15"),
+		Taxes:         []*invoicesitems.InvoiceItemTax{tax},
+	}
+
+	_, err := CalculateTotals([]*invoicesitems.InvoiceItem{item}, Discount{}, decimal.Zero, "EUR")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "10", item.TotalPrice.String())
+	assert.Equal(t, "1.5", item.DiscountAmount.String())
+	assert.Equal(t, "1.79", tax.Amount.String())
+	assert.Equal(t, "1.79", item.TaxAmount.String())
+}
+
+func TestCalculateTotalsRejectsInvalidInput(t *testing.T) {
+	line := func() []*invoicesitems.InvoiceItem {
+		return []*invoicesitems.InvoiceItem{{Quantity: 1, UnitPrice: dec("10")}}
+	}
+
+	_, err := CalculateTotals(line(), Discount{Type: lo.ToPtr(itemEnums.DiscountTypePercentage), Value: dec("101")}, decimal.Zero, "USD")
+	assert.ErrorIs(t, err, ErrInvalidDiscount)
+
+	_, err = CalculateTotals(line(), Discount{Type: lo.ToPtr(itemEnums.DiscountTypeFixed), Value: dec("10.01")}, decimal.Zero, "USD")
+	assert.ErrorIs(t, err, ErrInvalidDiscount)
+
+	_, err = CalculateTotals(line(), Discount{}, dec("-1"), "USD")
+	assert.ErrorIs(t, err, money.ErrInvalidAmount)
+
+	items := line()
+	items[0].Taxes = []*invoicesitems.InvoiceItemTax{{Name: "VAT", Rate: dec("-5")}}
+	_, err = CalculateTotals(items, Discount{}, decimal.Zero, "USD")
+	assert.ErrorIs(t, err, ErrInvalidTaxRate)
+}
+
+func TestLineNetAmountsMatchCalculateTotals(t *testing.T) {
+	vat := &invoicesitems.InvoiceItemTax{Name: "VAT", Rate: dec("20")}
+	items := []*invoicesitems.InvoiceItem{
+		{Quantity: 1, UnitPrice: dec("10"), Taxes: []*invoicesitems.InvoiceItemTax{vat}},
+		{Quantity: 2, UnitPrice: dec("10"), DiscountType: lo.ToPtr(itemEnums.DiscountTypeFixed), DiscountValue: dec("5")},
+		{Quantity: 1, UnitPrice: dec("0.01")},
+	}
+	discount := Discount{Type: lo.ToPtr(itemEnums.DiscountTypeFixed), Value: dec("1")}
+
+	totals, err := CalculateTotals(items, discount, decimal.Zero, "USD")
+	assert.NoError(t, err)
+
+	netAmounts, err := LineNetAmounts(items, discount, "USD")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"9.6", "14.4", "0.01"}, lo.Map(netAmounts, func(amount decimal.Decimal, _ int) string {
+		return amount.String()
+	}))
+	assert.Equal(t, "1.92", vat.Amount.String(), "taxed on the net amount of the line")
+	assert.Equal(t, totals.Subtotal.Sub(totals.DiscountAmount).String(), netAmounts[0].Add(netAmounts[1]).Add(netAmounts[2]).String())
+}
@@ -0,0 +1,112 @@
+package invoices
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"invoice-backend/internal/repositories/invoices/enums"
+)
+
+var ErrInvalidStatusTransition = errors.New("invalid invoice status transition")
+
+// statusTransitions lists, for every status, the statuses an invoice may move to next.
+// Statuses mapping to an empty list are terminal.
+var statusTransitions = map[enums.InvoiceStatus][]enums.InvoiceStatus{
+	enums.InvoiceStatusDRAFT: {
+		enums.InvoiceStatusPENDINGPAYMENT,
+		enums.InvoiceStatusCANCELLED,
+	},
+	enums.InvoiceStatusPENDINGPAYMENT: {
+		enums.InvoiceStatusOVERDUE,
+		enums.InvoiceStatusPARTIALLYPAID,
+		enums.InvoiceStatusPAID,
+		enums.InvoiceStatusVOID,
+	},
+	enums.InvoiceStatusOVERDUE: {
+		enums.InvoiceStatusPARTIALLYPAID,
+		enums.InvoiceStatusPAID,
+		enums.InvoiceStatusVOID,
+	},
+	enums.InvoiceStatusPARTIALLYPAID: {
+		enums.InvoiceStatusOVERDUE,
+		enums.InvoiceStatusPAID,
+	},
+	enums.InvoiceStatusPAID:      {},
+	enums.InvoiceStatusVOID:      {},
+	enums.InvoiceStatusCANCELLED: {},
+}
+
+func CanTransition(from, to enums.InvoiceStatus) bool {
+	return lo.Contains(statusTransitions[from], to)
+}
+
+func IsTerminalStatus(status enums.InvoiceStatus) bool {
+	return len(statusTransitions[status]) == 0
+}
+
+func ValidateTransition(from, to enums.InvoiceStatus) error {
+	if !CanTransition(from, to) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidStatusTransition, from, to)
+	}
+
+	return nil
+}
+
+// dueStatuses are the open statuses that turn overdue once the due date has passed.
+var dueStatuses = []enums.InvoiceStatus{
+	enums.InvoiceStatusPENDINGPAYMENT,
+	enums.InvoiceStatusPARTIALLYPAID,
+}
+
+// remindableStatuses are the statuses payment reminders are sent for, those with an amount still due.
+var remindableStatuses = []enums.InvoiceStatus{
+	enums.InvoiceStatusPENDINGPAYMENT,
+	enums.InvoiceStatusPARTIALLYPAID,
+	enums.InvoiceStatusOVERDUE,
+}
+
+// paymentStatuses are derived from the payments ledger and can only be reached by recording payments.
+var paymentStatuses = []enums.InvoiceStatus{
+	enums.InvoiceStatusPARTIALLYPAID,
+	enums.InvoiceStatusPAID,
+}
+
+// payableStatuses accept new payments and refunds.
+var payableStatuses = []enums.InvoiceStatus{
+	enums.InvoiceStatusPENDINGPAYMENT,
+	enums.InvoiceStatusOVERDUE,
+	enums.InvoiceStatusPARTIALLYPAID,
+	enums.InvoiceStatusPAID,
+}
+
+// IsCreditableStatus reports whether credit notes can be issued against an invoice in the given status, which is
+// the case once it was issued and as long as it was not voided.
+func IsCreditableStatus(status enums.InvoiceStatus) bool {
+	return lo.Contains(payableStatuses, status)
+}
+
+func IsPaymentStatus(status enums.InvoiceStatus) bool {
+	return lo.Contains(paymentStatuses, status)
+}
+
+func IsPayableStatus(status enums.InvoiceStatus) bool {
+	return lo.Contains(payableStatuses, status)
+}
+
+// StatusAfterPayment returns the status of a payable invoice once amountSettled of totalAmount has been paid or
+// credited. Refunds may take a paid invoice back to PARTIALLY_PAID or PENDING_PAYMENT, which the regular state
+// machine never allows. An overdue invoice stays overdue until it is settled in full.
+func StatusAfterPayment(current enums.InvoiceStatus, totalAmount, amountSettled decimal.Decimal) enums.InvoiceStatus {
+	switch {
+	case amountSettled.GreaterThanOrEqual(totalAmount):
+		return enums.InvoiceStatusPAID
+	case current == enums.InvoiceStatusOVERDUE:
+		return enums.InvoiceStatusOVERDUE
+	case amountSettled.IsPositive():
+		return enums.InvoiceStatusPARTIALLYPAID
+	default:
+		return enums.InvoiceStatusPENDINGPAYMENT
+	}
+}
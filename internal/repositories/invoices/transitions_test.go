@@ -0,0 +1,82 @@
+package invoices
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"invoice-backend/internal/repositories/invoices/enums"
+)
+
+func TestValidateTransition(t *testing.T) {
+	testCases := []struct {
+		name    string
+		from    enums.InvoiceStatus
+		to      enums.InvoiceStatus
+		allowed bool
+	}{
+		{name: "draft is sent", from: enums.InvoiceStatusDRAFT, to: enums.InvoiceStatusPENDINGPAYMENT, allowed: true},
+		{name: "draft is cancelled", from: enums.InvoiceStatusDRAFT, to: enums.InvoiceStatusCANCELLED, allowed: true},
+		{name: "draft cannot be paid", from: enums.InvoiceStatusDRAFT, to: enums.InvoiceStatusPAID, allowed: false},
+		{name: "pending invoice is paid", from: enums.InvoiceStatusPENDINGPAYMENT, to: enums.InvoiceStatusPAID, allowed: true},
+		{name: "pending invoice becomes overdue", from: enums.InvoiceStatusPENDINGPAYMENT, to: enums.InvoiceStatusOVERDUE, allowed: true},
+		{name: "overdue invoice is voided", from: enums.InvoiceStatusOVERDUE, to: enums.InvoiceStatusVOID, allowed: true},
+		{name: "paid invoice cannot go back to draft", from: enums.InvoiceStatusPAID, to: enums.InvoiceStatusDRAFT, allowed: false},
+		{name: "void invoice cannot be paid", from: enums.InvoiceStatusVOID, to: enums.InvoiceStatusPAID, allowed: false},
+		{name: "pending invoice is partially paid", from: enums.InvoiceStatusPENDINGPAYMENT, to: enums.InvoiceStatusPARTIALLYPAID, allowed: true},
+		{name: "partially paid invoice is paid", from: enums.InvoiceStatusPARTIALLYPAID, to: enums.InvoiceStatusPAID, allowed: true},
+		{name: "partially paid invoice becomes overdue", from: enums.InvoiceStatusPARTIALLYPAID, to: enums.InvoiceStatusOVERDUE, allowed: true},
+		{name: "partially paid invoice cannot be voided", from: enums.InvoiceStatusPARTIALLYPAID, to: enums.InvoiceStatusVOID, allowed: false},
+		{name: "status cannot transition to itself", from: enums.InvoiceStatusDRAFT, to: enums.InvoiceStatusDRAFT, allowed: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTransition(tc.from, tc.to)
+
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+			}
+		})
+	}
+}
+
+func TestIsTerminalStatus(t *testing.T) {
+	assert.True(t, IsTerminalStatus(enums.InvoiceStatusPAID))
+	assert.True(t, IsTerminalStatus(enums.InvoiceStatusVOID))
+	assert.True(t, IsTerminalStatus(enums.InvoiceStatusCANCELLED))
+	assert.False(t, IsTerminalStatus(enums.InvoiceStatusDRAFT))
+	assert.False(t, IsTerminalStatus(enums.InvoiceStatusOVERDUE))
+}
+
+func TestStatusAfterPayment(t *testing.T) {
+	total := decimal.RequireFromString("100")
+
+	testCases := []struct {
+		name     string
+		current  enums.InvoiceStatus
+		paid     string
+		expected enums.InvoiceStatus
+	}{
+		{name: "first partial payment", current: enums.InvoiceStatusPENDINGPAYMENT, paid: "40", expected: enums.InvoiceStatusPARTIALLYPAID},
+		{name: "remaining balance paid", current: enums.InvoiceStatusPARTIALLYPAID, paid: "100", expected: enums.InvoiceStatusPAID},
+		{name: "overdue invoice partially paid stays overdue", current: enums.InvoiceStatusOVERDUE, paid: "40", expected: enums.InvoiceStatusOVERDUE},
+		{name: "overdue invoice paid in full", current: enums.InvoiceStatusOVERDUE, paid: "100", expected: enums.InvoiceStatusPAID},
+		{name: "partial refund of a paid invoice", current: enums.InvoiceStatusPAID, paid: "60", expected: enums.InvoiceStatusPARTIALLYPAID},
+		{name: "full refund of a paid invoice", current: enums.InvoiceStatusPAID, paid: "0", expected: enums.InvoiceStatusPENDINGPAYMENT},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, StatusAfterPayment(tc.current, total, decimal.RequireFromString(tc.paid)))
+		})
+	}
+}
+
+func TestIsPaymentStatus(t *testing.T) {
+	assert.True(t, IsPaymentStatus(enums.InvoiceStatusPAID))
+	assert.True(t, IsPaymentStatus(enums.InvoiceStatusPARTIALLYPAID))
+	assert.False(t, IsPaymentStatus(enums.InvoiceStatusOVERDUE))
+}
@@ -0,0 +1,6 @@
+package enums
+
+// DiscountType ENUM(percentage, fixed)
+//
+//go:generate go run github.com/abice/go-enum@v0.5.5
+type DiscountType string
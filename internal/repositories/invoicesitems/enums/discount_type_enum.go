@@ -0,0 +1,45 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package enums
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// DiscountTypePercentage is a DiscountType of type percentage.
+	DiscountTypePercentage DiscountType = "percentage"
+	// DiscountTypeFixed is a DiscountType of type fixed.
+	DiscountTypeFixed DiscountType = "fixed"
+)
+
+var ErrInvalidDiscountType = errors.New("not a valid DiscountType")
+
+// String implements the Stringer interface.
+func (x DiscountType) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x DiscountType) IsValid() bool {
+	_, err := ParseDiscountType(string(x))
+	return err == nil
+}
+
+var _DiscountTypeValue = map[string]DiscountType{
+	"percentage": DiscountTypePercentage,
+	"fixed":      DiscountTypeFixed,
+}
+
+// ParseDiscountType attempts to convert a string to a DiscountType.
+func ParseDiscountType(name string) (DiscountType, error) {
+	if x, ok := _DiscountTypeValue[name]; ok {
+		return x, nil
+	}
+	return DiscountType(""), fmt.Errorf("%s is %w", name, ErrInvalidDiscountType)
+}
@@ -1,12 +1,36 @@
 package invoicesitems
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"invoice-backend/internal/repositories/invoicesitems/enums"
+)
 
 type InvoiceItem struct {
-	ID          uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
-	InvoiceID   uuid.UUID `json:"invoice_id" gorm:"type:uuid;not null"`
-	Description string    `gorm:"not null"` // Item description
-	Quantity    int       `gorm:"not null"` // Number of items
-	UnitPrice   float64   `gorm:"not null"` // Price per item
-	TotalPrice  float64   `gorm:"not null"` // Quantity * UnitPrice
+	ID             uuid.UUID           `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	InvoiceID      uuid.UUID           `json:"invoice_id" gorm:"type:uuid;not null"`
+	Position       int                 `gorm:"not null"`                    // Order of the line on the invoice
+	ProductID      *uuid.UUID          `gorm:"type:uuid"`                   // Catalogue product the line was filled from
+	Description    string              `gorm:"not null"`                    // Item description
+	Quantity       int                 `gorm:"not null"`                    // Number of items
+	UnitPrice      decimal.Decimal     `gorm:"type:numeric(19,4);not null"` // Price per item
+	TotalPrice     decimal.Decimal     `gorm:"type:numeric(19,4);not null"` // Quantity * UnitPrice, rounded to the invoice currency
+	DiscountType   *enums.DiscountType `gorm:"type:varchar(20)"`            // No discount when nil
+	DiscountValue  decimal.Decimal     `gorm:"type:numeric(19,4);not null"` // Percentage or fixed amount, depending on DiscountType
+	DiscountAmount decimal.Decimal     `gorm:"type:numeric(19,4);not null"` // Line discount taken off TotalPrice
+	TaxAmount      decimal.Decimal     `gorm:"type:numeric(19,4);not null"` // Sum of the line taxes
+	Taxes          []*InvoiceItemTax   `gorm:"foreignKey:InvoiceItemID"`
+}
+
+// InvoiceItemTax is a tax applied to a line. Name and rate are copied from the tax rate catalogue so that later
+// catalogue edits never change issued invoices.
+type InvoiceItemTax struct {
+	ID            uuid.UUID       `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	InvoiceItemID uuid.UUID       `gorm:"type:uuid;not null"`
+	TaxRateID     *uuid.UUID      `gorm:"type:uuid"`
+	Position      int             `gorm:"not null"`
+	Name          string          `gorm:"not null"`
+	Rate          decimal.Decimal `gorm:"type:numeric(7,4);not null"` // Percentage, e.g. 20 for 20%
+	Compound      bool            `gorm:"not null"`                   // Compound taxes also apply to the non-compound taxes of the line
+	Amount        decimal.Decimal `gorm:"type:numeric(19,4);not null"`
 }
@@ -4,14 +4,19 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"invoice-backend/internal/shared"
 )
 
 const (
-	tableName = "invoices_items"
+	tableName         = "invoice_items"
+	taxesTableName    = "invoice_item_taxes"
+	invoicesTableName = "invoices"
 )
 
 type Repository interface {
 	CreateInvoiceItem(context context.Context, item *InvoiceItem) error
+	CreateInvoiceItems(context context.Context, items []*InvoiceItem) error
 	GetInvoiceItemsByInvoiceID(context context.Context, invoiceID uuid.UUID) ([]InvoiceItem, error)
 	UpdateInvoiceItem(context context.Context, item *InvoiceItem) error
 	DeleteInvoiceItem(context context.Context, id uuid.UUID) error
@@ -22,23 +27,72 @@ type SQLRepository struct {
 }
 
 func (s *SQLRepository) CreateInvoiceItem(ctx context.Context, item *InvoiceItem) error {
-	return s.db.WithContext(ctx).Create(item).Error
+	return s.db.WithContext(ctx).Table(tableName).Create(item).Error
+}
+
+// CreateInvoiceItems stores items together with their taxes. Callers wanting both to be atomic must pass a
+// repository bound to a transaction.
+func (s *SQLRepository) CreateInvoiceItems(ctx context.Context, items []*InvoiceItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	err := s.db.WithContext(ctx).Table(tableName).Omit(clause.Associations).Create(items).Error
+	if err != nil {
+		return err
+	}
+
+	taxes := make([]*InvoiceItemTax, 0)
+
+	for _, item := range items {
+		for position, tax := range item.Taxes {
+			if tax.ID == uuid.Nil {
+				tax.ID = uuid.New()
+			}
+
+			tax.InvoiceItemID = item.ID
+			tax.Position = position
+			taxes = append(taxes, tax)
+		}
+	}
+
+	if len(taxes) == 0 {
+		return nil
+	}
+
+	return s.db.WithContext(ctx).Table(taxesTableName).Create(taxes).Error
 }
 
 func (s *SQLRepository) GetInvoiceItemsByInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceItem, error) {
 	var items []InvoiceItem
 	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Preload("Taxes", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Scopes(shared.OwnedThrough(ctx, "invoice_id", invoicesTableName)).
 		Where("invoice_id = ?", invoiceID).
+		Order("position ASC").
 		Find(&items).Error
 	return items, err
 }
 
+// UpdateInvoiceItem overwrites an existing item. Unlike Save it never inserts, so an item of another user's invoice
+// cannot be recreated through it.
 func (s *SQLRepository) UpdateInvoiceItem(ctx context.Context, item *InvoiceItem) error {
-	return s.db.WithContext(ctx).Save(item).Error
+	return s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedThrough(ctx, "invoice_id", invoicesTableName)).
+		Select("*").
+		Omit(clause.Associations).
+		Where("id = ?", item.ID).
+		Updates(item).Error
 }
 
 func (s *SQLRepository) DeleteInvoiceItem(ctx context.Context, id uuid.UUID) error {
 	return s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedThrough(ctx, "invoice_id", invoicesTableName)).
 		Where("id = ?", id).
 		Delete(&InvoiceItem{}).Error
 }
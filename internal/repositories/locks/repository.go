@@ -0,0 +1,42 @@
+package locks
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Key identifies an advisory lock. Keys are shared by every replica of the service, so each job needs its own.
+type Key int64
+
+const (
+	// KeyOverdueInvoices serialises the job marking overdue invoices.
+	KeyOverdueInvoices Key = 73_001
+)
+
+type Repository interface {
+	// TryLock takes the Postgres advisory lock for key without waiting and reports whether it was taken. The lock is
+	// held until the surrounding transaction ends, so it must be called on a repository bound to a transaction.
+	TryLock(ctx context.Context, key Key) (bool, error)
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) TryLock(ctx context.Context, key Key) (bool, error) {
+	var locked bool
+
+	err := s.db.WithContext(ctx).Raw("SELECT pg_try_advisory_xact_lock(?)", int64(key)).Scan(&locked).Error
+	if err != nil {
+		return false, err
+	}
+
+	return locked, nil
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
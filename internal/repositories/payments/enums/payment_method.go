@@ -0,0 +1,6 @@
+package enums
+
+// PaymentMethod ENUM(bank_transfer, card, cash, check, paypal, other)
+//
+//go:generate go run github.com/abice/go-enum@v0.5.5
+type PaymentMethod string
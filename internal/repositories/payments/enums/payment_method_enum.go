@@ -0,0 +1,57 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package enums
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// PaymentMethodBankTransfer is a PaymentMethod of type bank_transfer.
+	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
+	// PaymentMethodCard is a PaymentMethod of type card.
+	PaymentMethodCard PaymentMethod = "card"
+	// PaymentMethodCash is a PaymentMethod of type cash.
+	PaymentMethodCash PaymentMethod = "cash"
+	// PaymentMethodCheck is a PaymentMethod of type check.
+	PaymentMethodCheck PaymentMethod = "check"
+	// PaymentMethodPaypal is a PaymentMethod of type paypal.
+	PaymentMethodPaypal PaymentMethod = "paypal"
+	// PaymentMethodOther is a PaymentMethod of type other.
+	PaymentMethodOther PaymentMethod = "other"
+)
+
+var ErrInvalidPaymentMethod = errors.New("not a valid PaymentMethod")
+
+// String implements the Stringer interface.
+func (x PaymentMethod) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x PaymentMethod) IsValid() bool {
+	_, err := ParsePaymentMethod(string(x))
+	return err == nil
+}
+
+var _PaymentMethodValue = map[string]PaymentMethod{
+	"bank_transfer": PaymentMethodBankTransfer,
+	"card":          PaymentMethodCard,
+	"cash":          PaymentMethodCash,
+	"check":         PaymentMethodCheck,
+	"paypal":        PaymentMethodPaypal,
+	"other":         PaymentMethodOther,
+}
+
+// ParsePaymentMethod attempts to convert a string to a PaymentMethod.
+func ParsePaymentMethod(name string) (PaymentMethod, error) {
+	if x, ok := _PaymentMethodValue[name]; ok {
+		return x, nil
+	}
+	return PaymentMethod(""), fmt.Errorf("%s is %w", name, ErrInvalidPaymentMethod)
+}
@@ -0,0 +1,6 @@
+package enums
+
+// PaymentType ENUM(payment, refund)
+//
+//go:generate go run github.com/abice/go-enum@v0.5.5
+type PaymentType string
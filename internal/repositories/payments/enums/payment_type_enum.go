@@ -0,0 +1,45 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package enums
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// PaymentTypePayment is a PaymentType of type payment.
+	PaymentTypePayment PaymentType = "payment"
+	// PaymentTypeRefund is a PaymentType of type refund.
+	PaymentTypeRefund PaymentType = "refund"
+)
+
+var ErrInvalidPaymentType = errors.New("not a valid PaymentType")
+
+// String implements the Stringer interface.
+func (x PaymentType) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x PaymentType) IsValid() bool {
+	_, err := ParsePaymentType(string(x))
+	return err == nil
+}
+
+var _PaymentTypeValue = map[string]PaymentType{
+	"payment": PaymentTypePayment,
+	"refund":  PaymentTypeRefund,
+}
+
+// ParsePaymentType attempts to convert a string to a PaymentType.
+func ParsePaymentType(name string) (PaymentType, error) {
+	if x, ok := _PaymentTypeValue[name]; ok {
+		return x, nil
+	}
+	return PaymentType(""), fmt.Errorf("%s is %w", name, ErrInvalidPaymentType)
+}
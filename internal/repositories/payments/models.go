@@ -0,0 +1,32 @@
+package payments
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"invoice-backend/internal/repositories/payments/enums"
+	"invoice-backend/pkg/money"
+)
+
+type Payment struct {
+	ID        uuid.UUID           `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	InvoiceID uuid.UUID           `gorm:"type:uuid;not null"`
+	UserID    uuid.UUID           `gorm:"type:uuid;not null"`
+	Type      enums.PaymentType   `gorm:"not null"`
+	Amount    decimal.Decimal     `gorm:"type:numeric(19,4);not null"` // Always positive, refunds are told apart by Type
+	Currency  money.Currency      `gorm:"type:char(3);not null"`
+	Method    enums.PaymentMethod `gorm:"not null"`
+	Reference *string             `gorm:"type:varchar(255)"` // e.g. bank transfer or card transaction reference
+	PaidAt    time.Time           `gorm:"not null"`
+	CreatedAt time.Time           `gorm:"autoCreateTime"`
+}
+
+// SignedAmount returns the amount as it counts towards the invoice balance: negative for refunds.
+func (p *Payment) SignedAmount() decimal.Decimal {
+	if p.Type == enums.PaymentTypeRefund {
+		return p.Amount.Neg()
+	}
+
+	return p.Amount
+}
@@ -0,0 +1,92 @@
+package payments
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"invoice-backend/internal/repositories/payments/enums"
+	"invoice-backend/internal/shared"
+)
+
+const (
+	tableName = "payments"
+)
+
+type Repository interface {
+	CreatePayment(ctx context.Context, payment *Payment) (*Payment, error)
+	ListPaymentsByInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]*Payment, error)
+	// SumPayments returns the net amount received for an invoice, refunds deducted.
+	SumPayments(ctx context.Context, invoiceID uuid.UUID) (decimal.Decimal, error)
+	CountPayments(ctx context.Context, invoiceID uuid.UUID) (int64, error)
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) CreatePayment(ctx context.Context, payment *Payment) (*Payment, error) {
+	if payment.ID == uuid.Nil {
+		payment.ID = uuid.New()
+	}
+
+	err := shared.CheckOwner(ctx, payment.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Table(tableName).Create(payment).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+func (s *SQLRepository) ListPaymentsByInvoiceID(ctx context.Context, invoiceID uuid.UUID) ([]*Payment, error) {
+	list := make([]*Payment, 0)
+
+	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("invoice_id = ?", invoiceID).
+		Order("paid_at ASC, created_at ASC").
+		Find(&list).Error
+
+	return list, err
+}
+
+func (s *SQLRepository) SumPayments(ctx context.Context, invoiceID uuid.UUID) (decimal.Decimal, error) {
+	var total decimal.NullDecimal
+
+	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Select("SUM(CASE WHEN type = ? THEN -amount ELSE amount END)", enums.PaymentTypeRefund).
+		Where("invoice_id = ?", invoiceID).
+		Scan(&total).Error
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if !total.Valid {
+		return decimal.Zero, nil
+	}
+
+	return total.Decimal, nil
+}
+
+func (s *SQLRepository) CountPayments(ctx context.Context, invoiceID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := s.db.WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("invoice_id = ?", invoiceID).Count(&count).Error
+
+	return count, err
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
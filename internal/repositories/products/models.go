@@ -0,0 +1,42 @@
+package products
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"invoice-backend/pkg/money"
+)
+
+// Product is a good or service of the catalogue. Lines referencing it are prefilled with its description, price
+// and tax rate, which each line may still override.
+type Product struct {
+	ID          uuid.UUID       `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID      uuid.UUID       `gorm:"type:uuid;not null"`
+	SKU         *string         `gorm:"type:varchar(64)"` // Unique among the products of a user when set
+	Name        string          `gorm:"type:varchar(255);not null"`
+	Description string          `gorm:"not null"`
+	UnitPrice   decimal.Decimal `gorm:"type:numeric(19,4);not null"`
+	Unit        string          `gorm:"type:varchar(20);not null"` // Unit of measure, e.g. hour or kg
+	TaxRateID   *uuid.UUID      `gorm:"type:uuid"`                 // Tax rate applied by default to the lines of the product
+	Active      bool            `gorm:"not null"`                  // Inactive products cannot be added to new lines
+	CreatedAt   time.Time       `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time       `gorm:"autoUpdateTime"`
+}
+
+type ProductDBFilter struct {
+	ID     []*uuid.UUID `json:"id,omitempty"`
+	UserID []*uuid.UUID `json:"user_id,omitempty"`
+	Active *bool        `json:"active,omitempty"`
+
+	// Search is applied by ListProducts rather than shared.FilterDataset.
+	Search string `json:"-"` // Case-insensitive substring of the SKU, name or description
+}
+
+// Sales sums the lines of a product on issued invoices in one currency.
+type Sales struct {
+	Currency     money.Currency
+	InvoiceCount int64
+	Quantity     int64
+	NetAmount    decimal.Decimal // Line totals after line discounts, before taxes and invoice discounts
+}
@@ -0,0 +1,194 @@
+package products
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	invoiceEnums "invoice-backend/internal/repositories/invoices/enums"
+	"invoice-backend/internal/shared"
+)
+
+const (
+	tableName             = "products"
+	invoiceItemsTableName = "invoice_items"
+	invoicesTableName     = "invoices"
+
+	uniqueViolationCode = "23505"
+	skuUniqueIndexName  = "uq_products_sku"
+)
+
+var (
+	ErrProductNotFound = errors.New("no product found with the given ID")
+	ErrDuplicateSKU    = errors.New("a product with this SKU already exists")
+)
+
+// unbilledStatuses are the statuses of invoices left out of the sales of a product.
+var unbilledStatuses = []invoiceEnums.InvoiceStatus{
+	invoiceEnums.InvoiceStatusDRAFT,
+	invoiceEnums.InvoiceStatusVOID,
+	invoiceEnums.InvoiceStatusCANCELLED,
+}
+
+type Repository interface {
+	CreateProduct(ctx context.Context, product *Product) (*Product, error)
+	GetProductByID(ctx context.Context, id uuid.UUID) (*Product, error)
+	ListProducts(ctx context.Context, filters *ProductDBFilter, pagination shared.Pagination) ([]*Product, error)
+	UpdateProduct(ctx context.Context, product *Product) error
+	DeleteProduct(ctx context.Context, id uuid.UUID) error
+	// SummarizeSales sums the lines of the product on issued invoices, per currency, optionally restricted to the
+	// invoices issued between from and to, both inclusive.
+	SummarizeSales(ctx context.Context, id uuid.UUID, from, to *time.Time) ([]*Sales, error)
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) CreateProduct(ctx context.Context, product *Product) (*Product, error) {
+	if product.ID == uuid.Nil {
+		product.ID = uuid.New()
+	}
+
+	err := shared.CheckOwner(ctx, product.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Table(tableName).Create(product).Error
+	if err != nil {
+		return nil, mapUniqueViolation(err)
+	}
+
+	return product, nil
+}
+
+func (s *SQLRepository) GetProductByID(ctx context.Context, id uuid.UUID) (*Product, error) {
+	var product Product
+
+	err := s.db.WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("id = ?", id).First(&product).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+func (s *SQLRepository) ListProducts(ctx context.Context, filters *ProductDBFilter, pagination shared.Pagination) ([]*Product, error) {
+	list := make([]*Product, 0)
+
+	dataset, err := shared.BuildDataset(ctx, s.db, tableName, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	if filters != nil && filters.Search != "" {
+		pattern := shared.ContainsPattern(filters.Search)
+		dataset = dataset.Where("sku ILIKE ? OR name ILIKE ? OR description ILIKE ?", pattern, pattern, pattern)
+	}
+
+	paginatedDataset := shared.PaginateDataset(dataset.Scopes(shared.OwnedBy(ctx)).Order("name ASC, id ASC"), pagination)
+
+	err = paginatedDataset.Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (s *SQLRepository) UpdateProduct(ctx context.Context, product *Product) error {
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", product.ID).
+		Updates(map[string]interface{}{
+			"sku":         product.SKU,
+			"name":        product.Name,
+			"description": product.Description,
+			"unit_price":  product.UnitPrice,
+			"unit":        product.Unit,
+			"tax_rate_id": product.TaxRateID,
+			"active":      product.Active,
+			"updated_at":  time.Now(),
+		})
+	if result.Error != nil {
+		return mapUniqueViolation(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrProductNotFound
+	}
+
+	return nil
+}
+
+// DeleteProduct removes a product from the catalogue. Lines filled from it keep their description and prices but
+// no longer reference it; deactivating the product keeps it in the sales reports instead.
+func (s *SQLRepository) DeleteProduct(ctx context.Context, id uuid.UUID) error {
+	result := s.db.WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("id = ?", id).Delete(&Product{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrProductNotFound
+	}
+
+	return nil
+}
+
+func (s *SQLRepository) SummarizeSales(ctx context.Context, id uuid.UUID, from, to *time.Time) ([]*Sales, error) {
+	sales := make([]*Sales, 0)
+
+	query := s.db.WithContext(ctx).
+		Table(invoiceItemsTableName).
+		Select("invoices.currency, COUNT(DISTINCT invoices.id) AS invoice_count, "+
+			"SUM(invoice_items.quantity) AS quantity, "+
+			"SUM(invoice_items.total_price - invoice_items.discount_amount) AS net_amount").
+		Joins("JOIN invoices ON invoices.id = invoice_items.invoice_id").
+		Scopes(shared.OwnedThrough(ctx, "invoice_id", invoicesTableName)).
+		Where("invoice_items.product_id = ? AND invoices.status NOT IN ?", id, unbilledStatuses)
+
+	if from != nil {
+		query = query.Where("invoices.issue_date >= ?", from.Format(time.DateOnly))
+	}
+
+	if to != nil {
+		query = query.Where("invoices.issue_date <= ?", to.Format(time.DateOnly))
+	}
+
+	err := query.Group("invoices.currency").Order("invoices.currency ASC").Find(&sales).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return sales, nil
+}
+
+func mapUniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolationCode {
+		return err
+	}
+
+	if strings.Contains(pgErr.ConstraintName, skuUniqueIndexName) {
+		return ErrDuplicateSKU
+	}
+
+	return err
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,53 @@
+package products
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/shared"
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestListProductsFiltersAndSearches(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	owner := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: owner})
+
+	_, err := NewSQLRepository(db).ListProducts(
+		ctx,
+		&ProductDBFilter{Active: lo.ToPtr(true), Search: "50%"},
+		shared.Pagination{Limit: lo.ToPtr(10)},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, `SELECT * FROM "products" WHERE "active" = true `+
+		`AND (sku ILIKE '%50\%%' OR name ILIKE '%50\%%' OR description ILIKE '%50\%%') `+
+		`AND "products"."user_id" = '`+owner.String()+`' ORDER BY name ASC, id ASC LIMIT 10`, recorder.Last())
+}
+
+func TestSummarizeSalesOnlyCountsIssuedInvoicesOfTheOwner(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	owner := uuid.New()
+	productID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: owner})
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewSQLRepository(db).SummarizeSales(ctx, productID, &from, &to)
+	require.NoError(t, err)
+
+	query := recorder.Last()
+	assert.Contains(t, query, `FROM "invoice_items" JOIN invoices ON invoices.id = invoice_items.invoice_id`)
+	assert.Contains(t, query, `invoice_items.product_id = '`+productID.String()+`' `+
+		`AND invoices.status NOT IN ('DRAFT','VOID','CANCELLED')`)
+	assert.Contains(t, query, `"invoice_items"."invoice_id" IN (SELECT id FROM "invoices" WHERE "user_id" = '`+
+		owner.String()+`')`)
+	assert.Contains(t, query, `invoices.issue_date >= '2026-01-01' AND invoices.issue_date <= '2026-03-31'`)
+	assert.Contains(t, query, `GROUP BY "invoices"."currency" ORDER BY invoices.currency ASC`)
+}
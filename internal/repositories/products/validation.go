@@ -0,0 +1,50 @@
+package products
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ErrInvalidName      = errors.New("invalid product name")
+	ErrInvalidSKU       = errors.New("invalid product SKU")
+	ErrInvalidUnit      = errors.New("invalid product unit")
+	ErrInvalidUnitPrice = errors.New("invalid product unit price")
+)
+
+const (
+	maxSKULength  = 64
+	maxNameLength = 255
+	maxUnitLength = 20
+)
+
+// Validate checks the user-provided fields of a product.
+func Validate(product *Product) error {
+	if strings.TrimSpace(product.Name) == "" {
+		return fmt.Errorf("%w: name must not be empty", ErrInvalidName)
+	}
+
+	if utf8.RuneCountInString(product.Name) > maxNameLength {
+		return fmt.Errorf("%w: name is longer than %d characters", ErrInvalidName, maxNameLength)
+	}
+
+	if product.SKU != nil && strings.TrimSpace(*product.SKU) == "" {
+		return fmt.Errorf("%w: SKU must not be blank", ErrInvalidSKU)
+	}
+
+	if product.SKU != nil && utf8.RuneCountInString(*product.SKU) > maxSKULength {
+		return fmt.Errorf("%w: SKU is longer than %d characters", ErrInvalidSKU, maxSKULength)
+	}
+
+	if utf8.RuneCountInString(product.Unit) > maxUnitLength {
+		return fmt.Errorf("%w: unit is longer than %d characters", ErrInvalidUnit, maxUnitLength)
+	}
+
+	if product.UnitPrice.IsNegative() {
+		return fmt.Errorf("%w: %s is negative", ErrInvalidUnitPrice, product.UnitPrice)
+	}
+
+	return nil
+}
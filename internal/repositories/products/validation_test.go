@@ -0,0 +1,41 @@
+package products
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	price := decimal.RequireFromString("120")
+
+	tests := []struct {
+		name     string
+		product  Product
+		expected error
+	}{
+		{"valid", Product{Name: "Consulting", Unit: "hour", UnitPrice: price}, nil},
+		{"with SKU", Product{SKU: lo.ToPtr("CONS-01"), Name: "Consulting", UnitPrice: price}, nil},
+		{"free", Product{Name: "Setup", UnitPrice: decimal.Zero}, nil},
+		{"blank name", Product{Name: " ", UnitPrice: price}, ErrInvalidName},
+		{"long name", Product{Name: strings.Repeat("a", 256), UnitPrice: price}, ErrInvalidName},
+		{"blank SKU", Product{SKU: lo.ToPtr(""), Name: "Consulting", UnitPrice: price}, ErrInvalidSKU},
+		{"long SKU", Product{SKU: lo.ToPtr(strings.Repeat("A", 65)), Name: "Consulting", UnitPrice: price}, ErrInvalidSKU},
+		{"long unit", Product{Name: "Consulting", Unit: strings.Repeat("h", 21), UnitPrice: price}, ErrInvalidUnit},
+		{"negative price", Product{Name: "Consulting", UnitPrice: decimal.RequireFromString("-1")}, ErrInvalidUnitPrice},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&tt.product)
+			if tt.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expected)
+			}
+		})
+	}
+}
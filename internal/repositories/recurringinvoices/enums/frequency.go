@@ -0,0 +1,6 @@
+package enums
+
+// Frequency ENUM(weekly, monthly, yearly)
+//
+//go:generate go run github.com/abice/go-enum@v0.5.5
+type Frequency string
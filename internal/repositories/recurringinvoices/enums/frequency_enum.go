@@ -0,0 +1,48 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package enums
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// FrequencyWeekly is a Frequency of type weekly.
+	FrequencyWeekly Frequency = "weekly"
+	// FrequencyMonthly is a Frequency of type monthly.
+	FrequencyMonthly Frequency = "monthly"
+	// FrequencyYearly is a Frequency of type yearly.
+	FrequencyYearly Frequency = "yearly"
+)
+
+var ErrInvalidFrequency = errors.New("not a valid Frequency")
+
+// String implements the Stringer interface.
+func (x Frequency) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x Frequency) IsValid() bool {
+	_, err := ParseFrequency(string(x))
+	return err == nil
+}
+
+var _FrequencyValue = map[string]Frequency{
+	"weekly":  FrequencyWeekly,
+	"monthly": FrequencyMonthly,
+	"yearly":  FrequencyYearly,
+}
+
+// ParseFrequency attempts to convert a string to a Frequency.
+func ParseFrequency(name string) (Frequency, error) {
+	if x, ok := _FrequencyValue[name]; ok {
+		return x, nil
+	}
+	return Frequency(""), fmt.Errorf("%s is %w", name, ErrInvalidFrequency)
+}
@@ -0,0 +1,6 @@
+package enums
+
+// RecurringStatus ENUM(active, paused, completed)
+//
+//go:generate go run github.com/abice/go-enum@v0.5.5
+type RecurringStatus string
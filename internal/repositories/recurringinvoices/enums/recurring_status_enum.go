@@ -0,0 +1,48 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package enums
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// RecurringStatusActive is a RecurringStatus of type active.
+	RecurringStatusActive RecurringStatus = "active"
+	// RecurringStatusPaused is a RecurringStatus of type paused.
+	RecurringStatusPaused RecurringStatus = "paused"
+	// RecurringStatusCompleted is a RecurringStatus of type completed.
+	RecurringStatusCompleted RecurringStatus = "completed"
+)
+
+var ErrInvalidRecurringStatus = errors.New("not a valid RecurringStatus")
+
+// String implements the Stringer interface.
+func (x RecurringStatus) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x RecurringStatus) IsValid() bool {
+	_, err := ParseRecurringStatus(string(x))
+	return err == nil
+}
+
+var _RecurringStatusValue = map[string]RecurringStatus{
+	"active":    RecurringStatusActive,
+	"paused":    RecurringStatusPaused,
+	"completed": RecurringStatusCompleted,
+}
+
+// ParseRecurringStatus attempts to convert a string to a RecurringStatus.
+func ParseRecurringStatus(name string) (RecurringStatus, error) {
+	if x, ok := _RecurringStatusValue[name]; ok {
+		return x, nil
+	}
+	return RecurringStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidRecurringStatus)
+}
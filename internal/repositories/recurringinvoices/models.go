@@ -0,0 +1,90 @@
+package recurringinvoices
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	itemEnums "invoice-backend/internal/repositories/invoicesitems/enums"
+	"invoice-backend/internal/repositories/recurringinvoices/enums"
+	"invoice-backend/pkg/money"
+)
+
+// RecurringInvoice is a template invoices are generated from on a schedule. The template mirrors the fields of a
+// new invoice; the schedule state tracks how many invoices were generated and when the next one is due.
+type RecurringInvoice struct {
+	ID             uuid.UUID               `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID         uuid.UUID               `gorm:"type:uuid;not null"`
+	CustomerID     uuid.UUID               `gorm:"type:uuid;not null"`
+	Currency       money.Currency          `gorm:"type:char(3);not null"`
+	DiscountType   *itemEnums.DiscountType `gorm:"type:varchar(20)"`
+	DiscountValue  decimal.Decimal         `gorm:"type:numeric(19,4);not null"`
+	ShippingAmount decimal.Decimal         `gorm:"type:numeric(19,4);not null"`
+	Items          Items                   `gorm:"type:jsonb;not null"`
+
+	Frequency        enums.Frequency `gorm:"not null"`
+	Interval         int             `gorm:"column:interval_count;not null"` // Number of periods between two invoices
+	DayOfMonth       *int            // Monthly and yearly schedules only, clamped to the length of the month
+	StartDate        time.Time       `gorm:"type:date;not null"`
+	EndDate          *time.Time      `gorm:"type:date"` // Last day an invoice may be generated
+	MaxCount         *int            // Number of invoices after which the schedule completes
+	PaymentTermsDays int             `gorm:"not null"` // Days between the issue and due dates of generated invoices
+	AutoSend         bool            `gorm:"not null"` // Email generated invoices to the customer right away
+
+	Status         enums.RecurringStatus `gorm:"not null"`
+	GeneratedCount int                   `gorm:"not null"`
+	NextRunOn      *time.Time            `gorm:"type:date"` // Nil once the schedule is completed
+	CreatedAt      time.Time             `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time             `gorm:"autoUpdateTime"`
+}
+
+// Item is a line of the template. Taxes are referenced by tax rate so that generated invoices use the rates of the
+// catalogue at the time they are generated.
+type Item struct {
+	Description   string                  `json:"description"`
+	Quantity      int                     `json:"quantity"`
+	UnitPrice     decimal.Decimal         `json:"unit_price"`
+	DiscountType  *itemEnums.DiscountType `json:"discount_type,omitempty"`
+	DiscountValue decimal.Decimal         `json:"discount_value"`
+	TaxRateIDs    []uuid.UUID             `json:"tax_rate_ids,omitempty"`
+}
+
+// Items are stored as a JSON array.
+type Items []Item
+
+func (i Items) Value() (driver.Value, error) {
+	if i == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+func (i *Items) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(value), i)
+	case []byte:
+		return json.Unmarshal(value, i)
+	case nil:
+		*i = Items{}
+	default:
+		return fmt.Errorf("cannot scan %T into Items", src)
+	}
+
+	return nil
+}
+
+type RecurringInvoiceDBFilter struct {
+	UserID     []*uuid.UUID             `json:"user_id,omitempty"`
+	CustomerID []*uuid.UUID             `json:"customer_id,omitempty"`
+	Status     []*enums.RecurringStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,170 @@
+package recurringinvoices
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"invoice-backend/internal/repositories/recurringinvoices/enums"
+	"invoice-backend/internal/shared"
+)
+
+const (
+	tableName = "recurring_invoices"
+)
+
+var ErrRecurringInvoiceNotFound = errors.New("no recurring invoice found with the given ID")
+
+type Repository interface {
+	CreateRecurringInvoice(ctx context.Context, recurring *RecurringInvoice) (*RecurringInvoice, error)
+	GetRecurringInvoiceByID(ctx context.Context, id uuid.UUID) (*RecurringInvoice, error)
+	ListRecurringInvoices(ctx context.Context, filters *RecurringInvoiceDBFilter, pagination shared.Pagination) ([]*RecurringInvoice, error)
+	// UpdateRecurringInvoice persists the template, the schedule and its state.
+	UpdateRecurringInvoice(ctx context.Context, recurring *RecurringInvoice) error
+	DeleteRecurringInvoice(ctx context.Context, id uuid.UUID) error
+	// LockDueRecurringInvoices locks and returns up to limit active recurring invoices whose next run is on or before
+	// today, skipping those locked by another transaction. It must be called on a repository bound to a transaction.
+	LockDueRecurringInvoices(ctx context.Context, today time.Time, limit int) ([]*RecurringInvoice, error)
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) CreateRecurringInvoice(ctx context.Context, recurring *RecurringInvoice) (*RecurringInvoice, error) {
+	if recurring.ID == uuid.Nil {
+		recurring.ID = uuid.New()
+	}
+
+	err := shared.CheckOwner(ctx, recurring.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Table(tableName).Create(recurring).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return recurring, nil
+}
+
+func (s *SQLRepository) GetRecurringInvoiceByID(ctx context.Context, id uuid.UUID) (*RecurringInvoice, error) {
+	var recurring RecurringInvoice
+
+	err := s.db.WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("id = ?", id).First(&recurring).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &recurring, nil
+}
+
+func (s *SQLRepository) ListRecurringInvoices(
+	ctx context.Context,
+	filters *RecurringInvoiceDBFilter,
+	pagination shared.Pagination,
+) ([]*RecurringInvoice, error) {
+	list := make([]*RecurringInvoice, 0)
+
+	dataset, err := shared.BuildDataset(ctx, s.db, tableName, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	paginatedDataset := shared.PaginateDataset(
+		dataset.Scopes(shared.OwnedBy(ctx)).Order("created_at DESC, id DESC"),
+		pagination,
+	)
+
+	err = paginatedDataset.Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (s *SQLRepository) UpdateRecurringInvoice(ctx context.Context, recurring *RecurringInvoice) error {
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", recurring.ID).
+		Updates(map[string]interface{}{
+			"customer_id":        recurring.CustomerID,
+			"currency":           recurring.Currency,
+			"discount_type":      recurring.DiscountType,
+			"discount_value":     recurring.DiscountValue,
+			"shipping_amount":    recurring.ShippingAmount,
+			"items":              recurring.Items,
+			"frequency":          recurring.Frequency,
+			"interval_count":     recurring.Interval,
+			"day_of_month":       recurring.DayOfMonth,
+			"start_date":         recurring.StartDate,
+			"end_date":           recurring.EndDate,
+			"max_count":          recurring.MaxCount,
+			"payment_terms_days": recurring.PaymentTermsDays,
+			"auto_send":          recurring.AutoSend,
+			"status":             recurring.Status,
+			"generated_count":    recurring.GeneratedCount,
+			"next_run_on":        recurring.NextRunOn,
+			"updated_at":         time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrRecurringInvoiceNotFound
+	}
+
+	return nil
+}
+
+func (s *SQLRepository) DeleteRecurringInvoice(ctx context.Context, id uuid.UUID) error {
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", id).
+		Delete(&RecurringInvoice{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrRecurringInvoiceNotFound
+	}
+
+	return nil
+}
+
+func (s *SQLRepository) LockDueRecurringInvoices(ctx context.Context, today time.Time, limit int) ([]*RecurringInvoice, error) {
+	list := make([]*RecurringInvoice, 0)
+
+	err := s.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("status = ? AND next_run_on <= ?", enums.RecurringStatusActive, day(today)).
+		Order("next_run_on ASC, id ASC").
+		Limit(limit).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,40 @@
+package recurringinvoices
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestLockDueRecurringInvoicesSkipsLockedRows(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+
+	_, err := NewSQLRepository(db).LockDueRecurringInvoices(
+		auth.AsSystem(context.Background()),
+		time.Date(2026, time.October, 18, 15, 30, 0, 0, time.UTC),
+		1,
+	)
+	require.NoError(t, err)
+
+	assert.Contains(t, recorder.Last(), `status = 'active' AND next_run_on <= '2026-10-18 00:00:00'`)
+	assert.Contains(t, recorder.Last(), `ORDER BY next_run_on ASC, id ASC LIMIT 1 FOR UPDATE SKIP LOCKED`)
+}
+
+func TestUpdateRecurringInvoiceIsScopedToTheOwner(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	userID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: userID})
+
+	err := NewSQLRepository(db).UpdateRecurringInvoice(ctx, &RecurringInvoice{ID: uuid.New(), UserID: userID})
+
+	assert.ErrorIs(t, err, ErrRecurringInvoiceNotFound, "the dry run database affects no rows")
+	assert.Contains(t, recorder.Last(), `UPDATE "recurring_invoices" SET`)
+	assert.Contains(t, recorder.Last(), `"interval_count"=0`)
+	assert.Contains(t, recorder.Last(), `"recurring_invoices"."user_id" = '`+userID.String()+`'`)
+}
@@ -0,0 +1,162 @@
+package recurringinvoices
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"invoice-backend/internal/repositories/recurringinvoices/enums"
+)
+
+const (
+	maxInterval         = 52
+	maxPaymentTermsDays = 365
+)
+
+var ErrInvalidSchedule = errors.New("invalid recurring invoice schedule")
+
+// Validate checks the schedule and the template of a recurring invoice.
+func (r *RecurringInvoice) Validate() error {
+	if !r.Frequency.IsValid() {
+		return fmt.Errorf("%w: unknown frequency %q", ErrInvalidSchedule, r.Frequency)
+	}
+
+	if r.Interval < 1 || r.Interval > maxInterval {
+		return fmt.Errorf("%w: interval must be between 1 and %d", ErrInvalidSchedule, maxInterval)
+	}
+
+	if r.DayOfMonth != nil {
+		if r.Frequency == enums.FrequencyWeekly {
+			return fmt.Errorf("%w: day of month does not apply to weekly schedules", ErrInvalidSchedule)
+		}
+
+		if *r.DayOfMonth < 1 || *r.DayOfMonth > 31 {
+			return fmt.Errorf("%w: day of month must be between 1 and 31", ErrInvalidSchedule)
+		}
+	}
+
+	if r.EndDate != nil && day(*r.EndDate).Before(day(r.StartDate)) {
+		return fmt.Errorf("%w: end date is before the start date", ErrInvalidSchedule)
+	}
+
+	if r.MaxCount != nil && *r.MaxCount < 1 {
+		return fmt.Errorf("%w: count must be positive", ErrInvalidSchedule)
+	}
+
+	if r.PaymentTermsDays < 0 || r.PaymentTermsDays > maxPaymentTermsDays {
+		return fmt.Errorf("%w: payment terms must be between 0 and %d days", ErrInvalidSchedule, maxPaymentTermsDays)
+	}
+
+	if len(r.Items) == 0 {
+		return fmt.Errorf("%w: at least one item is required", ErrInvalidSchedule)
+	}
+
+	return nil
+}
+
+// Occurrence returns the date of the n-th run of the schedule, counting from 0 at the start date.
+func (r *RecurringInvoice) Occurrence(n int) time.Time {
+	start := day(r.StartDate)
+	periods := n * r.Interval
+
+	switch r.Frequency {
+	case enums.FrequencyWeekly:
+		return start.AddDate(0, 0, 7*periods)
+	case enums.FrequencyYearly:
+		return r.dayInMonth(start.Year()+periods, start.Month())
+	default:
+		return r.dayInMonth(start.Year(), start.Month()+time.Month(periods))
+	}
+}
+
+// NextRunFrom returns the first run on or after from, or nil when the schedule has ended by then.
+func (r *RecurringInvoice) NextRunFrom(from time.Time) *time.Time {
+	if r.MaxCount != nil && r.GeneratedCount >= *r.MaxCount {
+		return nil
+	}
+
+	from = day(from)
+
+	// Occurrences only move forward, and the one after the last run on or before from is after from, so the search
+	// ends within a couple of iterations however long the schedule has been running.
+	for n := r.occurrencesBefore(from); ; n++ {
+		occurrence := r.Occurrence(n)
+		if occurrence.Before(from) || occurrence.Before(day(r.StartDate)) {
+			continue
+		}
+
+		if r.EndDate != nil && occurrence.After(day(*r.EndDate)) {
+			return nil
+		}
+
+		return &occurrence
+	}
+}
+
+// occurrencesBefore returns the number of whole intervals between the start date and from, the index of the last
+// occurrence that may still fall on or before from.
+func (r *RecurringInvoice) occurrencesBefore(from time.Time) int {
+	start := day(r.StartDate)
+	if !from.After(start) {
+		return 0
+	}
+
+	var periods int
+
+	switch r.Frequency {
+	case enums.FrequencyWeekly:
+		periods = int(from.Sub(start).Hours()/24) / 7
+	case enums.FrequencyYearly:
+		periods = from.Year() - start.Year()
+	default:
+		periods = (from.Year()-start.Year())*12 + int(from.Month()-start.Month())
+	}
+
+	return periods / r.Interval
+}
+
+// Upcoming returns up to limit of the next runs, starting at the scheduled next run.
+func (r *RecurringInvoice) Upcoming(limit int) []time.Time {
+	runs := make([]time.Time, 0, limit)
+	preview := *r
+
+	next := preview.NextRunOn
+	for next != nil && len(runs) < limit {
+		runs = append(runs, *next)
+		preview.GeneratedCount++
+		next = preview.NextRunFrom(next.AddDate(0, 0, 1))
+	}
+
+	return runs
+}
+
+// Advance records a run on runOn and schedules the next one, completing the schedule when it has ended.
+func (r *RecurringInvoice) Advance(runOn time.Time) {
+	r.GeneratedCount++
+	r.NextRunOn = r.NextRunFrom(day(runOn).AddDate(0, 0, 1))
+
+	if r.NextRunOn == nil {
+		r.Status = enums.RecurringStatusCompleted
+	}
+}
+
+// dayInMonth returns the configured day of the given month, or the day of the start date, moved back to the last
+// day of shorter months. The month may overflow into the following years.
+func (r *RecurringInvoice) dayInMonth(year int, month time.Month) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+
+	dayOfMonth := r.StartDate.Day()
+	if r.DayOfMonth != nil {
+		dayOfMonth = *r.DayOfMonth
+	}
+
+	return first.AddDate(0, 0, min(dayOfMonth, last)-1)
+}
+
+// day truncates t to its calendar day in UTC, the time zone dates are stored in.
+func day(t time.Time) time.Time {
+	year, month, dayOfMonth := t.UTC().Date()
+
+	return time.Date(year, month, dayOfMonth, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,216 @@
+package recurringinvoices
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/repositories/recurringinvoices/enums"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func monthly(start time.Time) *RecurringInvoice {
+	return &RecurringInvoice{
+		Frequency: enums.FrequencyMonthly,
+		Interval:  1,
+		StartDate: start,
+		Status:    enums.RecurringStatusActive,
+		Items:     Items{{Description: "Hosting", Quantity: 1}},
+	}
+}
+
+func TestOccurrence(t *testing.T) {
+	tests := []struct {
+		name      string
+		recurring *RecurringInvoice
+		n         int
+		expected  time.Time
+	}{
+		{
+			name:      "weekly",
+			recurring: &RecurringInvoice{Frequency: enums.FrequencyWeekly, Interval: 2, StartDate: date(2026, time.October, 5)},
+			n:         3,
+			expected:  date(2026, time.November, 16),
+		},
+		{
+			name:      "monthly clamps to the end of shorter months",
+			recurring: monthly(date(2026, time.January, 31)),
+			n:         1,
+			expected:  date(2026, time.February, 28),
+		},
+		{
+			name:      "monthly returns to the start day after a short month",
+			recurring: monthly(date(2026, time.January, 31)),
+			n:         2,
+			expected:  date(2026, time.March, 31),
+		},
+		{
+			name:      "monthly overflows into the next year",
+			recurring: &RecurringInvoice{Frequency: enums.FrequencyMonthly, Interval: 5, StartDate: date(2026, time.October, 15)},
+			n:         1,
+			expected:  date(2027, time.March, 15),
+		},
+		{
+			name: "day of month",
+			recurring: &RecurringInvoice{
+				Frequency:  enums.FrequencyMonthly,
+				Interval:   1,
+				StartDate:  date(2026, time.October, 18),
+				DayOfMonth: lo.ToPtr(1),
+			},
+			n:        2,
+			expected: date(2026, time.December, 1),
+		},
+		{
+			name:      "yearly on a leap day",
+			recurring: &RecurringInvoice{Frequency: enums.FrequencyYearly, Interval: 1, StartDate: date(2028, time.February, 29)},
+			n:         1,
+			expected:  date(2029, time.February, 28),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.recurring.Occurrence(tt.n))
+		})
+	}
+}
+
+func TestNextRunFrom(t *testing.T) {
+	recurring := monthly(date(2026, time.October, 15))
+
+	assert.Equal(t, date(2026, time.October, 15), lo.FromPtr(recurring.NextRunFrom(date(2026, time.September, 1))), "before the start")
+	assert.Equal(t, date(2026, time.October, 15), lo.FromPtr(recurring.NextRunFrom(date(2026, time.October, 15))), "on a run")
+	assert.Equal(t, date(2026, time.November, 15), lo.FromPtr(recurring.NextRunFrom(date(2026, time.October, 16))), "between runs")
+
+	recurring.EndDate = lo.ToPtr(date(2026, time.November, 30))
+	assert.Nil(t, recurring.NextRunFrom(date(2026, time.November, 16)), "after the end date")
+
+	recurring.EndDate = nil
+	recurring.MaxCount = lo.ToPtr(2)
+	recurring.GeneratedCount = 2
+	assert.Nil(t, recurring.NextRunFrom(date(2026, time.October, 1)), "count reached")
+}
+
+func TestNextRunFromLongRunningSchedules(t *testing.T) {
+	tests := []struct {
+		name      string
+		recurring *RecurringInvoice
+		from      time.Time
+		expected  time.Time
+	}{
+		{
+			name:      "weekly started decades ago",
+			recurring: &RecurringInvoice{Frequency: enums.FrequencyWeekly, Interval: 1, StartDate: date(1990, time.January, 1)},
+			from:      date(2026, time.October, 18),
+			expected:  date(2026, time.October, 19),
+		},
+		{
+			name:      "every other week on a run",
+			recurring: &RecurringInvoice{Frequency: enums.FrequencyWeekly, Interval: 2, StartDate: date(2000, time.January, 3)},
+			from:      date(2026, time.October, 19),
+			expected:  date(2026, time.October, 19),
+		},
+		{
+			name:      "monthly later in the month",
+			recurring: monthly(date(1990, time.January, 31)),
+			from:      date(2027, time.February, 15),
+			expected:  date(2027, time.February, 28),
+		},
+		{
+			name:      "monthly past the run of the month",
+			recurring: &RecurringInvoice{Frequency: enums.FrequencyMonthly, Interval: 3, StartDate: date(1990, time.January, 15)},
+			from:      date(2026, time.October, 16),
+			expected:  date(2027, time.January, 15),
+		},
+		{
+			name: "monthly on a day of month before the start day",
+			recurring: &RecurringInvoice{
+				Frequency:  enums.FrequencyMonthly,
+				Interval:   1,
+				StartDate:  date(1990, time.January, 20),
+				DayOfMonth: lo.ToPtr(5),
+			},
+			from:     date(2026, time.October, 18),
+			expected: date(2026, time.November, 5),
+		},
+		{
+			name:      "yearly",
+			recurring: &RecurringInvoice{Frequency: enums.FrequencyYearly, Interval: 2, StartDate: date(1900, time.March, 1)},
+			from:      date(2026, time.October, 18),
+			expected:  date(2028, time.March, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, lo.FromPtr(tt.recurring.NextRunFrom(tt.from)))
+		})
+	}
+}
+
+func TestAdvanceCompletesTheSchedule(t *testing.T) {
+	recurring := monthly(date(2026, time.October, 15))
+	recurring.MaxCount = lo.ToPtr(2)
+	recurring.NextRunOn = recurring.NextRunFrom(recurring.StartDate)
+
+	recurring.Advance(*recurring.NextRunOn)
+
+	assert.Equal(t, 1, recurring.GeneratedCount)
+	assert.Equal(t, date(2026, time.November, 15), lo.FromPtr(recurring.NextRunOn))
+	assert.Equal(t, enums.RecurringStatusActive, recurring.Status)
+
+	recurring.Advance(*recurring.NextRunOn)
+
+	assert.Equal(t, 2, recurring.GeneratedCount)
+	assert.Nil(t, recurring.NextRunOn)
+	assert.Equal(t, enums.RecurringStatusCompleted, recurring.Status)
+}
+
+func TestUpcoming(t *testing.T) {
+	recurring := monthly(date(2026, time.October, 31))
+	recurring.MaxCount = lo.ToPtr(3)
+	recurring.GeneratedCount = 1
+	recurring.NextRunOn = lo.ToPtr(date(2026, time.November, 30))
+
+	runs := recurring.Upcoming(5)
+
+	assert.Equal(t, []time.Time{date(2026, time.November, 30), date(2026, time.December, 31)}, runs)
+	assert.Equal(t, 1, recurring.GeneratedCount, "the recurring invoice is left untouched")
+}
+
+func TestValidate(t *testing.T) {
+	valid := monthly(date(2026, time.October, 15))
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(r *RecurringInvoice)
+	}{
+		{name: "unknown frequency", modify: func(r *RecurringInvoice) { r.Frequency = "daily" }},
+		{name: "zero interval", modify: func(r *RecurringInvoice) { r.Interval = 0 }},
+		{name: "day of month on a weekly schedule", modify: func(r *RecurringInvoice) {
+			r.Frequency = enums.FrequencyWeekly
+			r.DayOfMonth = lo.ToPtr(1)
+		}},
+		{name: "day of month out of range", modify: func(r *RecurringInvoice) { r.DayOfMonth = lo.ToPtr(32) }},
+		{name: "end before start", modify: func(r *RecurringInvoice) { r.EndDate = lo.ToPtr(date(2026, time.October, 1)) }},
+		{name: "zero count", modify: func(r *RecurringInvoice) { r.MaxCount = lo.ToPtr(0) }},
+		{name: "negative payment terms", modify: func(r *RecurringInvoice) { r.PaymentTermsDays = -1 }},
+		{name: "no items", modify: func(r *RecurringInvoice) { r.Items = nil }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurring := monthly(date(2026, time.October, 15))
+			tt.modify(recurring)
+
+			assert.ErrorIs(t, recurring.Validate(), ErrInvalidSchedule)
+		})
+	}
+}
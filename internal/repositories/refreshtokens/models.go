@@ -0,0 +1,21 @@
+package refreshtokens
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken is an issued refresh token. Only the SHA-256 hash of the token is stored.
+type RefreshToken struct {
+	ID        uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null"`
+	TokenHash string     `gorm:"type:char(64);not null"`
+	ExpiresAt time.Time  `gorm:"not null"`
+	RevokedAt *time.Time // Set on logout and when the token is rotated
+	CreatedAt time.Time  `gorm:"autoCreateTime"`
+}
+
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,88 @@
+package refreshtokens
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	tableName = "refresh_tokens"
+)
+
+var ErrRefreshTokenRevoked = errors.New("refresh token already revoked")
+
+type Repository interface {
+	CreateRefreshToken(ctx context.Context, token *RefreshToken) (*RefreshToken, error)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// RevokeRefreshToken revokes a live token. It returns ErrRefreshTokenRevoked when the token was revoked
+	// already, so concurrent refreshes cannot both rotate the same token.
+	RevokeRefreshToken(ctx context.Context, tokenID uuid.UUID) error
+	RevokeUserRefreshTokens(ctx context.Context, userID uuid.UUID) error
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) CreateRefreshToken(ctx context.Context, token *RefreshToken) (*RefreshToken, error) {
+	if token.ID == uuid.Nil {
+		token.ID = uuid.New()
+	}
+
+	err := s.db.WithContext(ctx).Table(tableName).Create(token).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (s *SQLRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*RefreshToken, error) {
+	var token RefreshToken
+
+	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Where("token_hash = ?", tokenHash).
+		First(&token).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (s *SQLRepository) RevokeRefreshToken(ctx context.Context, tokenID uuid.UUID) error {
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Where("id = ? AND revoked_at IS NULL", tokenID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrRefreshTokenRevoked
+	}
+
+	return nil
+}
+
+func (s *SQLRepository) RevokeUserRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	return s.db.WithContext(ctx).
+		Table(tableName).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).
+		Error
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
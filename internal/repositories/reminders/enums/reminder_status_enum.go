@@ -0,0 +1,48 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package enums
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// ReminderStatusPending is a ReminderStatus of type pending.
+	ReminderStatusPending ReminderStatus = "pending"
+	// ReminderStatusSent is a ReminderStatus of type sent.
+	ReminderStatusSent ReminderStatus = "sent"
+	// ReminderStatusFailed is a ReminderStatus of type failed.
+	ReminderStatusFailed ReminderStatus = "failed"
+)
+
+var ErrInvalidReminderStatus = errors.New("not a valid ReminderStatus")
+
+// String implements the Stringer interface.
+func (x ReminderStatus) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x ReminderStatus) IsValid() bool {
+	_, err := ParseReminderStatus(string(x))
+	return err == nil
+}
+
+var _ReminderStatusValue = map[string]ReminderStatus{
+	"pending": ReminderStatusPending,
+	"sent":    ReminderStatusSent,
+	"failed":  ReminderStatusFailed,
+}
+
+// ParseReminderStatus attempts to convert a string to a ReminderStatus.
+func ParseReminderStatus(name string) (ReminderStatus, error) {
+	if x, ok := _ReminderStatusValue[name]; ok {
+		return x, nil
+	}
+	return ReminderStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidReminderStatus)
+}
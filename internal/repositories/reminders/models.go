@@ -0,0 +1,32 @@
+package reminders
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"invoice-backend/internal/repositories/reminders/enums"
+)
+
+// Rule schedules payment reminders relative to the due date of an invoice, e.g. 3 days before it or every 7 days
+// once it has passed. The rules of a user form their reminder policy.
+type Rule struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID     uuid.UUID `gorm:"type:uuid;not null"`
+	OffsetDays int       `gorm:"not null"` // Days between the due date and the first reminder, negative before it
+	RepeatDays *int      // Days between the following reminders, nil sends a single reminder
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// Reminder claims the reminder of an invoice for one day. It is stored before the reminder is sent and its unique
+// (invoice_id, scheduled_on) key keeps a reminder from going out twice, across restarts and replicas.
+type Reminder struct {
+	ID          uuid.UUID            `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID      uuid.UUID            `gorm:"type:uuid;not null"`
+	InvoiceID   uuid.UUID            `gorm:"type:uuid;not null"`
+	ScheduledOn time.Time            `gorm:"type:date;not null"`
+	Status      enums.ReminderStatus `gorm:"not null"`
+	Error       *string              // Channel error of failed reminders
+	SentAt      *time.Time
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
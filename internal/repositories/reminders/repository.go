@@ -0,0 +1,157 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"invoice-backend/internal/repositories/reminders/enums"
+	"invoice-backend/internal/shared"
+)
+
+const (
+	rulesTableName     = "reminder_rules"
+	remindersTableName = "invoice_reminders"
+)
+
+var ErrReminderNotFound = errors.New("reminder not found")
+
+type Repository interface {
+	// GetRules returns the reminder policy of a user, ordered by offset.
+	GetRules(ctx context.Context, userID uuid.UUID) ([]*Rule, error)
+	// ReplaceRules replaces the reminder policy of a user with the given rules.
+	ReplaceRules(ctx context.Context, userID uuid.UUID, rules []*Rule) ([]*Rule, error)
+	// ListRules returns the rules of every user visible to the context.
+	ListRules(ctx context.Context) ([]*Rule, error)
+	// ClaimReminder stores a pending reminder and reports whether it was claimed, it was not when a reminder for
+	// the same invoice and day already exists.
+	ClaimReminder(ctx context.Context, reminder *Reminder) (bool, error)
+	MarkSent(ctx context.Context, reminderID uuid.UUID, sentAt time.Time) error
+	MarkFailed(ctx context.Context, reminderID uuid.UUID, reason string) error
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) GetRules(ctx context.Context, userID uuid.UUID) ([]*Rule, error) {
+	rules := make([]*Rule, 0)
+
+	err := s.db.WithContext(ctx).
+		Table(rulesTableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("user_id = ?", userID).
+		Order("offset_days ASC, repeat_days ASC NULLS FIRST").
+		Find(&rules).Error
+
+	return rules, err
+}
+
+func (s *SQLRepository) ReplaceRules(ctx context.Context, userID uuid.UUID, rules []*Rule) ([]*Rule, error) {
+	err := shared.CheckOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		rule.UserID = userID
+		if rule.ID == uuid.Nil {
+			rule.ID = uuid.New()
+		}
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(rulesTableName).Where("user_id = ?", userID).Delete(&Rule{}).Error
+		if err != nil || len(rules) == 0 {
+			return err
+		}
+
+		return tx.Table(rulesTableName).Create(rules).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (s *SQLRepository) ListRules(ctx context.Context) ([]*Rule, error) {
+	rules := make([]*Rule, 0)
+
+	err := s.db.WithContext(ctx).
+		Table(rulesTableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Order("user_id ASC, offset_days ASC").
+		Find(&rules).Error
+
+	return rules, err
+}
+
+func (s *SQLRepository) ClaimReminder(ctx context.Context, reminder *Reminder) (bool, error) {
+	if reminder.ID == uuid.Nil {
+		reminder.ID = uuid.New()
+	}
+
+	err := shared.CheckOwner(ctx, reminder.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	reminder.Status = enums.ReminderStatusPending
+
+	result := s.db.WithContext(ctx).
+		Table(remindersTableName).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "invoice_id"}, {Name: "scheduled_on"}},
+			DoNothing: true,
+		}).
+		Create(reminder)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (s *SQLRepository) MarkSent(ctx context.Context, reminderID uuid.UUID, sentAt time.Time) error {
+	return s.updateStatus(ctx, reminderID, map[string]interface{}{
+		"status":  enums.ReminderStatusSent,
+		"sent_at": sentAt,
+		"error":   nil,
+	})
+}
+
+func (s *SQLRepository) MarkFailed(ctx context.Context, reminderID uuid.UUID, reason string) error {
+	return s.updateStatus(ctx, reminderID, map[string]interface{}{
+		"status": enums.ReminderStatusFailed,
+		"error":  reason,
+	})
+}
+
+func (s *SQLRepository) updateStatus(ctx context.Context, reminderID uuid.UUID, updates map[string]interface{}) error {
+	updates["updated_at"] = time.Now()
+
+	result := s.db.WithContext(ctx).
+		Table(remindersTableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", reminderID).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrReminderNotFound
+	}
+
+	return nil
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,40 @@
+package reminders
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestClaimReminderIgnoresExistingReminders(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	reminder := &Reminder{
+		UserID:      uuid.New(),
+		InvoiceID:   uuid.New(),
+		ScheduledOn: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+	}
+
+	claimed, err := NewSQLRepository(db).ClaimReminder(auth.AsSystem(context.Background()), reminder)
+	require.NoError(t, err)
+
+	assert.False(t, claimed, "the dry run database affects no rows")
+	assert.Contains(t, recorder.Last(), `INSERT INTO "invoice_reminders"`)
+	assert.Contains(t, recorder.Last(), `ON CONFLICT ("invoice_id","scheduled_on") DO NOTHING`)
+	assert.Contains(t, recorder.Last(), `'pending'`)
+}
+
+func TestClaimReminderChecksTheOwner(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: uuid.New()})
+
+	_, err := NewSQLRepository(db).ClaimReminder(ctx, &Reminder{UserID: uuid.New(), InvoiceID: uuid.New()})
+
+	assert.Error(t, err)
+	assert.Empty(t, recorder.Statements())
+}
@@ -0,0 +1,99 @@
+package reminders
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	MaxRules = 10
+
+	maxOffsetDays = 365
+	maxRepeatDays = 365
+
+	// CatchUpDays is how long a reminder missed while the worker was down is still sent.
+	CatchUpDays = 2
+)
+
+var ErrInvalidRule = errors.New("invalid reminder rule")
+
+// Validate checks a reminder policy: a bounded number of rules with distinct schedules.
+func Validate(rules []*Rule) error {
+	if len(rules) > MaxRules {
+		return fmt.Errorf("%w: at most %d rules are allowed", ErrInvalidRule, MaxRules)
+	}
+
+	seen := make(map[string]bool, len(rules))
+
+	for _, rule := range rules {
+		if rule.OffsetDays < -maxOffsetDays || rule.OffsetDays > maxOffsetDays {
+			return fmt.Errorf("%w: offset must be between -%d and %d days", ErrInvalidRule, maxOffsetDays, maxOffsetDays)
+		}
+
+		if rule.RepeatDays != nil && (*rule.RepeatDays < 1 || *rule.RepeatDays > maxRepeatDays) {
+			return fmt.Errorf("%w: repeat interval must be between 1 and %d days", ErrInvalidRule, maxRepeatDays)
+		}
+
+		key := fmt.Sprintf("%d/%d", rule.OffsetDays, lo.FromPtr(rule.RepeatDays))
+
+		if seen[key] {
+			return fmt.Errorf("%w: duplicate rule", ErrInvalidRule)
+		}
+
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// LastOccurrence returns the most recent day, up to today, the rule schedules a reminder for an invoice due on
+// dueDate. Both dates must be calendar days, see Day.
+func (r *Rule) LastOccurrence(dueDate, today time.Time) (time.Time, bool) {
+	first := dueDate.AddDate(0, 0, r.OffsetDays)
+	if first.After(today) {
+		return time.Time{}, false
+	}
+
+	if r.RepeatDays == nil {
+		return first, true
+	}
+
+	elapsed := daysBetween(first, today)
+
+	return first.AddDate(0, 0, elapsed-elapsed%*r.RepeatDays), true
+}
+
+// Due returns the day a reminder is due for an invoice issued on issueDate and due on dueDate, if any. Reminders
+// are never scheduled before the invoice was issued, and when several rules match the latest one wins.
+func Due(rules []*Rule, issueDate, dueDate, today time.Time) (time.Time, bool) {
+	var due time.Time
+
+	issueDate, dueDate, today = Day(issueDate), Day(dueDate), Day(today)
+
+	for _, rule := range rules {
+		occurrence, ok := rule.LastOccurrence(dueDate, today)
+		if !ok || occurrence.Before(issueDate) || daysBetween(occurrence, today) > CatchUpDays {
+			continue
+		}
+
+		if occurrence.After(due) {
+			due = occurrence
+		}
+	}
+
+	return due, !due.IsZero()
+}
+
+// Day truncates t to its calendar day in UTC, the time zone dates are stored in.
+func Day(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
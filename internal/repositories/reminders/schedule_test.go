@@ -0,0 +1,94 @@
+package reminders
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(month time.Month, day int) time.Time {
+	return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestLastOccurrence(t *testing.T) {
+	dueDate := date(time.October, 10)
+
+	tests := []struct {
+		name     string
+		rule     Rule
+		today    time.Time
+		expected time.Time
+		ok       bool
+	}{
+		{name: "before the first reminder", rule: Rule{OffsetDays: -3}, today: date(time.October, 6), ok: false},
+		{name: "days before the due date", rule: Rule{OffsetDays: -3}, today: date(time.October, 7), expected: date(time.October, 7), ok: true},
+		{name: "single reminder stays in the past", rule: Rule{OffsetDays: 0}, today: date(time.October, 20), expected: date(time.October, 10), ok: true},
+		{name: "repeating on an occurrence", rule: Rule{OffsetDays: 7, RepeatDays: lo.ToPtr(7)}, today: date(time.October, 24), expected: date(time.October, 24), ok: true},
+		{name: "repeating between occurrences", rule: Rule{OffsetDays: 7, RepeatDays: lo.ToPtr(7)}, today: date(time.October, 30), expected: date(time.October, 24), ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			occurrence, ok := tt.rule.LastOccurrence(dueDate, tt.today)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, occurrence)
+		})
+	}
+}
+
+func TestDue(t *testing.T) {
+	rules := []*Rule{
+		{OffsetDays: -3},
+		{OffsetDays: 0},
+		{OffsetDays: 7, RepeatDays: lo.ToPtr(7)},
+	}
+	issueDate := date(time.October, 1)
+	dueDate := time.Date(2026, time.October, 10, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		issueDate time.Time
+		today     time.Time
+		expected  time.Time
+		ok        bool
+	}{
+		{name: "nothing scheduled yet", issueDate: issueDate, today: date(time.October, 5), ok: false},
+		{name: "before the due date", issueDate: issueDate, today: date(time.October, 7), expected: date(time.October, 7), ok: true},
+		{name: "on the due date", issueDate: issueDate, today: time.Date(2026, time.October, 10, 23, 0, 0, 0, time.UTC), expected: date(time.October, 10), ok: true},
+		{name: "missed reminder is caught up", issueDate: issueDate, today: date(time.October, 12), expected: date(time.October, 10), ok: true},
+		{name: "missed reminder expires", issueDate: issueDate, today: date(time.October, 13), ok: false},
+		{name: "repeating after the due date", issueDate: issueDate, today: date(time.October, 24), expected: date(time.October, 24), ok: true},
+		{name: "not before the issue date", issueDate: date(time.October, 8), today: date(time.October, 7), ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			due, ok := Due(rules, tt.issueDate, dueDate, tt.today)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, due)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate([]*Rule{{OffsetDays: -3}, {OffsetDays: 0}, {OffsetDays: 7, RepeatDays: lo.ToPtr(7)}}))
+	require.NoError(t, Validate(nil))
+
+	tests := map[string][]*Rule{
+		"offset too large":    {{OffsetDays: 400}},
+		"repeat not positive": {{OffsetDays: 0, RepeatDays: lo.ToPtr(0)}},
+		"duplicate rule":      {{OffsetDays: 7, RepeatDays: lo.ToPtr(7)}, {OffsetDays: 7, RepeatDays: lo.ToPtr(7)}},
+		"too many rules":      lo.Times(MaxRules+1, func(i int) *Rule { return &Rule{OffsetDays: i} }),
+	}
+
+	for name, rules := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, Validate(rules), ErrInvalidRule)
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package enums
+
+// DocumentType ENUM(invoice, credit_note, estimate)
+//
+//go:generate go run github.com/abice/go-enum@v0.5.5
+type DocumentType string
@@ -0,0 +1,48 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package enums
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// DocumentTypeInvoice is a DocumentType of type invoice.
+	DocumentTypeInvoice DocumentType = "invoice"
+	// DocumentTypeCreditNote is a DocumentType of type credit_note.
+	DocumentTypeCreditNote DocumentType = "credit_note"
+	// DocumentTypeEstimate is a DocumentType of type estimate.
+	DocumentTypeEstimate DocumentType = "estimate"
+)
+
+var ErrInvalidDocumentType = errors.New("not a valid DocumentType")
+
+// String implements the Stringer interface.
+func (x DocumentType) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x DocumentType) IsValid() bool {
+	_, err := ParseDocumentType(string(x))
+	return err == nil
+}
+
+var _DocumentTypeValue = map[string]DocumentType{
+	"invoice":     DocumentTypeInvoice,
+	"credit_note": DocumentTypeCreditNote,
+	"estimate":    DocumentTypeEstimate,
+}
+
+// ParseDocumentType attempts to convert a string to a DocumentType.
+func ParseDocumentType(name string) (DocumentType, error) {
+	if x, ok := _DocumentTypeValue[name]; ok {
+		return x, nil
+	}
+	return DocumentType(""), fmt.Errorf("%s is %w", name, ErrInvalidDocumentType)
+}
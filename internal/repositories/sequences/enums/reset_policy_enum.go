@@ -0,0 +1,45 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version:
+// Revision:
+// Build Date:
+// Built By:
+
+package enums
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// ResetPolicyNever is a ResetPolicy of type never.
+	ResetPolicyNever ResetPolicy = "never"
+	// ResetPolicyYearly is a ResetPolicy of type yearly.
+	ResetPolicyYearly ResetPolicy = "yearly"
+)
+
+var ErrInvalidResetPolicy = errors.New("not a valid ResetPolicy")
+
+// String implements the Stringer interface.
+func (x ResetPolicy) String() string {
+	return string(x)
+}
+
+// String implements the Stringer interface.
+func (x ResetPolicy) IsValid() bool {
+	_, err := ParseResetPolicy(string(x))
+	return err == nil
+}
+
+var _ResetPolicyValue = map[string]ResetPolicy{
+	"never":  ResetPolicyNever,
+	"yearly": ResetPolicyYearly,
+}
+
+// ParseResetPolicy attempts to convert a string to a ResetPolicy.
+func ParseResetPolicy(name string) (ResetPolicy, error) {
+	if x, ok := _ResetPolicyValue[name]; ok {
+		return x, nil
+	}
+	return ResetPolicy(""), fmt.Errorf("%s is %w", name, ErrInvalidResetPolicy)
+}
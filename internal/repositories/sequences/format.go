@@ -0,0 +1,124 @@
+package sequences
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"invoice-backend/internal/repositories/sequences/enums"
+)
+
+const (
+	tokenPrefix      = "PREFIX"
+	tokenYear        = "YYYY"
+	tokenShortYear   = "YY"
+	tokenMonth       = "MM"
+	tokenSequence    = "SEQ"
+	maxSequenceWidth = 12
+	maxPrefixLength  = 20
+)
+
+var (
+	ErrInvalidTemplate = errors.New("invalid numbering template")
+	ErrInvalidPrefix   = errors.New("invalid numbering prefix")
+
+	templateTokenRE = regexp.MustCompile(`\{([A-Z]+)(?::(\d+))?}`)
+	prefixRE        = regexp.MustCompile(`^[A-Za-z0-9_/-]*$`)
+)
+
+// ValidateTemplate checks that a template such as "{PREFIX}-{YYYY}-{SEQ:5}" only uses known placeholders,
+// contains exactly one sequence placeholder and, when numbers reset yearly, includes the year so that
+// numbers from different years never collide.
+func ValidateTemplate(template string, resetPolicy enums.ResetPolicy) error {
+	sequenceCount := 0
+	hasYear := false
+
+	for _, match := range templateTokenRE.FindAllStringSubmatch(template, -1) {
+		token, width := match[1], match[2]
+
+		switch token {
+		case tokenSequence:
+			sequenceCount++
+
+			if width != "" {
+				parsedWidth, _ := strconv.Atoi(width)
+				if parsedWidth < 1 || parsedWidth > maxSequenceWidth {
+					return fmt.Errorf("%w: {SEQ} width must be between 1 and %d", ErrInvalidTemplate, maxSequenceWidth)
+				}
+			}
+		case tokenYear, tokenShortYear, tokenPrefix, tokenMonth:
+			if width != "" {
+				return fmt.Errorf("%w: {%s} does not accept a width", ErrInvalidTemplate, token)
+			}
+
+			hasYear = hasYear || token == tokenYear || token == tokenShortYear
+		default:
+			return fmt.Errorf("%w: unknown placeholder {%s}", ErrInvalidTemplate, token)
+		}
+	}
+
+	if strings.ContainsAny(templateTokenRE.ReplaceAllString(template, ""), "{}") {
+		return fmt.Errorf("%w: unbalanced braces", ErrInvalidTemplate)
+	}
+
+	if sequenceCount != 1 {
+		return fmt.Errorf("%w: exactly one {SEQ} placeholder is required", ErrInvalidTemplate)
+	}
+
+	if resetPolicy == enums.ResetPolicyYearly && !hasYear {
+		return fmt.Errorf("%w: templates that reset yearly must contain {YYYY} or {YY}", ErrInvalidTemplate)
+	}
+
+	return nil
+}
+
+func ValidatePrefix(prefix string) error {
+	if len(prefix) > maxPrefixLength || !prefixRE.MatchString(prefix) {
+		return fmt.Errorf(
+			"%w: up to %d letters, digits, '-', '_' or '/' are allowed",
+			ErrInvalidPrefix,
+			maxPrefixLength,
+		)
+	}
+
+	return nil
+}
+
+// FormatNumber renders a document number for the given sequence value.
+func FormatNumber(template, prefix string, at time.Time, value int64) string {
+	return templateTokenRE.ReplaceAllStringFunc(template, func(placeholder string) string {
+		match := templateTokenRE.FindStringSubmatch(placeholder)
+
+		switch match[1] {
+		case tokenPrefix:
+			return prefix
+		case tokenYear:
+			return fmt.Sprintf("%04d", at.Year())
+		case tokenShortYear:
+			return fmt.Sprintf("%02d", at.Year()%100)
+		case tokenMonth:
+			return fmt.Sprintf("%02d", int(at.Month()))
+		case tokenSequence:
+			width, parseErr := strconv.Atoi(match[2])
+			if parseErr != nil {
+				width = 1
+			}
+
+			return fmt.Sprintf("%0*d", width, value)
+		default:
+			return placeholder
+		}
+	})
+}
+
+// Period returns the sequence bucket a document issued at the given time belongs to.
+func Period(resetPolicy enums.ResetPolicy, at time.Time) string {
+	if resetPolicy == enums.ResetPolicyYearly {
+		return strconv.Itoa(at.Year())
+	}
+
+	return ""
+}
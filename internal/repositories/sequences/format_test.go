@@ -0,0 +1,56 @@
+package sequences
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"invoice-backend/internal/repositories/sequences/enums"
+)
+
+func TestValidateTemplate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		template    string
+		resetPolicy enums.ResetPolicy
+		valid       bool
+	}{
+		{name: "prefix, year and padded sequence", template: "{PREFIX}-{YYYY}-{SEQ:5}", resetPolicy: enums.ResetPolicyYearly, valid: true},
+		{name: "legacy format", template: "{PREFIX}{SEQ:7}", resetPolicy: enums.ResetPolicyNever, valid: true},
+		{name: "short year and month", template: "{YY}{MM}/{SEQ}", resetPolicy: enums.ResetPolicyYearly, valid: true},
+		{name: "missing sequence", template: "{PREFIX}-{YYYY}", resetPolicy: enums.ResetPolicyNever, valid: false},
+		{name: "two sequences", template: "{SEQ}-{SEQ}", resetPolicy: enums.ResetPolicyNever, valid: false},
+		{name: "unknown placeholder", template: "{PREFIX}-{DAY}-{SEQ}", resetPolicy: enums.ResetPolicyNever, valid: false},
+		{name: "sequence too wide", template: "{SEQ:20}", resetPolicy: enums.ResetPolicyNever, valid: false},
+		{name: "unbalanced braces", template: "{PREFIX-{SEQ}", resetPolicy: enums.ResetPolicyNever, valid: false},
+		{name: "yearly reset without year", template: "{PREFIX}-{SEQ:5}", resetPolicy: enums.ResetPolicyYearly, valid: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTemplate(tc.template, tc.resetPolicy)
+
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidTemplate)
+			}
+		})
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	issuedAt := time.Date(2026, time.March, 14, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "INV-2026-00042", FormatNumber("{PREFIX}-{YYYY}-{SEQ:5}", "INV", issuedAt, 42))
+	assert.Equal(t, "INV0000001", FormatNumber("{PREFIX}{SEQ:7}", "INV", issuedAt, 1))
+	assert.Equal(t, "2603/7", FormatNumber("{YY}{MM}/{SEQ}", "", issuedAt, 7))
+	assert.Equal(t, "INV-123456", FormatNumber("{PREFIX}-{SEQ:3}", "INV", issuedAt, 123456))
+}
+
+func TestPeriod(t *testing.T) {
+	issuedAt := time.Date(2026, time.December, 31, 23, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2026", Period(enums.ResetPolicyYearly, issuedAt))
+	assert.Equal(t, "", Period(enums.ResetPolicyNever, issuedAt))
+}
@@ -0,0 +1,37 @@
+package sequences
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"invoice-backend/internal/repositories/sequences/enums"
+)
+
+type NumberingSettings struct {
+	UserID       uuid.UUID          `json:"user_id" gorm:"type:uuid;primaryKey"`
+	DocumentType enums.DocumentType `json:"document_type" gorm:"primaryKey"`
+	Prefix       string             `json:"prefix" gorm:"not null"`
+	Template     string             `json:"template" gorm:"not null"`
+	ResetPolicy  enums.ResetPolicy  `json:"reset_policy" gorm:"not null"`
+	CreatedAt    time.Time          `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time          `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// defaultSettings keeps documents numbered the way they were before numbering became configurable.
+var defaultSettings = map[enums.DocumentType]NumberingSettings{
+	enums.DocumentTypeInvoice: {
+		Prefix:      "INV",
+		Template:    "{PREFIX}{SEQ:7}",
+		ResetPolicy: enums.ResetPolicyNever,
+	},
+	enums.DocumentTypeCreditNote: {
+		Prefix:      "CN",
+		Template:    "{PREFIX}{SEQ:7}",
+		ResetPolicy: enums.ResetPolicyNever,
+	},
+	enums.DocumentTypeEstimate: {
+		Prefix:      "EST",
+		Template:    "{PREFIX}{SEQ:7}",
+		ResetPolicy: enums.ResetPolicyNever,
+	},
+}
@@ -0,0 +1,115 @@
+package sequences
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"invoice-backend/internal/repositories/sequences/enums"
+)
+
+const (
+	settingsTableName  = "document_numbering_settings"
+	sequencesTableName = "document_sequences"
+
+	nextValueQuery = `
+INSERT INTO document_sequences (user_id, document_type, period, last_value, updated_at)
+VALUES (?, ?, ?, 1, NOW())
+ON CONFLICT (user_id, document_type, period)
+DO UPDATE SET last_value = document_sequences.last_value + 1, updated_at = NOW()
+RETURNING last_value`
+)
+
+type Repository interface {
+	// GetSettings returns the numbering settings of a user, falling back to the defaults of the document type
+	GetSettings(ctx context.Context, userID uuid.UUID, documentType enums.DocumentType) (*NumberingSettings, error)
+	SaveSettings(ctx context.Context, settings *NumberingSettings) (*NumberingSettings, error)
+
+	// CurrentValue returns the last value allocated in the given period, or 0 when none was allocated yet
+	CurrentValue(ctx context.Context, userID uuid.UUID, documentType enums.DocumentType, period string) (int64, error)
+
+	// NextNumber allocates and formats the next document number. It must run inside the transaction
+	// that persists the document: the sequence row stays locked until commit and a rollback releases
+	// the value again, so numbers are handed out without gaps or duplicates.
+	NextNumber(ctx context.Context, userID uuid.UUID, documentType enums.DocumentType, at time.Time) (string, error)
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) GetSettings(ctx context.Context, userID uuid.UUID, documentType enums.DocumentType) (*NumberingSettings, error) {
+	var settings NumberingSettings
+
+	err := s.db.WithContext(ctx).
+		Table(settingsTableName).
+		Where("user_id = ? AND document_type = ?", userID, documentType).
+		First(&settings).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			defaults := defaultSettings[documentType]
+			defaults.UserID = userID
+			defaults.DocumentType = documentType
+
+			return &defaults, nil
+		}
+
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+func (s *SQLRepository) SaveSettings(ctx context.Context, settings *NumberingSettings) (*NumberingSettings, error) {
+	err := s.db.WithContext(ctx).
+		Table(settingsTableName).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "document_type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"prefix", "template", "reset_policy", "updated_at"}),
+		}).
+		Create(settings).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (s *SQLRepository) CurrentValue(ctx context.Context, userID uuid.UUID, documentType enums.DocumentType, period string) (int64, error) {
+	var value int64
+
+	err := s.db.WithContext(ctx).
+		Table(sequencesTableName).
+		Select("COALESCE(MAX(last_value), 0)").
+		Where("user_id = ? AND document_type = ? AND period = ?", userID, documentType, period).
+		Scan(&value).Error
+
+	return value, err
+}
+
+func (s *SQLRepository) NextNumber(ctx context.Context, userID uuid.UUID, documentType enums.DocumentType, at time.Time) (string, error) {
+	settings, err := s.GetSettings(ctx, userID, documentType)
+	if err != nil {
+		return "", err
+	}
+
+	var value int64
+
+	err = s.db.WithContext(ctx).
+		Raw(nextValueQuery, userID, documentType, Period(settings.ResetPolicy, at)).
+		Scan(&value).Error
+	if err != nil {
+		return "", err
+	}
+
+	return FormatNumber(settings.Template, settings.Prefix, at, value), nil
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,23 @@
+package taxrates
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type TaxRate struct {
+	ID        uuid.UUID       `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID    uuid.UUID       `gorm:"type:uuid;not null"`
+	Name      string          `gorm:"type:varchar(100);not null"`
+	Rate      decimal.Decimal `gorm:"type:numeric(7,4);not null"` // Percentage, e.g. 20 for 20%
+	Compound  bool            `gorm:"not null"`                   // Compound taxes also apply to the other taxes of a line
+	CreatedAt time.Time       `gorm:"autoCreateTime"`
+	UpdatedAt time.Time       `gorm:"autoUpdateTime"`
+}
+
+type TaxRateDBFilter struct {
+	ID     []*uuid.UUID `json:"id,omitempty"`
+	UserID []*uuid.UUID `json:"user_id,omitempty"`
+}
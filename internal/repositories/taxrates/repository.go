@@ -0,0 +1,117 @@
+package taxrates
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"invoice-backend/internal/shared"
+)
+
+const (
+	tableName = "tax_rates"
+)
+
+var ErrTaxRateNotFound = errors.New("no tax rate found with the given ID")
+
+type Repository interface {
+	CreateTaxRate(ctx context.Context, taxRate *TaxRate) (*TaxRate, error)
+	GetTaxRateByID(ctx context.Context, id uuid.UUID) (*TaxRate, error)
+	ListTaxRates(ctx context.Context, filters *TaxRateDBFilter, pagination shared.Pagination) ([]*TaxRate, error)
+	UpdateTaxRate(ctx context.Context, taxRate *TaxRate) error
+	DeleteTaxRate(ctx context.Context, id uuid.UUID) error
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) CreateTaxRate(ctx context.Context, taxRate *TaxRate) (*TaxRate, error) {
+	if taxRate.ID == uuid.Nil {
+		taxRate.ID = uuid.New()
+	}
+
+	err := shared.CheckOwner(ctx, taxRate.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Table(tableName).Create(taxRate).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return taxRate, nil
+}
+
+func (s *SQLRepository) GetTaxRateByID(ctx context.Context, id uuid.UUID) (*TaxRate, error) {
+	var taxRate TaxRate
+
+	err := s.db.WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("id = ?", id).First(&taxRate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &taxRate, nil
+}
+
+func (s *SQLRepository) ListTaxRates(ctx context.Context, filters *TaxRateDBFilter, pagination shared.Pagination) ([]*TaxRate, error) {
+	taxRates := make([]*TaxRate, 0)
+
+	dataset, err := shared.BuildDataset(ctx, s.db, tableName, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	paginatedDataset := shared.PaginateDataset(dataset.Scopes(shared.OwnedBy(ctx)).Order("name ASC"), pagination)
+
+	result := paginatedDataset.Find(&taxRates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return taxRates, nil
+}
+
+func (s *SQLRepository) UpdateTaxRate(ctx context.Context, taxRate *TaxRate) error {
+	result := s.db.WithContext(ctx).
+		Table(tableName).
+		Scopes(shared.OwnedBy(ctx)).
+		Where("id = ?", taxRate.ID).
+		Updates(map[string]interface{}{
+			"name":       taxRate.Name,
+			"rate":       taxRate.Rate,
+			"compound":   taxRate.Compound,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaxRateNotFound
+	}
+	return nil
+}
+
+func (s *SQLRepository) DeleteTaxRate(ctx context.Context, id uuid.UUID) error {
+	result := s.db.WithContext(ctx).Table(tableName).Scopes(shared.OwnedBy(ctx)).Where("id = ?", id).Delete(&TaxRate{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaxRateNotFound
+	}
+	return nil
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
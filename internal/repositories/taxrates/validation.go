@@ -0,0 +1,29 @@
+package taxrates
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidName = errors.New("invalid tax rate name")
+	ErrInvalidRate = errors.New("invalid tax rate")
+)
+
+var maxRate = decimal.NewFromInt(100)
+
+// Validate checks the user-provided fields of a tax rate.
+func Validate(taxRate *TaxRate) error {
+	if strings.TrimSpace(taxRate.Name) == "" {
+		return fmt.Errorf("%w: name must not be empty", ErrInvalidName)
+	}
+
+	if taxRate.Rate.IsNegative() || taxRate.Rate.GreaterThan(maxRate) {
+		return fmt.Errorf("%w: %s is not between 0 and 100", ErrInvalidRate, taxRate.Rate)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package taxrates
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		taxRate  TaxRate
+		expected error
+	}{
+		{"valid", TaxRate{Name: "VAT", Rate: decimal.RequireFromString("20")}, nil},
+		{"zero rate", TaxRate{Name: "Exempt", Rate: decimal.Zero}, nil},
+		{"fractional rate", TaxRate{Name: "QST", Rate: decimal.RequireFromString("9.975")}, nil},
+		{"blank name", TaxRate{Name: "  ", Rate: decimal.RequireFromString("20")}, ErrInvalidName},
+		{"negative rate", TaxRate{Name: "VAT", Rate: decimal.RequireFromString("-1")}, ErrInvalidRate},
+		{"rate above 100", TaxRate{Name: "VAT", Rate: decimal.RequireFromString("100.01")}, ErrInvalidRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&tt.taxRate)
+			if tt.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package unitofwork
+
+import (
+	"context"
+
+	"invoice-backend/internal/repositories/activities"
+	"invoice-backend/internal/repositories/creditnotes"
+	"invoice-backend/internal/repositories/estimates"
+	"invoice-backend/internal/repositories/invoices"
+	"invoice-backend/internal/repositories/invoicesitems"
+	"invoice-backend/internal/repositories/locks"
+	"invoice-backend/internal/repositories/payments"
+	"invoice-backend/internal/repositories/recurringinvoices"
+	"invoice-backend/internal/repositories/sequences"
+
+	"gorm.io/gorm"
+)
+
+// Repositories groups the repositories taking part in a unit of work. All of them share the same transaction.
+type Repositories struct {
+	Activities        activities.Repository
+	CreditNotes       creditnotes.Repository
+	Estimates         estimates.Repository
+	Invoices          invoices.Repository
+	InvoiceItems      invoicesitems.Repository
+	Locks             locks.Repository
+	Payments          payments.Repository
+	RecurringInvoices recurringinvoices.Repository
+	Sequences         sequences.Repository
+}
+
+// UnitOfWork runs fn inside a transaction. Everything written through the given repositories is committed
+// when fn returns nil and rolled back when it returns an error.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(repos *Repositories) error) error
+}
+
+type SQLUnitOfWork struct {
+	db *gorm.DB
+}
+
+func (u *SQLUnitOfWork) Do(ctx context.Context, fn func(repos *Repositories) error) error {
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(newRepositories(tx))
+	})
+}
+
+func newRepositories(tx *gorm.DB) *Repositories {
+	return &Repositories{
+		Activities:        activities.NewSQLRepository(tx),
+		CreditNotes:       creditnotes.NewSQLRepository(tx),
+		Estimates:         estimates.NewSQLRepository(tx),
+		Invoices:          invoices.NewSQLRepository(tx),
+		InvoiceItems:      invoicesitems.NewSQLRepository(tx),
+		Locks:             locks.NewSQLRepository(tx),
+		Payments:          payments.NewSQLRepository(tx),
+		RecurringInvoices: recurringinvoices.NewSQLRepository(tx),
+		Sequences:         sequences.NewSQLRepository(tx),
+	}
+}
+
+func NewSQLUnitOfWork(db *gorm.DB) *SQLUnitOfWork {
+	return &SQLUnitOfWork{
+		db: db,
+	}
+}
@@ -0,0 +1,21 @@
+package users
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"invoice-backend/internal/auth"
+)
+
+// DefaultRole is the role of users registering themselves, who own the data of their account.
+const DefaultRole = auth.RoleOwner
+
+type User struct {
+	ID           uuid.UUID `json:"id" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	Name         string    `json:"name" gorm:"not null"`
+	Email        string    `json:"email" gorm:"not null"`
+	PasswordHash string    `json:"-" gorm:"not null"` // bcrypt
+	Role         auth.Role `json:"role" gorm:"not null"`
+	CreatedAt    time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
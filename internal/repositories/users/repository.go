@@ -0,0 +1,89 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+const (
+	tableName = "users"
+
+	uniqueViolationCode = "23505"
+)
+
+var ErrEmailTaken = errors.New("email is already registered")
+
+type Repository interface {
+	CreateUser(ctx context.Context, user *User) (*User, error)
+	GetUserByID(ctx context.Context, userID uuid.UUID) (*User, error)
+	// GetUserByEmail looks the user up by email, ignoring case
+	GetUserByEmail(ctx context.Context, email string) (*User, error)
+}
+
+type SQLRepository struct {
+	db *gorm.DB
+}
+
+func (s *SQLRepository) CreateUser(ctx context.Context, user *User) (*User, error) {
+	if user.ID == uuid.Nil {
+		user.ID = uuid.New()
+	}
+
+	if user.Role == "" {
+		user.Role = DefaultRole
+	}
+
+	user.Email = NormalizeEmail(user.Email)
+
+	err := s.db.WithContext(ctx).Table(tableName).Create(user).Error
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return nil, ErrEmailTaken
+		}
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *SQLRepository) GetUserByID(ctx context.Context, userID uuid.UUID) (*User, error) {
+	return s.getUser(ctx, "id = ?", userID)
+}
+
+func (s *SQLRepository) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	return s.getUser(ctx, "LOWER(email) = ?", NormalizeEmail(email))
+}
+
+func (s *SQLRepository) getUser(ctx context.Context, query string, args ...interface{}) (*User, error) {
+	var user User
+
+	err := s.db.WithContext(ctx).
+		Table(tableName).
+		Where(query, args...).
+		Where("deleted_at IS NULL").
+		First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func NewSQLRepository(db *gorm.DB) *SQLRepository {
+	return &SQLRepository{
+		db: db,
+	}
+}
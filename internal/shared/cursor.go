@@ -0,0 +1,75 @@
+package shared
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrCursorOrder   = errors.New("cursors are only supported in the default order")
+)
+
+// Cursor points after a row of a listing ordered by creation time and id, newest first. Unlike page numbers,
+// cursors stay correct while rows are added during the iteration.
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// Encode returns the opaque form of the cursor handed to clients.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(value string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	cursor := new(Cursor)
+
+	err = json.Unmarshal(data, cursor)
+	if err != nil || cursor.CreatedAt.IsZero() || cursor.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+// CursorOrder is the order cursors are keyed on.
+var CursorOrder = Sort{Column: "created_at", Descending: true}
+
+func afterCursor(dataset *gorm.DB, cursor *Cursor) *gorm.DB {
+	return dataset.Where(clause.Expr{
+		SQL: "(?, ?) < (?, ?)",
+		Vars: []interface{}{
+			clause.Column{Table: clause.CurrentTable, Name: "created_at"},
+			clause.Column{Table: clause.CurrentTable, Name: "id"},
+			cursor.CreatedAt,
+			cursor.ID,
+		},
+	})
+}
+
+// TrimPage cuts rows, fetched with one row more than the page size, to the page size. When the extra row was
+// there, it returns the cursor of the last row kept, from which the next page starts.
+func TrimPage[T any](rows []T, pageSize int, cursorOf func(T) Cursor) ([]T, *Cursor) {
+	if len(rows) <= pageSize || pageSize <= 0 {
+		return rows, nil
+	}
+
+	rows = rows[:pageSize]
+	next := cursorOf(rows[pageSize-1])
+
+	return rows, &next
+}
@@ -0,0 +1,56 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursor := Cursor{CreatedAt: time.Date(2026, 10, 18, 12, 30, 0, 123456000, time.UTC), ID: uuid.New()}
+
+	decoded, err := DecodeCursor(cursor.Encode())
+	require.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, cursor.ID, decoded.ID)
+}
+
+func TestDecodeCursorRejectsInvalidCursors(t *testing.T) {
+	for _, value := range []string{"", "not base64!", "e30", Cursor{ID: uuid.New()}.Encode()} {
+		_, err := DecodeCursor(value)
+		assert.ErrorIs(t, err, ErrInvalidCursor, value)
+	}
+}
+
+func TestPaginateDatasetAfterCursor(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+	cursor := &Cursor{CreatedAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC), ID: uuid.New()}
+
+	dataset := OrderDataset(db.Table("invoices"), CursorOrder)
+	PaginateDataset(dataset, Pagination{Limit: lo.ToPtr(10), Page: lo.ToPtr(3), Cursor: cursor}).
+		Find(&[]map[string]interface{}{})
+
+	assert.Equal(t,
+		`SELECT * FROM "invoices" WHERE ("invoices"."created_at", "invoices"."id") < ('2026-10-18 12:00:00', '`+
+			cursor.ID.String()+`') ORDER BY "invoices"."created_at" DESC,"invoices"."id" DESC LIMIT 10`,
+		recorder.Last(),
+	)
+}
+
+func TestTrimPage(t *testing.T) {
+	cursorOf := func(i int) Cursor { return Cursor{ID: uuid.UUID{byte(i)}} }
+
+	rows, next := TrimPage([]int{1, 2, 3}, 3, cursorOf)
+	assert.Equal(t, []int{1, 2, 3}, rows)
+	assert.Nil(t, next)
+
+	rows, next = TrimPage([]int{1, 2, 3, 4}, 3, cursorOf)
+	assert.Equal(t, []int{1, 2, 3}, rows)
+	assert.Equal(t, &Cursor{ID: uuid.UUID{3}}, next)
+}
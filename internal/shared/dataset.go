@@ -96,11 +96,18 @@ func FilterDataset(dataset *gorm.DB, filters any) (*gorm.DB, error) {
 }
 
 func PaginateDataset(dataset *gorm.DB, pagination Pagination) *gorm.DB {
-	if pagination.Limit != nil {
-		return dataset.Limit(*pagination.Limit)
+	limit := lo.FromPtrOr(pagination.Limit, defaultPaginationLimit)
+	page := lo.FromPtrOr(pagination.Page, 1)
+
+	if pagination.Cursor != nil {
+		return afterCursor(dataset, pagination.Cursor).Limit(limit)
+	}
+
+	if page <= 1 {
+		return dataset.Limit(limit)
 	}
 
-	return dataset.Limit(defaultPaginationLimit)
+	return dataset.Limit(limit).Offset((page - 1) * limit)
 }
 
 func ExcludeFilterDataset(dataset *gorm.DB, filters any) (*gorm.DB, error) {
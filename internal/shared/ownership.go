@@ -0,0 +1,92 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"invoice-backend/internal/auth"
+)
+
+const ownerColumn = "user_id"
+
+var (
+	ErrNoOwner  = errors.New("tenant data accessed without an authenticated user")
+	ErrNotOwner = errors.New("record belongs to another user")
+)
+
+// OwnedBy is a gorm scope restricting a query to the rows owned by the user authenticated in ctx, so rows of other
+// tenants behave as if they did not exist. System contexts see every row, any other context fails with ErrNoOwner.
+func OwnedBy(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		ownerID, scoped, err := ownerFromContext(ctx)
+		if err != nil {
+			_ = db.AddError(err)
+
+			return db
+		}
+
+		if !scoped {
+			return db
+		}
+
+		return db.Where(clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: ownerColumn}, Value: ownerID})
+	}
+}
+
+// OwnedThrough is OwnedBy for tables without an owner column, whose rows belong to the user owning the parent row
+// referenced by foreignKey.
+func OwnedThrough(ctx context.Context, foreignKey, parentTable string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		ownerID, scoped, err := ownerFromContext(ctx)
+		if err != nil {
+			_ = db.AddError(err)
+
+			return db
+		}
+
+		if !scoped {
+			return db
+		}
+
+		parents := db.Session(&gorm.Session{NewDB: true}).
+			Table(parentTable).
+			Select("id").
+			Where(clause.Eq{Column: clause.Column{Name: ownerColumn}, Value: ownerID})
+
+		return db.Where(clause.Expr{
+			SQL:  "? IN (?)",
+			Vars: []interface{}{clause.Column{Table: clause.CurrentTable, Name: foreignKey}, parents},
+		})
+	}
+}
+
+// CheckOwner verifies that a record about to be stored belongs to the user authenticated in ctx.
+func CheckOwner(ctx context.Context, ownerID uuid.UUID) error {
+	principalID, scoped, err := ownerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if scoped && principalID != ownerID {
+		return fmt.Errorf("%w: %s", ErrNotOwner, ownerID)
+	}
+
+	return nil
+}
+
+func ownerFromContext(ctx context.Context) (uuid.UUID, bool, error) {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.UserID, true, nil
+	}
+
+	if auth.IsSystem(ctx) {
+		return uuid.Nil, false, nil
+	}
+
+	return uuid.Nil, false, ErrNoOwner
+}
@@ -0,0 +1,85 @@
+package shared
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"invoice-backend/internal/auth"
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestOwnedBy(t *testing.T) {
+	owner := uuid.New()
+
+	testCases := []struct {
+		name        string
+		ctx         context.Context
+		expectedSQL string
+		expectedErr error
+	}{
+		{
+			name:        "authenticated user sees own rows",
+			ctx:         auth.WithPrincipal(context.Background(), &auth.Principal{UserID: owner}),
+			expectedSQL: `SELECT * FROM "invoices" WHERE id = 'x' AND "invoices"."user_id" = '` + owner.String() + `'`,
+		},
+		{
+			name:        "system context sees every row",
+			ctx:         auth.AsSystem(context.Background()),
+			expectedSQL: `SELECT * FROM "invoices" WHERE id = 'x'`,
+		},
+		{
+			name:        "anonymous context is rejected",
+			ctx:         context.Background(),
+			expectedErr: ErrNoOwner,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, recorder := sqltest.NewDryRunDB(t)
+
+			var rows []map[string]interface{}
+			err := db.WithContext(tc.ctx).Table("invoices").Scopes(OwnedBy(tc.ctx)).Where("id = ?", "x").Find(&rows).Error
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Empty(t, recorder.Statements())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSQL, recorder.Last())
+		})
+	}
+}
+
+func TestOwnedThrough(t *testing.T) {
+	owner := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: owner})
+	db, recorder := sqltest.NewDryRunDB(t)
+
+	var rows []map[string]interface{}
+	err := db.WithContext(ctx).Table("invoice_items").Scopes(OwnedThrough(ctx, "invoice_id", "invoices")).Find(&rows).Error
+
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`SELECT * FROM "invoice_items" WHERE "invoice_items"."invoice_id" IN (SELECT id FROM "invoices" WHERE "user_id" = '`+owner.String()+`')`,
+		recorder.Last(),
+	)
+}
+
+func TestCheckOwner(t *testing.T) {
+	owner := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: owner})
+
+	assert.NoError(t, CheckOwner(ctx, owner))
+	assert.ErrorIs(t, CheckOwner(ctx, uuid.New()), ErrNotOwner)
+	assert.NoError(t, CheckOwner(auth.AsSystem(context.Background()), uuid.New()))
+	assert.ErrorIs(t, CheckOwner(context.Background(), owner), ErrNoOwner)
+}
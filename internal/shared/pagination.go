@@ -1,6 +1,31 @@
 package shared
 
+import "github.com/samber/lo"
+
+// Pagination selects a page of a listing, by page number or, when Cursor is set, by cursor. Listings paginated by
+// cursor must be ordered by CursorOrder.
 type Pagination struct {
-	Limit *int
-	Page  *int
+	Limit  *int
+	Page   *int
+	Cursor *Cursor
+}
+
+// PageNumber returns the requested page, starting at 1.
+func (p Pagination) PageNumber() int {
+	return max(1, lo.FromPtrOr(p.Page, 1))
+}
+
+// PageSize returns the requested number of items per page.
+func (p Pagination) PageSize() int {
+	return lo.FromPtrOr(p.Limit, defaultPaginationLimit)
+}
+
+// PageCount returns the number of pages needed for total items.
+func (p Pagination) PageCount(total int64) int64 {
+	size := int64(p.PageSize())
+	if size <= 0 {
+		return 0
+	}
+
+	return (total + size - 1) / size
 }
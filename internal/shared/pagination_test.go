@@ -0,0 +1,17 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginationPageCount(t *testing.T) {
+	pagination := Pagination{Limit: lo.ToPtr(10), Page: lo.ToPtr(0)}
+
+	assert.Equal(t, 1, pagination.PageNumber())
+	assert.Equal(t, int64(0), pagination.PageCount(0))
+	assert.Equal(t, int64(1), pagination.PageCount(10))
+	assert.Equal(t, int64(2), pagination.PageCount(11))
+}
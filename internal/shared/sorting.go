@@ -0,0 +1,46 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrInvalidSort = errors.New("invalid sort")
+
+// Sort orders a listing by a single column. Listings add the id as a tie-breaker, so pages are stable.
+type Sort struct {
+	Column     string
+	Descending bool
+}
+
+// ParseSort parses a sort parameter such as "name" or "-created_at". Only the given columns are accepted, which
+// keeps client input out of the ORDER BY clause.
+func ParseSort(value string, columns ...string) (Sort, error) {
+	sort := Sort{Column: strings.TrimPrefix(value, "-"), Descending: strings.HasPrefix(value, "-")}
+
+	for _, column := range columns {
+		if sort.Column == column {
+			return sort, nil
+		}
+	}
+
+	return Sort{}, fmt.Errorf("%w: %q", ErrInvalidSort, value)
+}
+
+// OrderDataset applies the sort and then orders by id.
+func OrderDataset(dataset *gorm.DB, sort Sort) *gorm.DB {
+	return dataset.
+		Order(clause.OrderByColumn{Column: clause.Column{Table: clause.CurrentTable, Name: sort.Column}, Desc: sort.Descending}).
+		Order(clause.OrderByColumn{Column: clause.Column{Table: clause.CurrentTable, Name: "id"}, Desc: sort.Descending})
+}
+
+// ContainsPattern returns a LIKE pattern matching values that contain term, with the LIKE wildcards in term escaped.
+func ContainsPattern(term string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(term)
+
+	return "%" + escaped + "%"
+}
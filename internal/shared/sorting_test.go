@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"invoice-backend/internal/shared/sqltest"
+)
+
+func TestParseSort(t *testing.T) {
+	sort, err := ParseSort("-created_at", "name", "created_at")
+	require.NoError(t, err)
+	assert.Equal(t, Sort{Column: "created_at", Descending: true}, sort)
+
+	sort, err = ParseSort("name", "name", "created_at")
+	require.NoError(t, err)
+	assert.Equal(t, Sort{Column: "name"}, sort)
+
+	for _, invalid := range []string{"", "-", "password", "name; DROP TABLE customers"} {
+		_, err = ParseSort(invalid, "name", "created_at")
+		assert.ErrorIs(t, err, ErrInvalidSort, invalid)
+	}
+}
+
+func TestOrderDataset(t *testing.T) {
+	db, recorder := sqltest.NewDryRunDB(t)
+
+	OrderDataset(db.Table("customers"), Sort{Column: "name", Descending: true}).Find(&[]map[string]interface{}{})
+
+	assert.Equal(t, `SELECT * FROM "customers" ORDER BY "customers"."name" DESC,"customers"."id" DESC`, recorder.Last())
+}
+
+func TestContainsPattern(t *testing.T) {
+	assert.Equal(t, `%acme%`, ContainsPattern("acme"))
+	assert.Equal(t, `%50\% off\_now\\%`, ContainsPattern(`50% off_now\`))
+}
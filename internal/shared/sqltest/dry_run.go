@@ -0,0 +1,85 @@
+// Package sqltest lets repository tests inspect the SQL that gorm builds without a database.
+package sqltest
+
+import (
+	"sync"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Recorder collects the statements run against a dry-run database, with their arguments inlined.
+type Recorder struct {
+	mu         sync.Mutex
+	statements []string
+}
+
+// NewDryRunDB returns a Postgres flavoured gorm database that never connects. Queries return no rows and writes
+// report no affected rows.
+func NewDryRunDB(t testing.TB) (*gorm.DB, *Recorder) {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost sslmode=disable"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("open dry run database: %v", err)
+	}
+
+	recorder := &Recorder{}
+	callbacks := db.Callback()
+
+	for _, register := range []func() error{
+		func() error {
+			return callbacks.Create().After("gorm:create").Register("sqltest:record", recorder.record)
+		},
+		func() error { return callbacks.Query().After("gorm:query").Register("sqltest:record", recorder.record) },
+		func() error {
+			return callbacks.Update().After("gorm:update").Register("sqltest:record", recorder.record)
+		},
+		func() error {
+			return callbacks.Delete().After("gorm:delete").Register("sqltest:record", recorder.record)
+		},
+		func() error { return callbacks.Row().After("gorm:row").Register("sqltest:record", recorder.record) },
+	} {
+		if err = register(); err != nil {
+			t.Fatalf("register dry run callback: %v", err)
+		}
+	}
+
+	return db, recorder
+}
+
+// Statements returns the recorded statements in execution order.
+func (r *Recorder) Statements() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.statements...)
+}
+
+// Last returns the most recent statement, or an empty string when nothing ran.
+func (r *Recorder) Last() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.statements) == 0 {
+		return ""
+	}
+
+	return r.statements[len(r.statements)-1]
+}
+
+func (r *Recorder) record(db *gorm.DB) {
+	if db.Statement.SQL.Len() == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.statements = append(r.statements, db.Dialector.Explain(db.Statement.SQL.String(), db.Statement.Vars...))
+}
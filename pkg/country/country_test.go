@@ -0,0 +1,18 @@
+package country
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	code, err := Parse(" de ")
+	assert.NoError(t, err)
+	assert.Equal(t, Code("DE"), code)
+
+	for _, invalid := range []string{"", "XX", "DEU", "UK"} {
+		_, err = Parse(invalid)
+		assert.ErrorIs(t, err, ErrUnknownCountry, invalid)
+	}
+}
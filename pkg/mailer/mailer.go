@@ -0,0 +1,77 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+)
+
+const (
+	TransportSMTP   = "smtp"
+	TransportFile   = "file"
+	TransportMemory = "memory"
+)
+
+var (
+	ErrNoRecipients     = errors.New("message has no recipients")
+	ErrUnknownTransport = errors.New("unknown mail transport")
+)
+
+// Mailer delivers messages. Implementations must be safe for concurrent use.
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+type Message struct {
+	From        mail.Address
+	ReplyTo     *mail.Address
+	To          []mail.Address
+	Cc          []mail.Address
+	Subject     string
+	TextBody    string
+	HTMLBody    string
+	Attachments []Attachment
+}
+
+// Recipients returns the envelope recipients of the message.
+func (m *Message) Recipients() []string {
+	recipients := make([]string, 0, len(m.To)+len(m.Cc))
+
+	for _, address := range append(append([]mail.Address{}, m.To...), m.Cc...) {
+		recipients = append(recipients, address.Address)
+	}
+
+	return recipients
+}
+
+type Config struct {
+	Transport    string // smtp, file or memory
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	SMTPTimeout  time.Duration
+	FileDir      string // Directory the file transport writes .eml files to
+}
+
+// New returns the mailer for the configured transport.
+func New(config *Config) (Mailer, error) {
+	switch config.Transport {
+	case TransportSMTP:
+		return NewSMTPMailer(config.SMTPHost, config.SMTPPort, config.SMTPUsername, config.SMTPPassword, config.SMTPTimeout), nil
+	case TransportFile:
+		return NewFileMailer(config.FileDir), nil
+	case TransportMemory:
+		return NewMemoryMailer(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownTransport, config.Transport)
+	}
+}
@@ -0,0 +1,181 @@
+package mailer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMessage() *Message {
+	return &Message{
+		From:     mail.Address{Name: "Acme Café", Address: "billing@acme.test"},
+		ReplyTo:  &mail.Address{Address: "owner@acme.test"},
+		To:       []mail.Address{{Name: "Globex", Address: "ap@globex.test"}},
+		Subject:  "Invoice INV0000042 from Acme Café",
+		TextBody: "Please find your invoice attached.",
+		HTMLBody: "<p>Please find your invoice attached.</p>",
+		Attachments: []Attachment{
+			{Filename: "INV0000042.pdf", ContentType: "application/pdf", Data: bytes.Repeat([]byte("%PDF"), 100)},
+		},
+	}
+}
+
+func TestMessageBytes(t *testing.T) {
+	raw, err := testMessage().Bytes()
+	require.NoError(t, err)
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Invoice INV0000042 from Acme Café", subject)
+	assert.Equal(t, "<owner@acme.test>", parsed.Header.Get("Reply-To"))
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	parts := multipart.NewReader(parsed.Body, params["boundary"])
+
+	alternatives, err := parts.NextPart()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(alternatives.Header.Get("Content-Type"), "multipart/alternative"))
+
+	attachment, err := parts.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "INV0000042.pdf", attachment.FileName())
+
+	_, err = parts.NextPart()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestMessageBytesRequiresRecipients(t *testing.T) {
+	msg := testMessage()
+	msg.To = nil
+
+	_, err := msg.Bytes()
+	assert.ErrorIs(t, err, ErrNoRecipients)
+}
+
+func TestMemoryMailer(t *testing.T) {
+	mailer := NewMemoryMailer()
+
+	require.NoError(t, mailer.Send(context.Background(), testMessage()))
+	assert.Len(t, mailer.Messages(), 1)
+
+	failure := errors.New("connection refused")
+	mailer.FailWith(failure)
+	assert.ErrorIs(t, mailer.Send(context.Background(), testMessage()), failure)
+	assert.Len(t, mailer.Messages(), 1)
+}
+
+func TestFileMailer(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+
+	require.NoError(t, NewFileMailer(dir).Send(context.Background(), testMessage()))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	raw, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "To: \"Globex\" <ap@globex.test>")
+}
+
+// fakeSMTPServer accepts a single session and returns the envelope and data it received.
+func fakeSMTPServer(t *testing.T) (string, <-chan []string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	received := make(chan []string, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		text := textproto.NewConn(conn)
+		session := make([]string, 0)
+		_ = text.PrintfLine("220 localhost ESMTP")
+
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				return
+			}
+
+			command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+			switch command {
+			case "EHLO", "HELO":
+				_ = text.PrintfLine("250 localhost")
+			case "MAIL", "RCPT":
+				session = append(session, line)
+				_ = text.PrintfLine("250 OK")
+			case "DATA":
+				_ = text.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+				data, _ := io.ReadAll(bufio.NewReader(text.DotReader()))
+				session = append(session, string(data))
+				_ = text.PrintfLine("250 OK")
+			case "QUIT":
+				_ = text.PrintfLine("221 Bye")
+				received <- session
+
+				return
+			default:
+				_ = text.PrintfLine("502 Command not implemented")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), received
+}
+
+func TestSMTPMailer(t *testing.T) {
+	addr, received := fakeSMTPServer(t)
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	msg := testMessage()
+	msg.Cc = []mail.Address{{Address: "accounting@globex.test"}}
+
+	err = NewSMTPMailer(host, port, "", "", 0).Send(context.Background(), msg)
+	require.NoError(t, err)
+
+	session := <-received
+	require.Len(t, session, 4)
+	assert.Equal(t, "MAIL FROM:<billing@acme.test>", session[0])
+	assert.Equal(t, "RCPT TO:<ap@globex.test>", session[1])
+	assert.Equal(t, "RCPT TO:<accounting@globex.test>", session[2])
+	assert.Contains(t, session[3], "Content-Disposition: attachment; filename=INV0000042.pdf")
+}
+
+func TestNew(t *testing.T) {
+	m, err := New(&Config{Transport: TransportMemory})
+	require.NoError(t, err)
+	assert.IsType(t, &MemoryMailer{}, m)
+
+	_, err = New(&Config{Transport: "pigeon"})
+	assert.ErrorIs(t, err, ErrUnknownTransport)
+}
@@ -0,0 +1,178 @@
+package mailer
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+const base64LineLength = 76
+
+// Bytes encodes the message as RFC 5322 MIME text: a multipart/mixed body holding the text and HTML
+// alternatives followed by the attachments.
+func (m *Message) Bytes() ([]byte, error) {
+	if len(m.To) == 0 {
+		return nil, ErrNoRecipients
+	}
+
+	var buf bytes.Buffer
+
+	body := multipart.NewWriter(&buf)
+
+	headers := []string{
+		"From: " + m.From.String(),
+		"To: " + joinAddresses(m.To),
+	}
+
+	if len(m.Cc) > 0 {
+		headers = append(headers, "Cc: "+joinAddresses(m.Cc))
+	}
+
+	if m.ReplyTo != nil {
+		headers = append(headers, "Reply-To: "+m.ReplyTo.String())
+	}
+
+	headers = append(headers,
+		"Subject: "+mime.QEncoding.Encode("utf-8", m.Subject),
+		"Date: "+time.Now().Format(time.RFC1123Z),
+		"Message-ID: "+messageID(m.From),
+		"MIME-Version: 1.0",
+		fmt.Sprintf("Content-Type: multipart/mixed; boundary=%q", body.Boundary()),
+	)
+
+	var head bytes.Buffer
+	head.WriteString(strings.Join(headers, "\r\n"))
+	head.WriteString("\r\n\r\n")
+
+	err := m.writeAlternatives(body)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range m.Attachments {
+		err = writeAttachment(body, attachment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(head.Bytes(), buf.Bytes()...), nil
+}
+
+func (m *Message) writeAlternatives(body *multipart.Writer) error {
+	var buf bytes.Buffer
+
+	alternatives := multipart.NewWriter(&buf)
+
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain", m.TextBody},
+		{"text/html", m.HTMLBody},
+	} {
+		if part.content == "" {
+			continue
+		}
+
+		w, err := alternatives.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType + "; charset=utf-8"},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return err
+		}
+
+		qp := quotedprintable.NewWriter(w)
+
+		_, err = qp.Write([]byte(part.content))
+		if err != nil {
+			return err
+		}
+
+		err = qp.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	err := alternatives.Close()
+	if err != nil {
+		return err
+	}
+
+	w, err := body.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {fmt.Sprintf("multipart/alternative; boundary=%q", alternatives.Boundary())},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(buf.Bytes())
+
+	return err
+}
+
+func writeAttachment(body *multipart.Writer, attachment Attachment) error {
+	contentType := attachment.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	w, err := body.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {mime.FormatMediaType(contentType, map[string]string{"name": attachment.Filename})},
+		"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename})},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	if err != nil {
+		return err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+
+	for len(encoded) > 0 {
+		n := min(base64LineLength, len(encoded))
+
+		_, err = fmt.Fprintf(w, "%s\r\n", encoded[:n])
+		if err != nil {
+			return err
+		}
+
+		encoded = encoded[n:]
+	}
+
+	return nil
+}
+
+func joinAddresses(addresses []mail.Address) string {
+	formatted := make([]string, len(addresses))
+
+	for i, address := range addresses {
+		formatted[i] = address.String()
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+func messageID(from mail.Address) string {
+	domain := "localhost"
+	if at := strings.LastIndex(from.Address, "@"); at >= 0 {
+		domain = from.Address[at+1:]
+	}
+
+	random := make([]byte, 16)
+	_, _ = rand.Read(random)
+
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(random), domain)
+}
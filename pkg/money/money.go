@@ -0,0 +1,88 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// StorageScale is the number of decimal places amounts are persisted with (NUMERIC(19,4)).
+const StorageScale = 4
+
+const DefaultCurrency Currency = "USD"
+
+var (
+	ErrUnknownCurrency = errors.New("unknown currency")
+	ErrInvalidAmount   = errors.New("invalid amount")
+)
+
+// Currency is an ISO 4217 alphabetic currency code.
+type Currency string
+
+// minorUnits holds the ISO 4217 exponent of the currencies we accept.
+var minorUnits = map[Currency]int32{
+	"AED": 2, "ARS": 2, "AUD": 2, "BGN": 2, "BHD": 3, "BRL": 2, "CAD": 2, "CHF": 2,
+	"CLP": 0, "CNY": 2, "COP": 2, "CZK": 2, "DKK": 2, "EGP": 2, "EUR": 2, "GBP": 2,
+	"HKD": 2, "HUF": 2, "IDR": 2, "ILS": 2, "INR": 2, "ISK": 0, "JOD": 3, "JPY": 0,
+	"KRW": 0, "KWD": 3, "MAD": 2, "MXN": 2, "MYR": 2, "NGN": 2, "NOK": 2, "NZD": 2,
+	"OMR": 3, "PEN": 2, "PHP": 2, "PLN": 2, "RON": 2, "SAR": 2, "SEK": 2, "SGD": 2,
+	"THB": 2, "TND": 3, "TRY": 2, "TWD": 2, "UAH": 2, "USD": 2, "VND": 0, "ZAR": 2,
+}
+
+// ParseCurrency normalises code to upper case and checks it is a supported ISO 4217 currency.
+func ParseCurrency(code string) (Currency, error) {
+	currency := Currency(strings.ToUpper(strings.TrimSpace(code)))
+
+	if _, ok := minorUnits[currency]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+	}
+
+	return currency, nil
+}
+
+func (c Currency) String() string {
+	return string(c)
+}
+
+// MinorUnits returns the number of decimal places amounts in this currency are rounded to.
+func (c Currency) MinorUnits() int32 {
+	return minorUnits[c]
+}
+
+// Round rounds amount to the currency's minor units, with halves rounded away from zero.
+func (c Currency) Round(amount decimal.Decimal) decimal.Decimal {
+	return amount.Round(c.MinorUnits())
+}
+
+// Format renders amount with exactly the currency's minor units, unless it carries
+// extra precision (e.g. a unit price of 0.0125 USD), in which case that precision is kept.
+func (c Currency) Format(amount decimal.Decimal) string {
+	places := c.MinorUnits()
+
+	if !amount.Equal(amount.Round(places)) {
+		return amount.String()
+	}
+
+	return amount.StringFixed(places)
+}
+
+// ParseAmount parses a plain decimal string such as "1234.50". Exponents and more than
+// StorageScale decimal places are rejected so that no precision is silently dropped.
+func ParseAmount(value string) (decimal.Decimal, error) {
+	if value == "" || strings.ContainsAny(value, "eE") {
+		return decimal.Zero, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+
+	if !amount.Equal(amount.Truncate(StorageScale)) {
+		return decimal.Zero, fmt.Errorf("%w: %q has more than %d decimal places", ErrInvalidAmount, value, StorageScale)
+	}
+
+	return amount, nil
+}
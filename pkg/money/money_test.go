@@ -0,0 +1,76 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCurrency(t *testing.T) {
+	currency, err := ParseCurrency(" eur ")
+	assert.NoError(t, err)
+	assert.Equal(t, Currency("EUR"), currency)
+
+	_, err = ParseCurrency("XYZ")
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+}
+
+func TestCurrencyRound(t *testing.T) {
+	tests := []struct {
+		currency Currency
+		amount   string
+		expected string
+	}{
+		{"USD", "10.005", "10.01"},
+		{"USD", "10.004", "10"},
+		{"USD", "-10.005", "-10.01"},
+		{"JPY", "1234.5", "1235"},
+		{"KWD", "1.2345", "1.235"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.currency)+" "+tt.amount, func(t *testing.T) {
+			rounded := tt.currency.Round(decimal.RequireFromString(tt.amount))
+			assert.True(t, decimal.RequireFromString(tt.expected).Equal(rounded), rounded.String())
+		})
+	}
+}
+
+func TestCurrencyFormat(t *testing.T) {
+	assert.Equal(t, "10.00", Currency("USD").Format(decimal.RequireFromString("10")))
+	assert.Equal(t, "0.0125", Currency("USD").Format(decimal.RequireFromString("0.0125")))
+	assert.Equal(t, "1500", Currency("JPY").Format(decimal.RequireFromString("1500.0000")))
+	assert.Equal(t, "1.500", Currency("BHD").Format(decimal.RequireFromString("1.5")))
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"19.99", true},
+		{"0.0125", true},
+		{"-5", true},
+		{"1.23456", false},
+		{"1e3", false},
+		{"", false},
+		{"abc", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			_, err := ParseAmount(tt.value)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidAmount)
+			}
+		})
+	}
+
+	// Floats that cannot be represented exactly in binary must survive untouched.
+	amount, err := ParseAmount("0.1")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.3", amount.Mul(decimal.NewFromInt(3)).String())
+}
@@ -0,0 +1,103 @@
+package taxid
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"invoice-backend/pkg/country"
+)
+
+const maxLength = 32
+
+var ErrInvalidTaxID = errors.New("invalid tax ID")
+
+type format struct {
+	prefix  string // Printed in front of the number, e.g. the VAT country prefix; optional in the input
+	pattern *regexp.Regexp
+	name    string
+}
+
+func newFormat(prefix, pattern, name string) format {
+	return format{prefix: prefix, pattern: regexp.MustCompile("^(?:" + pattern + ")$"), name: name}
+}
+
+// formats holds the number formats of the countries we know, without their prefix. EU and UK VAT numbers are kept
+// with their country prefix, as they are printed on invoices.
+var formats = map[country.Code]format{
+	"AT": newFormat("AT", `U\d{8}`, "VAT number"),
+	"BE": newFormat("BE", `[01]\d{9}`, "VAT number"),
+	"BG": newFormat("BG", `\d{9,10}`, "VAT number"),
+	"CY": newFormat("CY", `\d{8}[A-Z]`, "VAT number"),
+	"CZ": newFormat("CZ", `\d{8,10}`, "VAT number"),
+	"DE": newFormat("DE", `\d{9}`, "VAT number"),
+	"DK": newFormat("DK", `\d{8}`, "VAT number"),
+	"EE": newFormat("EE", `\d{9}`, "VAT number"),
+	"ES": newFormat("ES", `[A-Z0-9]\d{7}[A-Z0-9]`, "VAT number"),
+	"FI": newFormat("FI", `\d{8}`, "VAT number"),
+	"FR": newFormat("FR", `[A-HJ-NP-Z0-9]{2}\d{9}`, "VAT number"),
+	"GR": newFormat("EL", `\d{9}`, "VAT number"),
+	"HR": newFormat("HR", `\d{11}`, "VAT number"),
+	"HU": newFormat("HU", `\d{8}`, "VAT number"),
+	"IE": newFormat("IE", `\d{7}[A-W][A-I]?|\d[A-Z+*]\d{5}[A-W]`, "VAT number"),
+	"IT": newFormat("IT", `\d{11}`, "VAT number"),
+	"LT": newFormat("LT", `\d{9}|\d{12}`, "VAT number"),
+	"LU": newFormat("LU", `\d{8}`, "VAT number"),
+	"LV": newFormat("LV", `\d{11}`, "VAT number"),
+	"MT": newFormat("MT", `\d{8}`, "VAT number"),
+	"NL": newFormat("NL", `\d{9}B\d{2}`, "VAT number"),
+	"PL": newFormat("PL", `\d{10}`, "VAT number"),
+	"PT": newFormat("PT", `\d{9}`, "VAT number"),
+	"RO": newFormat("RO", `\d{2,10}`, "VAT number"),
+	"SE": newFormat("SE", `\d{10}01`, "VAT number"),
+	"SI": newFormat("SI", `\d{8}`, "VAT number"),
+	"SK": newFormat("SK", `\d{10}`, "VAT number"),
+	"GB": newFormat("GB", `\d{9}|\d{12}|GD\d{3}|HA\d{3}`, "VAT number"),
+	"CH": newFormat("CHE", `\d{9}(?:MWST|TVA|IVA)?`, "UID"),
+	"NO": newFormat("NO", `\d{9}(?:MVA)?`, "organisation number"),
+	"US": newFormat("", `\d{9}`, "EIN"),
+	"CA": newFormat("", `\d{9}(?:RT\d{4})?`, "business number"),
+	"AU": newFormat("", `\d{11}`, "ABN"),
+	"NZ": newFormat("", `\d{8,9}`, "GST number"),
+	"IN": newFormat("", `\d{2}[A-Z]{5}\d{4}[A-Z][1-9A-Z]Z[0-9A-Z]`, "GSTIN"),
+	"BR": newFormat("", `\d{14}`, "CNPJ"),
+	"MX": newFormat("", `[A-Z&Ñ]{3,4}\d{6}[A-Z0-9]{3}`, "RFC"),
+	"JP": newFormat("T", `\d{13}`, "registration number"),
+}
+
+// genericFormat accepts the tax IDs of countries without a known format.
+var genericFormat = newFormat("", `[A-Z0-9]{2,32}`, "tax ID")
+
+// Normalize upper cases id and removes the spaces and punctuation people commonly type into tax IDs.
+func Normalize(id string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '.', '-', '/', ',':
+			return -1
+		default:
+			return r
+		}
+	}, strings.ToUpper(id))
+}
+
+// Validate checks that id is a well formed tax ID of the country and returns it in its canonical form: normalised
+// and, for VAT numbers, with the country prefix. Only the format is checked, not whether the number was issued.
+func Validate(code country.Code, id string) (string, error) {
+	normalised := Normalize(id)
+	if normalised == "" || len(normalised) > maxLength {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTaxID, id)
+	}
+
+	f, ok := formats[code]
+	if !ok {
+		f = genericFormat
+	}
+
+	number := strings.TrimPrefix(normalised, f.prefix)
+	if !f.pattern.MatchString(number) {
+		return "", fmt.Errorf("%w: %q is not a valid %s %s", ErrInvalidTaxID, id, code, f.name)
+	}
+
+	return f.prefix + number, nil
+}
@@ -0,0 +1,62 @@
+package taxid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"invoice-backend/pkg/country"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		country  country.Code
+		id       string
+		expected string
+	}{
+		{"DE", "DE 123 456 789", "DE123456789"},
+		{"DE", "123456789", "DE123456789"},
+		{"AT", "atu12345678", "ATU12345678"},
+		{"GR", "EL123456789", "EL123456789"},
+		{"NL", "NL123456789B01", "NL123456789B01"},
+		{"FR", "FR 40 303 265 045", "FR40303265045"},
+		{"GB", "GB 123 4567 89", "GB123456789"},
+		{"CH", "CHE-123.456.789 MWST", "CHE123456789MWST"},
+		{"US", "12-3456789", "123456789"},
+		{"CA", "123456789 RT0001", "123456789RT0001"},
+		{"AU", "51 824 753 556", "51824753556"},
+		{"IN", "27AAPFU0939F1ZV", "27AAPFU0939F1ZV"},
+		{"KE", "P051234567X", "P051234567X"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.country)+" "+tt.id, func(t *testing.T) {
+			id, err := Validate(tt.country, tt.id)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, id)
+		})
+	}
+}
+
+func TestValidateRejectsMalformedIDs(t *testing.T) {
+	tests := []struct {
+		country country.Code
+		id      string
+	}{
+		{"DE", ""},
+		{"DE", "DE12345678"},
+		{"DE", "FR123456789"},
+		{"AT", "AT12345678"},
+		{"NL", "NL123456789"},
+		{"US", "1234567"},
+		{"AU", "5182475355"},
+		{"KE", "P"},
+		{"KE", "P0512345!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.country)+" "+tt.id, func(t *testing.T) {
+			_, err := Validate(tt.country, tt.id)
+			assert.ErrorIs(t, err, ErrInvalidTaxID)
+		})
+	}
+}